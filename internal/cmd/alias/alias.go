@@ -0,0 +1,36 @@
+package alias
+
+import (
+	"fmt"
+
+	"github.com/stackitcloud/stackit-cli/internal/cmd/alias/delete"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/alias/list"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/alias/set"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "alias",
+		Short: "Manage command aliases",
+		Long: fmt.Sprintf("%s\n%s\n%s",
+			"Manage command aliases.",
+			`An alias is a shortcut for a (partial) STACKIT CLI command, e.g. "stackit kc prod" can expand to "stackit ske kubeconfig create prod --login --overwrite".`,
+			"Aliases are stored in the configuration of the active profile.",
+		),
+		Args: args.NoArgs,
+		Run:  utils.CmdHelp,
+	}
+	addSubcommands(cmd, params)
+	return cmd
+}
+
+func addSubcommands(cmd *cobra.Command, params *types.CmdParams) {
+	cmd.AddCommand(set.NewCmd(params))
+	cmd.AddCommand(list.NewCmd(params))
+	cmd.AddCommand(delete.NewCmd(params))
+}
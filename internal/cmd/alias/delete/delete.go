@@ -0,0 +1,77 @@
+package delete
+
+import (
+	"fmt"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/aliases"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	nameArg = "NAME"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+	Name string
+}
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("delete %s", nameArg),
+		Short: "Deletes a command alias",
+		Long:  "Deletes a command alias.",
+		Args:  args.SingleArg(nameArg, aliases.ValidateName),
+		Example: examples.Build(
+			examples.NewExample(
+				`Delete the alias "kc"`,
+				"$ stackit alias delete kc"),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			if _, exists := aliases.Get(model.Name); !exists {
+				return &errors.AliasDoesNotExistError{Alias: model.Name}
+			}
+
+			prompt := fmt.Sprintf("Are you sure you want to delete the alias %q?", model.Name)
+			err = params.Printer.PromptForConfirmation(prompt)
+			if err != nil {
+				return err
+			}
+
+			err = aliases.Delete(model.Name)
+			if err != nil {
+				return fmt.Errorf("delete alias: %w", err)
+			}
+
+			params.Printer.Info("Deleted alias %q\n", model.Name)
+			return nil
+		},
+	}
+	return cmd
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inputModel, error) {
+	name := inputArgs[0]
+
+	globalFlags := globalflags.Parse(p, cmd)
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		Name:            name,
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
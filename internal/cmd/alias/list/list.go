@@ -0,0 +1,89 @@
+package list
+
+import (
+	"fmt"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/aliases"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/tables"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+}
+
+type aliasInfo struct {
+	Name      string `json:"name"`
+	Expansion string `json:"expansion"`
+}
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Lists all command aliases",
+		Long:  "Lists all command aliases of the active profile.",
+		Args:  args.NoArgs,
+		Example: examples.Build(
+			examples.NewExample(
+				`List all command aliases`,
+				"$ stackit alias list"),
+			examples.NewExample(
+				`List all command aliases in a json format`,
+				"$ stackit alias list --output-format json"),
+		),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			model := parseInput(params.Printer, cmd)
+
+			output := buildOutput(aliases.List())
+			return outputResult(params.Printer, model.OutputFormat, output)
+		},
+	}
+	return cmd
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command) *inputModel {
+	globalFlags := globalflags.Parse(p, cmd)
+
+	return &inputModel{
+		GlobalFlagModel: globalFlags,
+	}
+}
+
+func buildOutput(aliases map[string]string) []aliasInfo {
+	output := []aliasInfo{}
+	for _, name := range utils.SortedKeys(aliases) {
+		output = append(output, aliasInfo{
+			Name:      name,
+			Expansion: aliases[name],
+		})
+	}
+	return output
+}
+
+func outputResult(p *print.Printer, outputFormat string, aliases []aliasInfo) error {
+	return p.OutputResult(outputFormat, aliases, func() error {
+		if len(aliases) == 0 {
+			p.Info("No aliases configured\n")
+			return nil
+		}
+
+		table := tables.NewTable()
+		table.SetHeader("NAME", "EXPANSION")
+		for _, alias := range aliases {
+			table.AddRow(alias.Name, alias.Expansion)
+			table.AddSeparator()
+		}
+		err := table.Display(p)
+		if err != nil {
+			return fmt.Errorf("render table: %w", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,79 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testparams"
+)
+
+func TestBuildOutput(t *testing.T) {
+	tests := []struct {
+		description string
+		aliases     map[string]string
+		expected    []aliasInfo
+	}{
+		{
+			description: "empty",
+			aliases:     map[string]string{},
+			expected:    []aliasInfo{},
+		},
+		{
+			description: "sorted by name",
+			aliases: map[string]string{
+				"servers": "server list",
+				"kc":      "ske kubeconfig create $1",
+			},
+			expected: []aliasInfo{
+				{Name: "kc", Expansion: "ske kubeconfig create $1"},
+				{Name: "servers", Expansion: "server list"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := buildOutput(tt.aliases)
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("unexpected output: %s", diff)
+			}
+		})
+	}
+}
+
+func TestOutputResult(t *testing.T) {
+	type args struct {
+		outputFormat string
+		aliases      []aliasInfo
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name:    "empty",
+			args:    args{},
+			wantErr: false,
+		},
+		{
+			name: "with aliases",
+			args: args{
+				aliases: []aliasInfo{
+					{Name: "kc", Expansion: "ske kubeconfig create $1"},
+				},
+			},
+			wantErr: false,
+		},
+	}
+	params := testparams.NewTestParams()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := outputResult(params.Printer, tt.args.outputFormat, tt.args.aliases); (err != nil) != tt.wantErr {
+				t.Errorf("outputResult() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package set
+
+import (
+	"fmt"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/aliases"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	nameArg      = "NAME"
+	expansionArg = "EXPANSION"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+	Name      string
+	Expansion string
+}
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("set %s %s", nameArg, expansionArg),
+		Short: "Creates or updates a command alias",
+		Long: fmt.Sprintf("%s\n%s\n%s\n%s",
+			"Creates or updates a command alias.",
+			`The expansion is the STACKIT CLI command (without the leading "stackit") the alias expands to.`,
+			`Positional placeholders "$1", "$2", ... in the expansion are replaced by the arguments passed to the alias. Arguments which aren't referenced by a placeholder are appended to the expansion.`,
+			"An alias can't have the same name as an existing command.",
+		),
+		Args: cobra.ExactArgs(2),
+		Example: examples.Build(
+			examples.NewExample(
+				`Create an alias "kc", so that "stackit kc prod" creates a login kubeconfig for the SKE cluster "prod"`,
+				"$ stackit alias set kc 'ske kubeconfig create $1 --login --overwrite'"),
+			examples.NewExample(
+				`Create an alias "servers" to list servers in a json format`,
+				"$ stackit alias set servers 'server list --output-format json'"),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			// Aliases are only resolved for unknown commands, so they can't shadow existing commands
+			rootCmd := cmd.Root()
+			if foundCmd, _, err := rootCmd.Find([]string{model.Name}); err == nil && foundCmd != rootCmd {
+				return fmt.Errorf("alias %q can't be set, since a command with the same name exists", model.Name)
+			}
+
+			if _, exists := aliases.Get(model.Name); exists {
+				prompt := fmt.Sprintf("Are you sure you want to overwrite the alias %q?", model.Name)
+				err = params.Printer.PromptForConfirmation(prompt)
+				if err != nil {
+					return err
+				}
+			}
+
+			err = aliases.Set(model.Name, model.Expansion)
+			if err != nil {
+				return fmt.Errorf("set alias: %w", err)
+			}
+
+			params.Printer.Info("Set alias %q to %q\n", model.Name, model.Expansion)
+			return nil
+		},
+	}
+	return cmd
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inputModel, error) {
+	name := inputArgs[0]
+	expansion := inputArgs[1]
+
+	err := aliases.ValidateName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	words, err := aliases.Split(expansion)
+	if err != nil {
+		return nil, fmt.Errorf("parse expansion %q: %w", expansion, err)
+	}
+	if len(words) == 0 {
+		return nil, fmt.Errorf("expansion can't be empty")
+	}
+
+	globalFlags := globalflags.Parse(p, cmd)
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		Name:            name,
+		Expansion:       expansion,
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
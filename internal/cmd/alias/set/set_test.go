@@ -0,0 +1,108 @@
+package set
+
+import (
+	"testing"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testutils"
+)
+
+const (
+	testName      = "kc"
+	testExpansion = "ske kubeconfig create $1 --login --overwrite"
+)
+
+func fixtureArgValues(mods ...func(argValues []string)) []string {
+	argValues := []string{
+		testName,
+		testExpansion,
+	}
+	for _, mod := range mods {
+		mod(argValues)
+	}
+	return argValues
+}
+
+func fixtureInputModel(mods ...func(model *inputModel)) *inputModel {
+	model := &inputModel{
+		GlobalFlagModel: &globalflags.GlobalFlagModel{
+			Verbosity: globalflags.VerbosityDefault,
+		},
+		Name:      testName,
+		Expansion: testExpansion,
+	}
+	for _, mod := range mods {
+		mod(model)
+	}
+	return model
+}
+
+func TestParseInput(t *testing.T) {
+	tests := []struct {
+		description   string
+		argValues     []string
+		flagValues    map[string]string
+		isValid       bool
+		expectedModel *inputModel
+	}{
+		{
+			description:   "base",
+			argValues:     fixtureArgValues(),
+			isValid:       true,
+			expectedModel: fixtureInputModel(),
+		},
+		{
+			description: "no arg values",
+			argValues:   []string{},
+			isValid:     false,
+		},
+		{
+			description: "only name",
+			argValues:   []string{testName},
+			isValid:     false,
+		},
+		{
+			description: "too many args",
+			argValues:   []string{testName, "ske", "cluster", "list"},
+			isValid:     false,
+		},
+		{
+			description: "invalid name",
+			argValues: fixtureArgValues(func(argValues []string) {
+				argValues[0] = "Invalid_Name"
+			}),
+			isValid: false,
+		},
+		{
+			description: "empty expansion",
+			argValues: fixtureArgValues(func(argValues []string) {
+				argValues[1] = " "
+			}),
+			isValid: false,
+		},
+		{
+			description: "unterminated quote in expansion",
+			argValues: fixtureArgValues(func(argValues []string) {
+				argValues[1] = `project list --name "xxx`
+			}),
+			isValid: false,
+		},
+		{
+			description: "some global flag",
+			argValues:   fixtureArgValues(),
+			flagValues: map[string]string{
+				globalflags.VerbosityFlag.Name(): globalflags.DebugVerbosity,
+			},
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.Verbosity = globalflags.DebugVerbosity
+			}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			testutils.TestParseInput(t, NewCmd, parseInput, tt.expectedModel, tt.argValues, tt.flagValues, tt.isValid)
+		})
+	}
+}
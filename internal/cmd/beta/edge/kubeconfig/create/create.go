@@ -34,6 +34,7 @@ const (
 	filepathFlag       = "filepath"
 	overwriteFlag      = "overwrite"
 	switchContextFlag  = "switch-context"
+	loginFlag          = "login"
 
 	expirationSecondsDefault = 3600 // 60 * 60 seconds = 1 hour
 )
@@ -46,6 +47,7 @@ type inputModel struct {
 	Overwrite      bool
 	Expiration     uint64
 	SwitchContext  bool
+	Login          bool
 }
 
 // NewCmd https://aip.stackit.cloud/aip/general/0121/
@@ -55,12 +57,13 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create",
 		Short: "Creates or updates a local kubeconfig file of an Edge Cloud instance",
-		Long: fmt.Sprintf("%s\n\n%s\n%s\n%s\n%s",
+		Long: fmt.Sprintf("%s\n\n%s\n%s\n%s\n%s\n%s",
 			"Creates or updates a local kubeconfig file of a STACKIT Edge Cloud (STEC) instance. If the config exists in the kubeconfig file, the information will be updated.",
 			"By default, the kubeconfig information of the edge instance is merged into the current kubeconfig file which is determined by Kubernetes client logic. If the kubeconfig file doesn't exist, a new one will be created.",
 			fmt.Sprintf("You can override this behavior by specifying a custom filepath with the --%s flag or disable writing with the --%s flag.", filepathFlag, disableWritingFlag),
 			fmt.Sprintf("An expiration time can be set for the kubeconfig. The expiration time is set in seconds(s), minutes(m), hours(h), days(d) or months(M). Default is %d seconds.", expirationSecondsDefault),
-			"Note: the format for the duration is <value><unit>, e.g. 30d for 30 days. You may not combine units."),
+			"Note: the format for the duration is <value><unit>, e.g. 30d for 30 days. You may not combine units.",
+			fmt.Sprintf("With the --%s flag the kubeconfig doesn't contain any credentials and instead obtains short-lived credentials via \"stackit beta edge-cloud kubeconfig login\".", loginFlag)),
 		Args: args.NoArgs,
 		Example: examples.Build(
 			examples.NewExample(
@@ -75,6 +78,9 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 			examples.NewExample(
 				`Create a kubeconfig for the Edge Cloud instance with instance ID "xxx". This will replace your current kubeconfig file.`,
 				`$ stackit beta edge-cloud kubeconfig create --instance-id xxx --overwrite`),
+			examples.NewExample(
+				`Create or update a login kubeconfig for the Edge Cloud instance with instance ID "xxx". This kubeconfig doesn't contain any credentials and instead obtains valid credentials via the "stackit beta edge-cloud kubeconfig login" command.`,
+				`$ stackit beta edge-cloud kubeconfig create --instance-id xxx --login`),
 		),
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			ctx := context.Background()
@@ -156,9 +162,11 @@ func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP(expirationFlag, "e", "", "Expiration time for the kubeconfig, e.g. 5d. By default, the token is valid for 1h.")
 	cmd.Flags().Bool(overwriteFlag, false, "Force overwrite the kubeconfig file if it exists.")
 	cmd.Flags().Bool(switchContextFlag, false, "Switch to the context in the kubeconfig file to the new context.")
+	cmd.Flags().BoolP(loginFlag, "l", false, "Create a login kubeconfig that obtains valid credentials via the STACKIT CLI. This flag is mutually exclusive with the expiration flag.")
 
 	cmd.MarkFlagsMutuallyExclusive(disableWritingFlag, filepathFlag)  // DisableWriting xor Filepath
 	cmd.MarkFlagsMutuallyExclusive(disableWritingFlag, overwriteFlag) // DisableWriting xor Overwrite
+	cmd.MarkFlagsMutuallyExclusive(loginFlag, expirationFlag)         // Login xor Expiration
 
 	err := flags.MarkFlagsRequired(cmd, instanceIdFlag)
 	cobra.CheckErr(err)
@@ -178,6 +186,7 @@ func parseInput(p *print.Printer, cmd *cobra.Command) (*inputModel, error) {
 		Filepath:        flags.FlagToStringPointer(p, cmd, filepathFlag),
 		Overwrite:       flags.FlagToBoolValue(p, cmd, overwriteFlag),
 		SwitchContext:   flags.FlagToBoolValue(p, cmd, switchContextFlag),
+		Login:           flags.FlagToBoolValue(p, cmd, loginFlag),
 	}
 
 	// Parse and validate kubeconfig expiration time
@@ -255,8 +264,18 @@ func outputResult(p *print.Printer, outputFormat string, model *inputModel, kube
 		}
 	}
 
+	kubeconfigMap := kubeconfig.Kubeconfig
+	if model.Login {
+		// Replace the credentials by the exec plugin, which obtains short-lived credentials instead
+		loginKubeconfig, err := commonKubeconfig.ToLoginKubeconfig(kubeconfigMap, model.ProjectId, model.Region, model.InstanceId)
+		if err != nil {
+			return fmt.Errorf("create login kubeconfig: %w", err)
+		}
+		kubeconfigMap = loginKubeconfig
+	}
+
 	// Marshal kubeconfig data based on the determined format
-	kubeconfigData, err := marshalKubeconfig(kubeconfig.Kubeconfig, format)
+	kubeconfigData, err := marshalKubeconfig(kubeconfigMap, format)
 	if err != nil {
 		return err
 	}
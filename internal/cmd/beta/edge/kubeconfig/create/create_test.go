@@ -221,6 +221,24 @@ func TestParseInput(t *testing.T) {
 			}),
 			isValid: true,
 		},
+		{
+			description: "login",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[loginFlag] = "true"
+			}),
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.Login = true
+			}),
+			isValid: true,
+		},
+		{
+			description: "login and expiration",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[loginFlag] = "true"
+				flagValues[expirationFlag] = "1h"
+			}),
+			isValid: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -326,6 +344,28 @@ func TestOutputResult(t *testing.T) {
 				kubeconfig: &edge.Kubeconfig{Kubeconfig: testKubeconfigMap()},
 			},
 		},
+		{
+			name: "output login kubeconfig with disable writing",
+			args: args{
+				model: fixtureInputModel(func(model *inputModel) {
+					model.OutputFormat = print.YAMLOutputFormat
+					model.DisableWriting = true
+					model.Login = true
+				}),
+				kubeconfig: &edge.Kubeconfig{Kubeconfig: testKubeconfigMap()},
+			},
+		},
+		{
+			name:    "login kubeconfig without users",
+			wantErr: true,
+			args: args{
+				model: fixtureInputModel(func(model *inputModel) {
+					model.DisableWriting = true
+					model.Login = true
+				}),
+				kubeconfig: &edge.Kubeconfig{Kubeconfig: map[string]interface{}{"apiVersion": "v1"}},
+			},
+		},
 		{
 			name: "file writing enabled (default behavior)",
 			args: args{
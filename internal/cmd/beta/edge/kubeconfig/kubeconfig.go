@@ -2,6 +2,7 @@ package kubeconfig
 
 import (
 	"github.com/stackitcloud/stackit-cli/internal/cmd/beta/edge/kubeconfig/create"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/beta/edge/kubeconfig/login"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
@@ -23,4 +24,5 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 
 func addSubcommands(cmd *cobra.Command, params *types.CmdParams) {
 	cmd.AddCommand(create.NewCmd(params))
+	cmd.AddCommand(login.NewCmd(params))
 }
@@ -0,0 +1,232 @@
+package login
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	edge "github.com/stackitcloud/stackit-sdk-go/services/edge/v1beta1api"
+	"github.com/stackitcloud/stackit-sdk-go/services/edge/v1beta1api/wait"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientauthenticationv1 "k8s.io/client-go/pkg/apis/clientauthentication/v1"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/auth"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/cache"
+	cliErr "github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/services/edge/client"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+)
+
+const (
+	instanceIdFlag = "instance-id"
+
+	expirationSeconds     = 60 * 60          // 1 hour
+	refreshBeforeDuration = 15 * time.Minute // 15 min
+
+	cachePrefix = "edge-login-"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+	InstanceId string
+}
+
+// cachedToken is a token of an instance together with its expiration, which is cached between the calls of the plugin
+type cachedToken struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "login",
+		Short: "Login plugin for Kubernetes clients of Edge Cloud instances",
+		Long: fmt.Sprintf("%s\n%s\n%s\n%s",
+			"Login plugin for Kubernetes clients, that creates short-lived credentials to authenticate against a STACKIT Edge Cloud (STEC) instance.",
+			"First you need to obtain a kubeconfig for use with the login command (first example).",
+			"Secondly you use the kubeconfig with your chosen Kubernetes client (second example), the client will automatically retrieve the credentials via the STACKIT CLI.",
+			"The credentials are cached and new ones are requested shortly before they expire. If that fails, e.g. while offline, the cached credentials are used until they expire.",
+		),
+		Args: args.NoArgs,
+		Example: examples.Build(
+			examples.NewExample(
+				`Get a login kubeconfig for the Edge Cloud instance with instance ID "xxx". `+
+					"This kubeconfig does not contain any credentials and instead obtains valid credentials via the `stackit beta edge-cloud kubeconfig login` command.",
+				"$ stackit beta edge-cloud kubeconfig create --instance-id xxx --login"),
+			examples.NewExample(
+				"Use the previously saved kubeconfig to authenticate to the Edge Cloud instance, in this case with kubectl.",
+				"$ kubectl cluster-info",
+				"$ kubectl get pods"),
+		),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := context.Background()
+
+			model, err := parseInput(params.Printer, cmd)
+			if err != nil {
+				return err
+			}
+
+			if err := cache.Init(); err != nil {
+				return fmt.Errorf("cache init failed: %w", err)
+			}
+
+			cacheKey, err := getCacheKey(model)
+			if err != nil {
+				return err
+			}
+			token, err := retrieveToken(ctx, params, model, cacheKey)
+			if err != nil {
+				return err
+			}
+
+			output, err := parseTokenToExecCredential(token)
+			if err != nil {
+				_ = cache.DeleteObject(cacheKey)
+				return fmt.Errorf("convert to ExecCredential: %w", err)
+			}
+			params.Printer.Outputf("%s", string(output))
+			return nil
+		},
+	}
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().String(instanceIdFlag, "", "Edge Cloud instance ID")
+
+	err := flags.MarkFlagsRequired(cmd, instanceIdFlag)
+	cobra.CheckErr(err)
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command) (*inputModel, error) {
+	globalFlags := globalflags.Parse(p, cmd)
+	if globalFlags.ProjectId == "" {
+		return nil, &cliErr.ProjectIdError{}
+	}
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		InstanceId:      flags.FlagToStringValue(p, cmd, instanceIdFlag),
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+// getCacheKey returns the cache identifier of the token of the instance for the authenticated account
+func getCacheKey(model *inputModel) (string, error) {
+	authEmail, err := auth.GetAuthEmail()
+	if err != nil {
+		return "", fmt.Errorf("error getting auth email: %w", err)
+	}
+	return fmt.Sprintf("%s%x", cachePrefix, sha256.Sum256([]byte(model.ProjectId+"\x00"+model.Region+"\x00"+model.InstanceId+"\x00"+authEmail))), nil
+}
+
+func retrieveToken(ctx context.Context, params *types.CmdParams, model *inputModel, cacheKey string) (*cachedToken, error) {
+	cached := getCachedToken(cacheKey)
+	if cached != nil && time.Now().Add(refreshBeforeDuration).Before(cached.ExpiresAt) {
+		// cached token is valid and won't expire soon
+		return cached, nil
+	}
+
+	token, err := requestNewToken(ctx, params, model, cacheKey)
+	if err != nil {
+		// try to get a new one but use cache on failure, as long as it is valid
+		if cached != nil && time.Now().Before(cached.ExpiresAt) {
+			params.Printer.Debug(print.ErrorLevel, "request new token: %v", err)
+			return cached, nil
+		}
+		return nil, err
+	}
+	return token, nil
+}
+
+func getCachedToken(key string) *cachedToken {
+	data, err := cache.GetObject(key)
+	if err != nil {
+		return nil
+	}
+	return parseCachedToken(data)
+}
+
+func parseCachedToken(data []byte) *cachedToken {
+	token := &cachedToken{}
+	if err := json.Unmarshal(data, token); err != nil || token.Token == "" {
+		return nil
+	}
+	return token
+}
+
+func requestNewToken(ctx context.Context, params *types.CmdParams, model *inputModel, cacheKey string) (*cachedToken, error) {
+	// Configure API client
+	apiClient, err := client.ConfigureClient(params.Printer, params.CliVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	// The expiration is calculated before the request, so that it is rather too early than too late
+	expiresAt := time.Now().Add(expirationSeconds * time.Second)
+	resp, err := buildRequest(ctx, model, apiClient).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("request token for Edge Cloud instance: %w", err)
+	}
+	if resp == nil || resp.Token == "" {
+		return nil, fmt.Errorf("no token returned from the API")
+	}
+
+	// No spinner is shown, as the output is read by the Kubernetes client
+	expiration := int64(expirationSeconds)
+	_, err = wait.TokenWaitHandler(ctx, apiClient.DefaultAPI, model.ProjectId, model.Region, model.InstanceId, &expiration).WaitWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("wait for token creation: %w", err)
+	}
+
+	token := &cachedToken{
+		Token:     resp.Token,
+		ExpiresAt: expiresAt,
+	}
+	data, err := json.Marshal(token)
+	if err != nil {
+		return nil, fmt.Errorf("marshal token: %w", err)
+	}
+	if err = cache.PutObject(cacheKey, data); err != nil {
+		return nil, fmt.Errorf("cache token: %w", err)
+	}
+	return token, nil
+}
+
+func buildRequest(ctx context.Context, model *inputModel, apiClient *edge.APIClient) edge.ApiGetTokenByInstanceIdRequest {
+	req := apiClient.DefaultAPI.GetTokenByInstanceId(ctx, model.ProjectId, model.Region, model.InstanceId)
+	return req.ExpirationSeconds(expirationSeconds)
+}
+
+func parseTokenToExecCredential(token *cachedToken) ([]byte, error) {
+	if token == nil {
+		return nil, fmt.Errorf("token is nil")
+	}
+
+	outputExecCredential := clientauthenticationv1.ExecCredential{
+		TypeMeta: v1.TypeMeta{
+			APIVersion: clientauthenticationv1.SchemeGroupVersion.String(),
+			Kind:       "ExecCredential",
+		},
+		Status: &clientauthenticationv1.ExecCredentialStatus{
+			ExpirationTimestamp: &v1.Time{Time: token.ExpiresAt.Add(-refreshBeforeDuration)},
+			Token:               token.Token,
+		},
+	}
+	output, err := json.Marshal(&outputExecCredential)
+	if err != nil {
+		return nil, fmt.Errorf("marshal: %w", err)
+	}
+	return output, nil
+}
@@ -0,0 +1,215 @@
+package login
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	edge "github.com/stackitcloud/stackit-sdk-go/services/edge/v1beta1api"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientauthenticationv1 "k8s.io/client-go/pkg/apis/clientauthentication/v1"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testutils"
+)
+
+type testCtxKey struct{}
+
+var (
+	testCtx        = context.WithValue(context.Background(), testCtxKey{}, "foo")
+	testProjectId  = uuid.NewString()
+	testInstanceId = uuid.NewString()
+	testClient     = &edge.APIClient{DefaultAPI: &edge.DefaultAPIService{}}
+)
+
+const testRegion = "eu01"
+
+func fixtureFlagValues(mods ...func(flagValues map[string]string)) map[string]string {
+	flagValues := map[string]string{
+		globalflags.ProjectIdFlag: testProjectId,
+		globalflags.RegionFlag:    testRegion,
+		instanceIdFlag:            testInstanceId,
+	}
+	for _, mod := range mods {
+		mod(flagValues)
+	}
+	return flagValues
+}
+
+func fixtureInputModel(mods ...func(model *inputModel)) *inputModel {
+	model := &inputModel{
+		GlobalFlagModel: &globalflags.GlobalFlagModel{
+			ProjectId: testProjectId,
+			Region:    testRegion,
+			Verbosity: globalflags.VerbosityDefault,
+		},
+		InstanceId: testInstanceId,
+	}
+	for _, mod := range mods {
+		mod(model)
+	}
+	return model
+}
+
+func TestParseInput(t *testing.T) {
+	tests := []struct {
+		description   string
+		argValues     []string
+		flagValues    map[string]string
+		isValid       bool
+		expectedModel *inputModel
+	}{
+		{
+			description:   "base",
+			flagValues:    fixtureFlagValues(),
+			isValid:       true,
+			expectedModel: fixtureInputModel(),
+		},
+		{
+			description: "no flag values",
+			flagValues:  map[string]string{},
+			isValid:     false,
+		},
+		{
+			description: "project id missing",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, globalflags.ProjectIdFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "instance id missing",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, instanceIdFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "with arguments",
+			argValues:   []string{"arg"},
+			flagValues:  fixtureFlagValues(),
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			testutils.TestParseInput(t, NewCmd, func(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
+				return parseInput(p, cmd)
+			}, tt.expectedModel, tt.argValues, tt.flagValues, tt.isValid)
+		})
+	}
+}
+
+func TestBuildRequest(t *testing.T) {
+	expectedRequest := testClient.DefaultAPI.GetTokenByInstanceId(testCtx, testProjectId, testRegion, testInstanceId).
+		ExpirationSeconds(expirationSeconds)
+
+	request := buildRequest(testCtx, fixtureInputModel(), testClient)
+
+	diff := cmp.Diff(request, expectedRequest,
+		cmp.AllowUnexported(expectedRequest, edge.DefaultAPIService{}),
+		cmpopts.EquateComparable(testCtx),
+	)
+	if diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+}
+
+func TestParseCachedToken(t *testing.T) {
+	expiresAt := time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC)
+	data, err := json.Marshal(&cachedToken{Token: "token", ExpiresAt: expiresAt})
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	tests := []struct {
+		description   string
+		data          []byte
+		expectedToken *cachedToken
+	}{
+		{
+			description:   "valid",
+			data:          data,
+			expectedToken: &cachedToken{Token: "token", ExpiresAt: expiresAt},
+		},
+		{
+			description:   "empty token",
+			data:          []byte(`{"token":"","expiresAt":"2024-01-01T01:00:00Z"}`),
+			expectedToken: nil,
+		},
+		{
+			description:   "invalid",
+			data:          []byte("invalid"),
+			expectedToken: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			token := parseCachedToken(tt.data)
+			diff := cmp.Diff(token, tt.expectedToken)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestParseTokenToExecCredential(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		description                   string
+		token                         *cachedToken
+		isValid                       bool
+		expectedExecCredentialRequest *clientauthenticationv1.ExecCredential
+	}{
+		{
+			description: "expiration time",
+			token:       &cachedToken{Token: "token", ExpiresAt: expiresAt},
+			isValid:     true,
+			expectedExecCredentialRequest: &clientauthenticationv1.ExecCredential{
+				TypeMeta: v1.TypeMeta{
+					APIVersion: clientauthenticationv1.SchemeGroupVersion.String(),
+					Kind:       "ExecCredential",
+				},
+				Status: &clientauthenticationv1.ExecCredentialStatus{
+					ExpirationTimestamp: &v1.Time{Time: expiresAt.Add(-refreshBeforeDuration)},
+					Token:               "token",
+				},
+			},
+		},
+		{
+			description: "nil token",
+			token:       nil,
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			execCredential, err := parseTokenToExecCredential(tt.token)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatal("should have failed but didn't")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("func returned error: %s", err)
+			}
+			expected, _ := json.Marshal(tt.expectedExecCredentialRequest)
+			diff := cmp.Diff(execCredential, expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
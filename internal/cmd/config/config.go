@@ -9,6 +9,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/cmd/config/profile"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/config/set"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/config/unset"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/config/validate"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
 
@@ -19,12 +20,14 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "config",
 		Short: "Provides functionality for CLI configuration options",
-		Long: fmt.Sprintf("%s\n%s\n\n%s\n%s\n%s",
+		Long: fmt.Sprintf("%s\n%s\n\n%s\n%s\n%s\n\n%s\n%s",
 			"Provides functionality for CLI configuration options.",
 			`You can set and unset different configuration options via the "stackit config set" and "stackit config unset" commands.`,
 			"Additionally, you can configure the CLI to use different profiles, each with its own configuration.",
 			`Additional profiles can be configured via the "STACKIT_CLI_PROFILE" environment variable or using the "stackit config profile set PROFILE" and "stackit config profile unset" commands.`,
 			"The environment variable takes precedence over what is set via the commands.",
+			`Per directory, a ".stackit.yaml" (or ".stackit.json") project configuration file can set the "project_id", "region" and "output_format" values and select a profile via "profile".`,
+			"The closest file in the current directory or its parent directories is used. It takes precedence over the profile configuration, but not over environment variables and flags.",
 		),
 		Args: args.NoArgs,
 		Run:  utils.CmdHelp,
@@ -38,4 +41,5 @@ func addSubcommands(cmd *cobra.Command, params *types.CmdParams) {
 	cmd.AddCommand(set.NewCmd(params))
 	cmd.AddCommand(unset.NewCmd(params))
 	cmd.AddCommand(profile.NewCmd(params))
+	cmd.AddCommand(validate.NewCmd(params))
 }
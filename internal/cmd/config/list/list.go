@@ -16,6 +16,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/config"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/tables"
@@ -24,19 +25,27 @@ import (
 	"github.com/spf13/viper"
 )
 
+const (
+	showOriginFlag = "show-origin"
+)
+
 type inputModel struct {
 	*globalflags.GlobalFlagModel
+	ShowOrigin bool
 }
 
 func NewCmd(params *types.CmdParams) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "Lists the current CLI configuration values",
-		Long: fmt.Sprintf("%s\n%s\n%s\n%s\n%s\n%s\n%s",
+		Long: fmt.Sprintf("%s\n%s\n%s\n%s\n%s\n%s\n%s\n%s\n%s\n%s",
 			"Lists the current CLI configuration values, based on the following sources (in order of precedence):",
 			"- Environment variable",
 			`  The environment variable is the name of the setting, with underscores ("_") instead of dashes ("-") and the "STACKIT" prefix.`,
 			"  Example: you can set the project ID by setting the environment variable STACKIT_PROJECT_ID.",
+			"- Project configuration file",
+			`  A ".stackit.yaml" (or ".stackit.json") file in the current directory or in one of its parent directories.`,
+			`  It can set the "project_id", "region" and "output_format" values and select a profile via "profile".`,
 			"- Configuration set in CLI",
 			`  These are set using the "stackit config set" command`,
 			`  Example: you can set the project ID by running "stackit config set --project-id xxx"`,
@@ -49,6 +58,9 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 			examples.NewExample(
 				`List your active configuration in a json format`,
 				"$ stackit config list --output-format json"),
+			examples.NewExample(
+				`List your active configuration and where each value comes from`,
+				"$ stackit config list --show-origin"),
 		),
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			configData := viper.AllSettings()
@@ -60,21 +72,49 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 				return fmt.Errorf("get profile: %w", err)
 			}
 
-			return outputResult(params.Printer, model.OutputFormat, configData, activeProfile)
+			var origins map[string]string
+			if model.ShowOrigin {
+				origins = map[string]string{}
+				for key := range configData {
+					origins[key] = config.GetOrigin(key, cmd.Flags())
+				}
+			}
+
+			return outputResult(params.Printer, model.OutputFormat, configData, origins, activeProfile)
 		},
 	}
+	configureFlags(cmd)
 	return cmd
 }
 
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(showOriginFlag, false, "If set, shows where each configuration value comes from")
+}
+
 func parseInput(p *print.Printer, cmd *cobra.Command) *inputModel {
 	globalFlags := globalflags.Parse(p, cmd)
 
 	return &inputModel{
 		GlobalFlagModel: globalFlags,
+		ShowOrigin:      flags.FlagToBoolValue(p, cmd, showOriginFlag),
 	}
 }
 
-func outputResult(p *print.Printer, outputFormat string, configData map[string]any, activeProfile string) error {
+// outputResult prints the config values. If origins is not nil, the origin of each value is printed as well.
+func outputResult(p *print.Printer, outputFormat string, configData map[string]any, origins map[string]string, activeProfile string) error {
+	if origins != nil {
+		configDataWithOrigin := make(map[string]any, len(configData))
+		for key, value := range configData {
+			configDataWithOrigin[key] = map[string]any{
+				"value":  value,
+				"origin": origins[key],
+			}
+		}
+		if outputFormat == print.JSONOutputFormat || outputFormat == print.YAMLOutputFormat {
+			configData = configDataWithOrigin
+		}
+	}
+
 	switch outputFormat {
 	case print.JSONOutputFormat:
 		if activeProfile != "" {
@@ -107,7 +147,11 @@ func outputResult(p *print.Printer, outputFormat string, configData map[string]a
 		if activeProfile != "" {
 			table.SetTitle(fmt.Sprintf("Profile: %q", activeProfile))
 		}
-		table.SetHeader("NAME", "VALUE")
+		if origins != nil {
+			table.SetHeader("NAME", "VALUE", "ORIGIN")
+		} else {
+			table.SetHeader("NAME", "VALUE")
+		}
 		for _, key := range configKeys {
 			value := configData[key]
 
@@ -133,10 +177,16 @@ func outputResult(p *print.Printer, outputFormat string, configData map[string]a
 				continue
 			}
 
+			origin := origins[key]
+
 			// Replace "_" with "-" to match the flags
 			key = strings.ReplaceAll(key, "_", "-")
 
-			table.AddRow(key, valueString)
+			if origins != nil {
+				table.AddRow(key, valueString, origin)
+			} else {
+				table.AddRow(key, valueString)
+			}
 			table.AddSeparator()
 		}
 		err := table.Display(p)
@@ -10,6 +10,7 @@ func TestOutputResult(t *testing.T) {
 	type args struct {
 		outputFormat  string
 		configData    map[string]any
+		origins       map[string]string
 		activeProfile string
 	}
 	tests := []struct {
@@ -22,11 +23,40 @@ func TestOutputResult(t *testing.T) {
 			args:    args{},
 			wantErr: false,
 		},
+		{
+			name: "with origins",
+			args: args{
+				configData: map[string]any{
+					"project_id": "xxx",
+					"async":      false,
+				},
+				origins: map[string]string{
+					"project_id": `project file "/tmp/.stackit.yaml"`,
+					"async":      "default",
+				},
+				activeProfile: "default",
+			},
+			wantErr: false,
+		},
+		{
+			name: "with origins json",
+			args: args{
+				outputFormat: "json",
+				configData: map[string]any{
+					"project_id": "xxx",
+				},
+				origins: map[string]string{
+					"project_id": `environment variable "STACKIT_PROJECT_ID"`,
+				},
+				activeProfile: "default",
+			},
+			wantErr: false,
+		},
 	}
 	params := testparams.NewTestParams()
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if err := outputResult(params.Printer, tt.args.outputFormat, tt.args.configData, tt.args.activeProfile); (err != nil) != tt.wantErr {
+			if err := outputResult(params.Printer, tt.args.outputFormat, tt.args.configData, tt.args.origins, tt.args.activeProfile); (err != nil) != tt.wantErr {
 				t.Errorf("outputResult() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
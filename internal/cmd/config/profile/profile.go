@@ -10,7 +10,9 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/cmd/config/profile/export"
 	importProfile "github.com/stackitcloud/stackit-cli/internal/cmd/config/profile/import"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/config/profile/list"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/config/profile/protect"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/config/profile/set"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/config/profile/unprotect"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/config/profile/unset"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
@@ -43,4 +45,6 @@ func addSubcommands(cmd *cobra.Command, params *types.CmdParams) {
 	cmd.AddCommand(delete.NewCmd(params))
 	cmd.AddCommand(importProfile.NewCmd(params))
 	cmd.AddCommand(export.NewCmd(params))
+	cmd.AddCommand(protect.NewCmd(params))
+	cmd.AddCommand(unprotect.NewCmd(params))
 }
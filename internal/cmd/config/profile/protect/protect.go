@@ -0,0 +1,93 @@
+package protect
+
+import (
+	"fmt"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/config"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	profileArg = "PROFILE"
+
+	projectFlag = "project"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+	Profile string
+	Project string
+}
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("protect %s", profileArg),
+		Short: "Protects a CLI configuration profile or a project in it",
+		Long: fmt.Sprintf("%s\n%s\n%s\n%s",
+			"Protects a CLI configuration profile, or only a project in it, e.g. because it is used for production.",
+			"When running a command in a protected profile or project, the confirmation prompts require typing the project name (or the profile name, if no project is set) and a red banner is shown.",
+			`The "--assume-yes" flag doesn't skip these confirmation prompts, unless the "--i-know-what-i-am-doing" flag is set as well.`,
+			`The protection can be removed using the "stackit config profile unprotect" command.`,
+		),
+		Args: args.SingleArg(profileArg, nil),
+		Example: examples.Build(
+			examples.NewExample(
+				`Protect the configuration profile "prod"`,
+				"$ stackit config profile protect prod"),
+			examples.NewExample(
+				`Protect only the project with ID "xxx" in the configuration profile "default"`,
+				"$ stackit config profile protect default --project xxx"),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			err = config.SetProfileProtection(params.Printer, model.Profile, model.Project, true)
+			if err != nil {
+				return fmt.Errorf("protect profile: %w", err)
+			}
+
+			if model.Project != "" {
+				params.Printer.Info("Protected project %q in profile %q\n", model.Project, model.Profile)
+				return nil
+			}
+			params.Printer.Info("Protected profile %q\n", model.Profile)
+			return nil
+		},
+	}
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().Var(flags.UUIDFlag(), projectFlag, "ID of a project to protect in the profile. If not set, the whole profile is protected")
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inputModel, error) {
+	profile := inputArgs[0]
+
+	err := config.ValidateProfile(profile)
+	if err != nil {
+		return nil, err
+	}
+
+	globalFlags := globalflags.Parse(p, cmd)
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		Profile:         profile,
+		Project:         flags.FlagToStringValue(p, cmd, projectFlag),
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
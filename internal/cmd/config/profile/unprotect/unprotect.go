@@ -0,0 +1,100 @@
+package unprotect
+
+import (
+	"fmt"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/config"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	profileArg = "PROFILE"
+
+	projectFlag = "project"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+	Profile string
+	Project string
+}
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("unprotect %s", profileArg),
+		Short: "Removes the protection of a CLI configuration profile or a project in it",
+		Long: fmt.Sprintf("%s\n%s",
+			"Removes the protection of a CLI configuration profile, or only of a project in it.",
+			"If the command itself runs in a protected profile or project, the confirmation prompt requires typing the project name (or the profile name, if no project is set).",
+		),
+		Args: args.SingleArg(profileArg, nil),
+		Example: examples.Build(
+			examples.NewExample(
+				`Remove the protection of the configuration profile "prod"`,
+				"$ stackit config profile unprotect prod"),
+			examples.NewExample(
+				`Remove the protection of the project with ID "xxx" in the configuration profile "default"`,
+				"$ stackit config profile unprotect default --project xxx"),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			prompt := fmt.Sprintf("Are you sure you want to remove the protection of profile %q?", model.Profile)
+			if model.Project != "" {
+				prompt = fmt.Sprintf("Are you sure you want to remove the protection of project %q in profile %q?", model.Project, model.Profile)
+			}
+			err = params.Printer.PromptForConfirmation(prompt)
+			if err != nil {
+				return err
+			}
+
+			err = config.SetProfileProtection(params.Printer, model.Profile, model.Project, false)
+			if err != nil {
+				return fmt.Errorf("unprotect profile: %w", err)
+			}
+
+			if model.Project != "" {
+				params.Printer.Info("Removed the protection of project %q in profile %q\n", model.Project, model.Profile)
+				return nil
+			}
+			params.Printer.Info("Removed the protection of profile %q\n", model.Profile)
+			return nil
+		},
+	}
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().Var(flags.UUIDFlag(), projectFlag, "ID of a project to remove the protection of in the profile. If not set, the protection of the whole profile is removed")
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inputModel, error) {
+	profile := inputArgs[0]
+
+	err := config.ValidateProfile(profile)
+	if err != nil {
+		return nil, err
+	}
+
+	globalFlags := globalflags.Parse(p, cmd)
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		Profile:         profile,
+		Project:         flags.FlagToStringValue(p, cmd, projectFlag),
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
@@ -2,7 +2,6 @@ package set
 
 import (
 	"fmt"
-	"time"
 
 	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
 
@@ -24,37 +23,38 @@ const (
 	identityProviderCustomClientIdFlag               = "identity-provider-custom-client-id"
 	allowedUrlDomainFlag                             = "allowed-url-domain"
 
-	authorizationCustomEndpointFlag     = "authorization-custom-endpoint"
-	albWafCustomEndpointFlag            = "alb-waf-custom-endpoint"
-	dnsCustomEndpointFlag               = "dns-custom-endpoint"
-	edgeCustomEndpointFlag              = "edge-custom-endpoint"
-	loadBalancerCustomEndpointFlag      = "load-balancer-custom-endpoint"
-	logMeCustomEndpointFlag             = "logme-custom-endpoint"
-	mariaDBCustomEndpointFlag           = "mariadb-custom-endpoint"
-	mongoDBFlexCustomEndpointFlag       = "mongodbflex-custom-endpoint"
-	objectStorageCustomEndpointFlag     = "object-storage-custom-endpoint"
-	observabilityCustomEndpointFlag     = "observability-custom-endpoint"
-	openSearchCustomEndpointFlag        = "opensearch-custom-endpoint"
-	postgresFlexCustomEndpointFlag      = "postgresflex-custom-endpoint"
-	rabbitMQCustomEndpointFlag          = "rabbitmq-custom-endpoint"
-	redisCustomEndpointFlag             = "redis-custom-endpoint"
-	resourceManagerCustomEndpointFlag   = "resource-manager-custom-endpoint"
-	secretsManagerCustomEndpointFlag    = "secrets-manager-custom-endpoint"
-	kmsCustomEndpointFlag               = "kms-custom-endpoint"
-	serverBackupCustomEndpointFlag      = "serverbackup-custom-endpoint"
-	serverOsUpdateCustomEndpointFlag    = "server-osupdate-custom-endpoint"
-	runCommandCustomEndpointFlag        = "runcommand-custom-endpoint"
-	serviceAccountCustomEndpointFlag    = "service-account-custom-endpoint"
-	serviceEnablementCustomEndpointFlag = "service-enablement-custom-endpoint"
-	skeCustomEndpointFlag               = "ske-custom-endpoint"
-	sqlServerFlexCustomEndpointFlag     = "sqlserverflex-custom-endpoint"
-	iaasCustomEndpointFlag              = "iaas-custom-endpoint"
-	tokenCustomEndpointFlag             = "token-custom-endpoint"
-	intakeCustomEndpointFlag            = "intake-custom-endpoint"
-	logsCustomEndpointFlag              = "logs-custom-endpoint"
-	sfsCustomEndpointFlag               = "sfs-custom-endpoint"
-	cdnCustomEndpointFlag               = "cdn-custom-endpoint"
-	vpnCustomEndpointFlag               = "vpn-custom-endpoint"
+	authorizationCustomEndpointFlag       = "authorization-custom-endpoint"
+	albWafCustomEndpointFlag              = "alb-waf-custom-endpoint"
+	dnsCustomEndpointFlag                 = "dns-custom-endpoint"
+	edgeCustomEndpointFlag                = "edge-custom-endpoint"
+	loadBalancerCustomEndpointFlag        = "load-balancer-custom-endpoint"
+	logMeCustomEndpointFlag               = "logme-custom-endpoint"
+	mariaDBCustomEndpointFlag             = "mariadb-custom-endpoint"
+	mongoDBFlexCustomEndpointFlag         = "mongodbflex-custom-endpoint"
+	objectStorageCustomEndpointFlag       = "object-storage-custom-endpoint"
+	observabilityCustomEndpointFlag       = "observability-custom-endpoint"
+	openSearchCustomEndpointFlag          = "opensearch-custom-endpoint"
+	postgresFlexCustomEndpointFlag        = "postgresflex-custom-endpoint"
+	rabbitMQCustomEndpointFlag            = "rabbitmq-custom-endpoint"
+	redisCustomEndpointFlag               = "redis-custom-endpoint"
+	resourceManagerCustomEndpointFlag     = "resource-manager-custom-endpoint"
+	secretsManagerCustomEndpointFlag      = "secrets-manager-custom-endpoint"
+	secretsManagerVaultCustomEndpointFlag = "secrets-manager-vault-custom-endpoint"
+	kmsCustomEndpointFlag                 = "kms-custom-endpoint"
+	serverBackupCustomEndpointFlag        = "serverbackup-custom-endpoint"
+	serverOsUpdateCustomEndpointFlag      = "server-osupdate-custom-endpoint"
+	runCommandCustomEndpointFlag          = "runcommand-custom-endpoint"
+	serviceAccountCustomEndpointFlag      = "service-account-custom-endpoint"
+	serviceEnablementCustomEndpointFlag   = "service-enablement-custom-endpoint"
+	skeCustomEndpointFlag                 = "ske-custom-endpoint"
+	sqlServerFlexCustomEndpointFlag       = "sqlserverflex-custom-endpoint"
+	iaasCustomEndpointFlag                = "iaas-custom-endpoint"
+	tokenCustomEndpointFlag               = "token-custom-endpoint"
+	intakeCustomEndpointFlag              = "intake-custom-endpoint"
+	logsCustomEndpointFlag                = "logs-custom-endpoint"
+	sfsCustomEndpointFlag                 = "sfs-custom-endpoint"
+	cdnCustomEndpointFlag                 = "cdn-custom-endpoint"
+	vpnCustomEndpointFlag                 = "vpn-custom-endpoint"
 )
 
 type inputModel struct {
@@ -161,6 +161,7 @@ func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().String(redisCustomEndpointFlag, "", "Redis API base URL, used in calls to this API")
 	cmd.Flags().String(resourceManagerCustomEndpointFlag, "", "Resource Manager API base URL, used in calls to this API")
 	cmd.Flags().String(secretsManagerCustomEndpointFlag, "", "Secrets Manager API base URL, used in calls to this API")
+	cmd.Flags().String(secretsManagerVaultCustomEndpointFlag, "", "Secrets Manager Vault API base URL, used to write rotated database credentials to secrets")
 	cmd.Flags().String(kmsCustomEndpointFlag, "", "KMS API base URL, used in calls to this API")
 	cmd.Flags().String(serviceAccountCustomEndpointFlag, "", "Service Account API base URL, used in calls to this API")
 	cmd.Flags().String(serviceEnablementCustomEndpointFlag, "", "Service Enablement API base URL, used in calls to this API")
@@ -218,6 +219,8 @@ func configureFlags(cmd *cobra.Command) {
 	cobra.CheckErr(err)
 	err = viper.BindPFlag(config.SecretsManagerCustomEndpointKey, cmd.Flags().Lookup(secretsManagerCustomEndpointFlag))
 	cobra.CheckErr(err)
+	err = viper.BindPFlag(config.SecretsManagerVaultCustomEndpointKey, cmd.Flags().Lookup(secretsManagerVaultCustomEndpointFlag))
+	cobra.CheckErr(err)
 	err = viper.BindPFlag(config.KMSCustomEndpointKey, cmd.Flags().Lookup(kmsCustomEndpointFlag))
 	cobra.CheckErr(err)
 	err = viper.BindPFlag(config.ServerBackupCustomEndpointKey, cmd.Flags().Lookup(serverBackupCustomEndpointFlag))
@@ -286,21 +289,9 @@ func parseSessionTimeLimit(p *print.Printer, cmd *cobra.Command) (*string, error
 		return nil, nil
 	}
 
-	// time.ParseDuration doesn't recognize unit "d", for simplicity we allow the value "1d"
-	if *sessionTimeLimit == "1d" {
-		*sessionTimeLimit = "24h"
-	}
-
-	duration, err := time.ParseDuration(*sessionTimeLimit)
+	value, err := config.ParseSessionTimeLimit(*sessionTimeLimit)
 	if err != nil {
-		return nil, fmt.Errorf("parse value \"%s\": %w", *sessionTimeLimit, err)
+		return nil, err
 	}
-	if duration <= 0 {
-		return nil, fmt.Errorf("value must be positive")
-	}
-	if duration > time.Duration(24)*time.Hour {
-		return nil, fmt.Errorf("value can't be larger than 24h")
-	}
-
-	return sessionTimeLimit, nil
+	return &value, nil
 }
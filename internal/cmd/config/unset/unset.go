@@ -27,37 +27,38 @@ const (
 	identityProviderCustomClientIdFlag               = "identity-provider-custom-client-id"
 	allowedUrlDomainFlag                             = "allowed-url-domain"
 
-	authorizationCustomEndpointFlag     = "authorization-custom-endpoint"
-	albWafCustomEndpointFlag            = "alb-waf-custom-endpoint"
-	dnsCustomEndpointFlag               = "dns-custom-endpoint"
-	edgeCustomEndpointFlag              = "edge-custom-endpoint"
-	loadBalancerCustomEndpointFlag      = "load-balancer-custom-endpoint"
-	logMeCustomEndpointFlag             = "logme-custom-endpoint"
-	mariaDBCustomEndpointFlag           = "mariadb-custom-endpoint"
-	mongoDBFlexCustomEndpointFlag       = "mongodbflex-custom-endpoint"
-	objectStorageCustomEndpointFlag     = "object-storage-custom-endpoint"
-	observabilityCustomEndpointFlag     = "observability-custom-endpoint"
-	openSearchCustomEndpointFlag        = "opensearch-custom-endpoint"
-	postgresFlexCustomEndpointFlag      = "postgresflex-custom-endpoint"
-	rabbitMQCustomEndpointFlag          = "rabbitmq-custom-endpoint"
-	redisCustomEndpointFlag             = "redis-custom-endpoint"
-	resourceManagerCustomEndpointFlag   = "resource-manager-custom-endpoint"
-	secretsManagerCustomEndpointFlag    = "secrets-manager-custom-endpoint"
-	kmsCustomEndpointFlag               = "kms-custom-endpoint"
-	serviceAccountCustomEndpointFlag    = "service-account-custom-endpoint"
-	serviceEnablementCustomEndpointFlag = "service-enablement-custom-endpoint"
-	serverBackupCustomEndpointFlag      = "serverbackup-custom-endpoint"
-	serverOsUpdateCustomEndpointFlag    = "server-osupdate-custom-endpoint"
-	runCommandCustomEndpointFlag        = "runcommand-custom-endpoint"
-	sfsCustomEndpointFlag               = "sfs-custom-endpoint"
-	skeCustomEndpointFlag               = "ske-custom-endpoint"
-	sqlServerFlexCustomEndpointFlag     = "sqlserverflex-custom-endpoint"
-	iaasCustomEndpointFlag              = "iaas-custom-endpoint"
-	tokenCustomEndpointFlag             = "token-custom-endpoint"
-	intakeCustomEndpointFlag            = "intake-custom-endpoint"
-	logsCustomEndpointFlag              = "logs-custom-endpoint"
-	cdnCustomEndpointFlag               = "cdn-custom-endpoint"
-	vpnCustomEndpointFlag               = "vpn-custom-endpoint"
+	authorizationCustomEndpointFlag       = "authorization-custom-endpoint"
+	albWafCustomEndpointFlag              = "alb-waf-custom-endpoint"
+	dnsCustomEndpointFlag                 = "dns-custom-endpoint"
+	edgeCustomEndpointFlag                = "edge-custom-endpoint"
+	loadBalancerCustomEndpointFlag        = "load-balancer-custom-endpoint"
+	logMeCustomEndpointFlag               = "logme-custom-endpoint"
+	mariaDBCustomEndpointFlag             = "mariadb-custom-endpoint"
+	mongoDBFlexCustomEndpointFlag         = "mongodbflex-custom-endpoint"
+	objectStorageCustomEndpointFlag       = "object-storage-custom-endpoint"
+	observabilityCustomEndpointFlag       = "observability-custom-endpoint"
+	openSearchCustomEndpointFlag          = "opensearch-custom-endpoint"
+	postgresFlexCustomEndpointFlag        = "postgresflex-custom-endpoint"
+	rabbitMQCustomEndpointFlag            = "rabbitmq-custom-endpoint"
+	redisCustomEndpointFlag               = "redis-custom-endpoint"
+	resourceManagerCustomEndpointFlag     = "resource-manager-custom-endpoint"
+	secretsManagerCustomEndpointFlag      = "secrets-manager-custom-endpoint"
+	secretsManagerVaultCustomEndpointFlag = "secrets-manager-vault-custom-endpoint"
+	kmsCustomEndpointFlag                 = "kms-custom-endpoint"
+	serviceAccountCustomEndpointFlag      = "service-account-custom-endpoint"
+	serviceEnablementCustomEndpointFlag   = "service-enablement-custom-endpoint"
+	serverBackupCustomEndpointFlag        = "serverbackup-custom-endpoint"
+	serverOsUpdateCustomEndpointFlag      = "server-osupdate-custom-endpoint"
+	runCommandCustomEndpointFlag          = "runcommand-custom-endpoint"
+	sfsCustomEndpointFlag                 = "sfs-custom-endpoint"
+	skeCustomEndpointFlag                 = "ske-custom-endpoint"
+	sqlServerFlexCustomEndpointFlag       = "sqlserverflex-custom-endpoint"
+	iaasCustomEndpointFlag                = "iaas-custom-endpoint"
+	tokenCustomEndpointFlag               = "token-custom-endpoint"
+	intakeCustomEndpointFlag              = "intake-custom-endpoint"
+	logsCustomEndpointFlag                = "logs-custom-endpoint"
+	cdnCustomEndpointFlag                 = "cdn-custom-endpoint"
+	vpnCustomEndpointFlag                 = "vpn-custom-endpoint"
 )
 
 var (
@@ -78,37 +79,38 @@ type inputModel struct {
 	IdentityProviderCustomClientID bool
 	AllowedUrlDomain               bool
 
-	AuthorizationCustomEndpoint     bool
-	AlbWafCustomEndpoint            bool
-	DNSCustomEndpoint               bool
-	EdgeCustomEndpoint              bool
-	LoadBalancerCustomEndpoint      bool
-	LogMeCustomEndpoint             bool
-	MariaDBCustomEndpoint           bool
-	MongoDBFlexCustomEndpoint       bool
-	ObjectStorageCustomEndpoint     bool
-	ObservabilityCustomEndpoint     bool
-	OpenSearchCustomEndpoint        bool
-	PostgresFlexCustomEndpoint      bool
-	RabbitMQCustomEndpoint          bool
-	RedisCustomEndpoint             bool
-	ResourceManagerCustomEndpoint   bool
-	SecretsManagerCustomEndpoint    bool
-	KMSCustomEndpoint               bool
-	ServerBackupCustomEndpoint      bool
-	ServerOsUpdateCustomEndpoint    bool
-	RunCommandCustomEndpoint        bool
-	ServiceAccountCustomEndpoint    bool
-	ServiceEnablementCustomEndpoint bool
-	SfsCustomEndpoint               bool
-	SKECustomEndpoint               bool
-	SQLServerFlexCustomEndpoint     bool
-	IaaSCustomEndpoint              bool
-	TokenCustomEndpoint             bool
-	IntakeCustomEndpoint            bool
-	LogsCustomEndpoint              bool
-	CDNCustomEndpoint               bool
-	VpnCustomEndpoint               bool
+	AuthorizationCustomEndpoint       bool
+	AlbWafCustomEndpoint              bool
+	DNSCustomEndpoint                 bool
+	EdgeCustomEndpoint                bool
+	LoadBalancerCustomEndpoint        bool
+	LogMeCustomEndpoint               bool
+	MariaDBCustomEndpoint             bool
+	MongoDBFlexCustomEndpoint         bool
+	ObjectStorageCustomEndpoint       bool
+	ObservabilityCustomEndpoint       bool
+	OpenSearchCustomEndpoint          bool
+	PostgresFlexCustomEndpoint        bool
+	RabbitMQCustomEndpoint            bool
+	RedisCustomEndpoint               bool
+	ResourceManagerCustomEndpoint     bool
+	SecretsManagerCustomEndpoint      bool
+	SecretsManagerVaultCustomEndpoint bool
+	KMSCustomEndpoint                 bool
+	ServerBackupCustomEndpoint        bool
+	ServerOsUpdateCustomEndpoint      bool
+	RunCommandCustomEndpoint          bool
+	ServiceAccountCustomEndpoint      bool
+	ServiceEnablementCustomEndpoint   bool
+	SfsCustomEndpoint                 bool
+	SKECustomEndpoint                 bool
+	SQLServerFlexCustomEndpoint       bool
+	IaaSCustomEndpoint                bool
+	TokenCustomEndpoint               bool
+	IntakeCustomEndpoint              bool
+	LogsCustomEndpoint                bool
+	CDNCustomEndpoint                 bool
+	VpnCustomEndpoint                 bool
 }
 
 func NewCmd(params *types.CmdParams) *cobra.Command {
@@ -211,6 +213,9 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 			if model.SecretsManagerCustomEndpoint {
 				viper.Set(config.SecretsManagerCustomEndpointKey, "")
 			}
+			if model.SecretsManagerVaultCustomEndpoint {
+				viper.Set(config.SecretsManagerVaultCustomEndpointKey, "")
+			}
 			if model.KMSCustomEndpoint {
 				viper.Set(config.KMSCustomEndpointKey, "")
 			}
@@ -297,6 +302,7 @@ func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().Bool(redisCustomEndpointFlag, false, "Redis API base URL. If unset, uses the default base URL")
 	cmd.Flags().Bool(resourceManagerCustomEndpointFlag, false, "Resource Manager API base URL. If unset, uses the default base URL")
 	cmd.Flags().Bool(secretsManagerCustomEndpointFlag, false, "Secrets Manager API base URL. If unset, uses the default base URL")
+	cmd.Flags().Bool(secretsManagerVaultCustomEndpointFlag, false, "Secrets Manager Vault API base URL. If unset, uses the default base URL")
 	cmd.Flags().Bool(kmsCustomEndpointFlag, false, "KMS API base URL. If unset, uses the default base URL")
 	cmd.Flags().Bool(serviceAccountCustomEndpointFlag, false, "Service Account API base URL. If unset, uses the default base URL")
 	cmd.Flags().Bool(serviceEnablementCustomEndpointFlag, false, "Service Enablement API base URL. If unset, uses the default base URL")
@@ -328,37 +334,38 @@ func parseInput(p *print.Printer, cmd *cobra.Command) *inputModel {
 		IdentityProviderCustomClientID: flags.FlagToBoolValue(p, cmd, identityProviderCustomClientIdFlag),
 		AllowedUrlDomain:               flags.FlagToBoolValue(p, cmd, allowedUrlDomainFlag),
 
-		AuthorizationCustomEndpoint:     flags.FlagToBoolValue(p, cmd, authorizationCustomEndpointFlag),
-		AlbWafCustomEndpoint:            flags.FlagToBoolValue(p, cmd, albWafCustomEndpointFlag),
-		DNSCustomEndpoint:               flags.FlagToBoolValue(p, cmd, dnsCustomEndpointFlag),
-		EdgeCustomEndpoint:              flags.FlagToBoolValue(p, cmd, edgeCustomEndpointFlag),
-		LoadBalancerCustomEndpoint:      flags.FlagToBoolValue(p, cmd, loadBalancerCustomEndpointFlag),
-		LogMeCustomEndpoint:             flags.FlagToBoolValue(p, cmd, logMeCustomEndpointFlag),
-		MariaDBCustomEndpoint:           flags.FlagToBoolValue(p, cmd, mariaDBCustomEndpointFlag),
-		MongoDBFlexCustomEndpoint:       flags.FlagToBoolValue(p, cmd, mongoDBFlexCustomEndpointFlag),
-		ObjectStorageCustomEndpoint:     flags.FlagToBoolValue(p, cmd, objectStorageCustomEndpointFlag),
-		ObservabilityCustomEndpoint:     flags.FlagToBoolValue(p, cmd, observabilityCustomEndpointFlag),
-		OpenSearchCustomEndpoint:        flags.FlagToBoolValue(p, cmd, openSearchCustomEndpointFlag),
-		PostgresFlexCustomEndpoint:      flags.FlagToBoolValue(p, cmd, postgresFlexCustomEndpointFlag),
-		RabbitMQCustomEndpoint:          flags.FlagToBoolValue(p, cmd, rabbitMQCustomEndpointFlag),
-		RedisCustomEndpoint:             flags.FlagToBoolValue(p, cmd, redisCustomEndpointFlag),
-		ResourceManagerCustomEndpoint:   flags.FlagToBoolValue(p, cmd, resourceManagerCustomEndpointFlag),
-		SecretsManagerCustomEndpoint:    flags.FlagToBoolValue(p, cmd, secretsManagerCustomEndpointFlag),
-		KMSCustomEndpoint:               flags.FlagToBoolValue(p, cmd, kmsCustomEndpointFlag),
-		ServiceAccountCustomEndpoint:    flags.FlagToBoolValue(p, cmd, serviceAccountCustomEndpointFlag),
-		ServiceEnablementCustomEndpoint: flags.FlagToBoolValue(p, cmd, serviceEnablementCustomEndpointFlag),
-		ServerBackupCustomEndpoint:      flags.FlagToBoolValue(p, cmd, serverBackupCustomEndpointFlag),
-		ServerOsUpdateCustomEndpoint:    flags.FlagToBoolValue(p, cmd, serverOsUpdateCustomEndpointFlag),
-		RunCommandCustomEndpoint:        flags.FlagToBoolValue(p, cmd, runCommandCustomEndpointFlag),
-		SKECustomEndpoint:               flags.FlagToBoolValue(p, cmd, skeCustomEndpointFlag),
-		SfsCustomEndpoint:               flags.FlagToBoolValue(p, cmd, sfsCustomEndpointFlag),
-		SQLServerFlexCustomEndpoint:     flags.FlagToBoolValue(p, cmd, sqlServerFlexCustomEndpointFlag),
-		IaaSCustomEndpoint:              flags.FlagToBoolValue(p, cmd, iaasCustomEndpointFlag),
-		TokenCustomEndpoint:             flags.FlagToBoolValue(p, cmd, tokenCustomEndpointFlag),
-		IntakeCustomEndpoint:            flags.FlagToBoolValue(p, cmd, intakeCustomEndpointFlag),
-		LogsCustomEndpoint:              flags.FlagToBoolValue(p, cmd, logsCustomEndpointFlag),
-		CDNCustomEndpoint:               flags.FlagToBoolValue(p, cmd, cdnCustomEndpointFlag),
-		VpnCustomEndpoint:               flags.FlagToBoolValue(p, cmd, vpnCustomEndpointFlag),
+		AuthorizationCustomEndpoint:       flags.FlagToBoolValue(p, cmd, authorizationCustomEndpointFlag),
+		AlbWafCustomEndpoint:              flags.FlagToBoolValue(p, cmd, albWafCustomEndpointFlag),
+		DNSCustomEndpoint:                 flags.FlagToBoolValue(p, cmd, dnsCustomEndpointFlag),
+		EdgeCustomEndpoint:                flags.FlagToBoolValue(p, cmd, edgeCustomEndpointFlag),
+		LoadBalancerCustomEndpoint:        flags.FlagToBoolValue(p, cmd, loadBalancerCustomEndpointFlag),
+		LogMeCustomEndpoint:               flags.FlagToBoolValue(p, cmd, logMeCustomEndpointFlag),
+		MariaDBCustomEndpoint:             flags.FlagToBoolValue(p, cmd, mariaDBCustomEndpointFlag),
+		MongoDBFlexCustomEndpoint:         flags.FlagToBoolValue(p, cmd, mongoDBFlexCustomEndpointFlag),
+		ObjectStorageCustomEndpoint:       flags.FlagToBoolValue(p, cmd, objectStorageCustomEndpointFlag),
+		ObservabilityCustomEndpoint:       flags.FlagToBoolValue(p, cmd, observabilityCustomEndpointFlag),
+		OpenSearchCustomEndpoint:          flags.FlagToBoolValue(p, cmd, openSearchCustomEndpointFlag),
+		PostgresFlexCustomEndpoint:        flags.FlagToBoolValue(p, cmd, postgresFlexCustomEndpointFlag),
+		RabbitMQCustomEndpoint:            flags.FlagToBoolValue(p, cmd, rabbitMQCustomEndpointFlag),
+		RedisCustomEndpoint:               flags.FlagToBoolValue(p, cmd, redisCustomEndpointFlag),
+		ResourceManagerCustomEndpoint:     flags.FlagToBoolValue(p, cmd, resourceManagerCustomEndpointFlag),
+		SecretsManagerCustomEndpoint:      flags.FlagToBoolValue(p, cmd, secretsManagerCustomEndpointFlag),
+		SecretsManagerVaultCustomEndpoint: flags.FlagToBoolValue(p, cmd, secretsManagerVaultCustomEndpointFlag),
+		KMSCustomEndpoint:                 flags.FlagToBoolValue(p, cmd, kmsCustomEndpointFlag),
+		ServiceAccountCustomEndpoint:      flags.FlagToBoolValue(p, cmd, serviceAccountCustomEndpointFlag),
+		ServiceEnablementCustomEndpoint:   flags.FlagToBoolValue(p, cmd, serviceEnablementCustomEndpointFlag),
+		ServerBackupCustomEndpoint:        flags.FlagToBoolValue(p, cmd, serverBackupCustomEndpointFlag),
+		ServerOsUpdateCustomEndpoint:      flags.FlagToBoolValue(p, cmd, serverOsUpdateCustomEndpointFlag),
+		RunCommandCustomEndpoint:          flags.FlagToBoolValue(p, cmd, runCommandCustomEndpointFlag),
+		SKECustomEndpoint:                 flags.FlagToBoolValue(p, cmd, skeCustomEndpointFlag),
+		SfsCustomEndpoint:                 flags.FlagToBoolValue(p, cmd, sfsCustomEndpointFlag),
+		SQLServerFlexCustomEndpoint:       flags.FlagToBoolValue(p, cmd, sqlServerFlexCustomEndpointFlag),
+		IaaSCustomEndpoint:                flags.FlagToBoolValue(p, cmd, iaasCustomEndpointFlag),
+		TokenCustomEndpoint:               flags.FlagToBoolValue(p, cmd, tokenCustomEndpointFlag),
+		IntakeCustomEndpoint:              flags.FlagToBoolValue(p, cmd, intakeCustomEndpointFlag),
+		LogsCustomEndpoint:                flags.FlagToBoolValue(p, cmd, logsCustomEndpointFlag),
+		CDNCustomEndpoint:                 flags.FlagToBoolValue(p, cmd, cdnCustomEndpointFlag),
+		VpnCustomEndpoint:                 flags.FlagToBoolValue(p, cmd, vpnCustomEndpointFlag),
 	}
 
 	p.DebugInputModel(model)
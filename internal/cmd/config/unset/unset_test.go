@@ -22,34 +22,35 @@ func fixtureFlagValues(mods ...func(flagValues map[string]bool)) map[string]bool
 		identityProviderCustomClientIdFlag:               true,
 		allowedUrlDomainFlag:                             true,
 
-		authorizationCustomEndpointFlag:   true,
-		albWafCustomEndpointFlag:          true,
-		dnsCustomEndpointFlag:             true,
-		edgeCustomEndpointFlag:            true,
-		loadBalancerCustomEndpointFlag:    true,
-		logMeCustomEndpointFlag:           true,
-		mariaDBCustomEndpointFlag:         true,
-		objectStorageCustomEndpointFlag:   true,
-		observabilityCustomEndpointFlag:   true,
-		openSearchCustomEndpointFlag:      true,
-		rabbitMQCustomEndpointFlag:        true,
-		redisCustomEndpointFlag:           true,
-		resourceManagerCustomEndpointFlag: true,
-		secretsManagerCustomEndpointFlag:  true,
-		kmsCustomEndpointFlag:             true,
-		serviceAccountCustomEndpointFlag:  true,
-		serverBackupCustomEndpointFlag:    true,
-		serverOsUpdateCustomEndpointFlag:  true,
-		runCommandCustomEndpointFlag:      true,
-		sfsCustomEndpointFlag:             true,
-		skeCustomEndpointFlag:             true,
-		sqlServerFlexCustomEndpointFlag:   true,
-		iaasCustomEndpointFlag:            true,
-		tokenCustomEndpointFlag:           true,
-		intakeCustomEndpointFlag:          true,
-		logsCustomEndpointFlag:            true,
-		cdnCustomEndpointFlag:             true,
-		vpnCustomEndpointFlag:             true,
+		authorizationCustomEndpointFlag:       true,
+		albWafCustomEndpointFlag:              true,
+		dnsCustomEndpointFlag:                 true,
+		edgeCustomEndpointFlag:                true,
+		loadBalancerCustomEndpointFlag:        true,
+		logMeCustomEndpointFlag:               true,
+		mariaDBCustomEndpointFlag:             true,
+		objectStorageCustomEndpointFlag:       true,
+		observabilityCustomEndpointFlag:       true,
+		openSearchCustomEndpointFlag:          true,
+		rabbitMQCustomEndpointFlag:            true,
+		redisCustomEndpointFlag:               true,
+		resourceManagerCustomEndpointFlag:     true,
+		secretsManagerCustomEndpointFlag:      true,
+		secretsManagerVaultCustomEndpointFlag: true,
+		kmsCustomEndpointFlag:                 true,
+		serviceAccountCustomEndpointFlag:      true,
+		serverBackupCustomEndpointFlag:        true,
+		serverOsUpdateCustomEndpointFlag:      true,
+		runCommandCustomEndpointFlag:          true,
+		sfsCustomEndpointFlag:                 true,
+		skeCustomEndpointFlag:                 true,
+		sqlServerFlexCustomEndpointFlag:       true,
+		iaasCustomEndpointFlag:                true,
+		tokenCustomEndpointFlag:               true,
+		intakeCustomEndpointFlag:              true,
+		logsCustomEndpointFlag:                true,
+		cdnCustomEndpointFlag:                 true,
+		vpnCustomEndpointFlag:                 true,
 	}
 	for _, mod := range mods {
 		mod(flagValues)
@@ -70,34 +71,35 @@ func fixtureInputModel(mods ...func(model *inputModel)) *inputModel {
 		IdentityProviderCustomClientID: true,
 		AllowedUrlDomain:               true,
 
-		AuthorizationCustomEndpoint:   true,
-		AlbWafCustomEndpoint:          true,
-		DNSCustomEndpoint:             true,
-		EdgeCustomEndpoint:            true,
-		LoadBalancerCustomEndpoint:    true,
-		LogMeCustomEndpoint:           true,
-		MariaDBCustomEndpoint:         true,
-		ObjectStorageCustomEndpoint:   true,
-		ObservabilityCustomEndpoint:   true,
-		OpenSearchCustomEndpoint:      true,
-		RabbitMQCustomEndpoint:        true,
-		RedisCustomEndpoint:           true,
-		ResourceManagerCustomEndpoint: true,
-		SecretsManagerCustomEndpoint:  true,
-		KMSCustomEndpoint:             true,
-		ServiceAccountCustomEndpoint:  true,
-		ServerBackupCustomEndpoint:    true,
-		ServerOsUpdateCustomEndpoint:  true,
-		RunCommandCustomEndpoint:      true,
-		SfsCustomEndpoint:             true,
-		SKECustomEndpoint:             true,
-		SQLServerFlexCustomEndpoint:   true,
-		IaaSCustomEndpoint:            true,
-		TokenCustomEndpoint:           true,
-		IntakeCustomEndpoint:          true,
-		LogsCustomEndpoint:            true,
-		CDNCustomEndpoint:             true,
-		VpnCustomEndpoint:             true,
+		AuthorizationCustomEndpoint:       true,
+		AlbWafCustomEndpoint:              true,
+		DNSCustomEndpoint:                 true,
+		EdgeCustomEndpoint:                true,
+		LoadBalancerCustomEndpoint:        true,
+		LogMeCustomEndpoint:               true,
+		MariaDBCustomEndpoint:             true,
+		ObjectStorageCustomEndpoint:       true,
+		ObservabilityCustomEndpoint:       true,
+		OpenSearchCustomEndpoint:          true,
+		RabbitMQCustomEndpoint:            true,
+		RedisCustomEndpoint:               true,
+		ResourceManagerCustomEndpoint:     true,
+		SecretsManagerCustomEndpoint:      true,
+		SecretsManagerVaultCustomEndpoint: true,
+		KMSCustomEndpoint:                 true,
+		ServiceAccountCustomEndpoint:      true,
+		ServerBackupCustomEndpoint:        true,
+		ServerOsUpdateCustomEndpoint:      true,
+		RunCommandCustomEndpoint:          true,
+		SfsCustomEndpoint:                 true,
+		SKECustomEndpoint:                 true,
+		SQLServerFlexCustomEndpoint:       true,
+		IaaSCustomEndpoint:                true,
+		TokenCustomEndpoint:               true,
+		IntakeCustomEndpoint:              true,
+		LogsCustomEndpoint:                true,
+		CDNCustomEndpoint:                 true,
+		VpnCustomEndpoint:                 true,
 	}
 	for _, mod := range mods {
 		mod(model)
@@ -148,6 +150,7 @@ func TestParseInput(t *testing.T) {
 				model.RedisCustomEndpoint = false
 				model.ResourceManagerCustomEndpoint = false
 				model.SecretsManagerCustomEndpoint = false
+				model.SecretsManagerVaultCustomEndpoint = false
 				model.KMSCustomEndpoint = false
 				model.ServiceAccountCustomEndpoint = false
 				model.ServerBackupCustomEndpoint = false
@@ -264,6 +267,16 @@ func TestParseInput(t *testing.T) {
 				model.SecretsManagerCustomEndpoint = false
 			}),
 		},
+		{
+			description: "secrets manager vault custom endpoint empty",
+			flagValues: fixtureFlagValues(func(flagValues map[string]bool) {
+				flagValues[secretsManagerVaultCustomEndpointFlag] = false
+			}),
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.SecretsManagerVaultCustomEndpoint = false
+			}),
+		},
 		{
 			description: "kms custom endpoint empty",
 			flagValues: fixtureFlagValues(func(flagValues map[string]bool) {
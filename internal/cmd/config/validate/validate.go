@@ -0,0 +1,180 @@
+package validate
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/config"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/tables"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	customEndpointKeySuffix = "_endpoint"
+)
+
+var regionRegex = regexp.MustCompile(`^[a-z]+[0-9]+$`)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+}
+
+type configProblem struct {
+	Key     string `json:"key"`
+	Value   string `json:"value"`
+	Origin  string `json:"origin"`
+	Problem string `json:"problem"`
+}
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Validates the current CLI configuration values",
+		Long: fmt.Sprintf("%s\n%s",
+			"Validates the current CLI configuration values, regardless of where they are set (environment variable, project configuration file or profile configuration).",
+			"All invalid values are reported at once, together with where they come from.",
+		),
+		Args: args.NoArgs,
+		Example: examples.Build(
+			examples.NewExample(
+				`Validate your active configuration`,
+				"$ stackit config validate"),
+			examples.NewExample(
+				`Validate your active configuration and list the problems in a json format`,
+				"$ stackit config validate --output-format json"),
+		),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			model := parseInput(params.Printer, cmd)
+
+			configData := map[string]string{}
+			for _, key := range config.ConfigKeys {
+				configData[key] = viper.GetString(key)
+			}
+
+			problems := validateConfig(configData)
+			for i := range problems {
+				problems[i].Origin = config.GetOrigin(problems[i].Key, cmd.Flags())
+			}
+
+			err := outputResult(params.Printer, model.OutputFormat, problems)
+			if err != nil {
+				return err
+			}
+			if len(problems) > 0 {
+				return fmt.Errorf("found %d invalid configuration value(s)", len(problems))
+			}
+			return nil
+		},
+	}
+	return cmd
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command) *inputModel {
+	globalFlags := globalflags.Parse(p, cmd)
+
+	return &inputModel{
+		GlobalFlagModel: globalFlags,
+	}
+}
+
+// validateConfig checks the values of all supported config keys and returns the problems found, sorted by key.
+// Empty values are not validated, since they mean that the key is not set.
+func validateConfig(configData map[string]string) []configProblem {
+	problems := []configProblem{}
+	for _, key := range utils.SortedKeys(configData) {
+		value := configData[key]
+		if value == "" || !slices.Contains(config.ConfigKeys, key) {
+			continue
+		}
+
+		err := validateValue(key, value)
+		if err != nil {
+			problems = append(problems, configProblem{
+				Key:     key,
+				Value:   value,
+				Problem: err.Error(),
+			})
+		}
+	}
+	return problems
+}
+
+func validateValue(key, value string) error {
+	switch {
+	case key == config.AsyncKey, key == config.AssumeYesKey:
+		_, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("must be a boolean")
+		}
+	case key == config.OutputFormatKey:
+		return validateEnum(value, []string{print.JSONOutputFormat, print.PrettyOutputFormat, print.NoneOutputFormat, print.YAMLOutputFormat})
+	case key == config.VerbosityKey:
+		return validateEnum(value, []string{globalflags.DebugVerbosity, globalflags.InfoVerbosity, globalflags.WarningVerbosity, globalflags.ErrorVerbosity})
+	case key == config.ProjectIdKey:
+		return utils.ValidateUUID(value)
+	case key == config.RegionKey:
+		if !regionRegex.MatchString(value) {
+			return fmt.Errorf(`must be a region name such as %q`, config.RegionDefault)
+		}
+	case key == config.SessionTimeLimitKey:
+		_, err := config.ParseSessionTimeLimit(value)
+		return err
+	case key == config.IdentityProviderCustomWellKnownConfigurationKey,
+		strings.HasSuffix(key, customEndpointKeySuffix):
+		return validateURL(value)
+	}
+	return nil
+}
+
+func validateEnum(value string, allowedValues []string) error {
+	for _, allowedValue := range allowedValues {
+		if strings.EqualFold(value, allowedValue) {
+			return nil
+		}
+	}
+	return fmt.Errorf("must be one of %q", allowedValues)
+}
+
+func validateURL(value string) error {
+	_, err := url.ParseRequestURI(value)
+	if err != nil {
+		return fmt.Errorf("parse url: %w", err)
+	}
+	return utils.ValidateURLDomain(value)
+}
+
+func outputResult(p *print.Printer, outputFormat string, problems []configProblem) error {
+	return p.OutputResult(outputFormat, problems, func() error {
+		if len(problems) == 0 {
+			p.Outputln("The configuration is valid")
+			return nil
+		}
+
+		table := tables.NewTable()
+		table.SetHeader("NAME", "VALUE", "ORIGIN", "PROBLEM")
+		for _, problem := range problems {
+			// Replace "_" with "-" to match the flags
+			key := strings.ReplaceAll(problem.Key, "_", "-")
+			table.AddRow(key, problem.Value, problem.Origin, problem.Problem)
+			table.AddSeparator()
+		}
+		err := table.Display(p)
+		if err != nil {
+			return fmt.Errorf("render table: %w", err)
+		}
+		return nil
+	})
+}
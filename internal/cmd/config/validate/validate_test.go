@@ -0,0 +1,211 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/viper"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/config"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testparams"
+)
+
+func fixtureConfigData(mods ...func(configData map[string]string)) map[string]string {
+	configData := map[string]string{
+		config.AsyncKey:                       "false",
+		config.OutputFormatKey:                "json",
+		config.ProjectIdKey:                   "0f2a2b3c-4d5e-4f60-8a7b-8c9d0e1f2a3b",
+		config.RegionKey:                      "eu01",
+		config.SessionTimeLimitKey:            "12h",
+		config.VerbosityKey:                   "info",
+		config.DNSCustomEndpointKey:           "https://dns.api.stackit.cloud",
+		config.AuthorizationCustomEndpointKey: "",
+	}
+	for _, mod := range mods {
+		mod(configData)
+	}
+	return configData
+}
+
+func TestValidateConfig(t *testing.T) {
+	viper.Set(config.AllowedUrlDomainKey, config.AllowedUrlDomainDefault)
+	defer viper.Reset()
+
+	tests := []struct {
+		description  string
+		configData   map[string]string
+		expectedKeys []string
+	}{
+		{
+			description:  "base",
+			configData:   fixtureConfigData(),
+			expectedKeys: []string{},
+		},
+		{
+			description: "session time limit of 1 day",
+			configData: fixtureConfigData(func(configData map[string]string) {
+				configData[config.SessionTimeLimitKey] = "1d"
+			}),
+			expectedKeys: []string{},
+		},
+		{
+			description: "unsupported keys are ignored",
+			configData: fixtureConfigData(func(configData map[string]string) {
+				configData["some_key"] = "invalid"
+			}),
+			expectedKeys: []string{},
+		},
+		{
+			description: "invalid project id",
+			configData: fixtureConfigData(func(configData map[string]string) {
+				configData[config.ProjectIdKey] = "invalid"
+			}),
+			expectedKeys: []string{config.ProjectIdKey},
+		},
+		{
+			description: "invalid region",
+			configData: fixtureConfigData(func(configData map[string]string) {
+				configData[config.RegionKey] = "eu 01"
+			}),
+			expectedKeys: []string{config.RegionKey},
+		},
+		{
+			description: "invalid output format",
+			configData: fixtureConfigData(func(configData map[string]string) {
+				configData[config.OutputFormatKey] = "xml"
+			}),
+			expectedKeys: []string{config.OutputFormatKey},
+		},
+		{
+			description: "invalid async",
+			configData: fixtureConfigData(func(configData map[string]string) {
+				configData[config.AsyncKey] = "maybe"
+			}),
+			expectedKeys: []string{config.AsyncKey},
+		},
+		{
+			description: "session time limit too long",
+			configData: fixtureConfigData(func(configData map[string]string) {
+				configData[config.SessionTimeLimitKey] = "25h"
+			}),
+			expectedKeys: []string{config.SessionTimeLimitKey},
+		},
+		{
+			description: "session time limit negative",
+			configData: fixtureConfigData(func(configData map[string]string) {
+				configData[config.SessionTimeLimitKey] = "-1h"
+			}),
+			expectedKeys: []string{config.SessionTimeLimitKey},
+		},
+		{
+			description: "session time limit malformed",
+			configData: fixtureConfigData(func(configData map[string]string) {
+				configData[config.SessionTimeLimitKey] = "12"
+			}),
+			expectedKeys: []string{config.SessionTimeLimitKey},
+		},
+		{
+			description: "malformed custom endpoint",
+			configData: fixtureConfigData(func(configData map[string]string) {
+				configData[config.DNSCustomEndpointKey] = "dns.api.stackit.cloud"
+			}),
+			expectedKeys: []string{config.DNSCustomEndpointKey},
+		},
+		{
+			description: "custom endpoint not in allowed domain",
+			configData: fixtureConfigData(func(configData map[string]string) {
+				configData[config.DNSCustomEndpointKey] = "https://dns.example.com"
+			}),
+			expectedKeys: []string{config.DNSCustomEndpointKey},
+		},
+		{
+			description: "invalid identity provider well-known configuration",
+			configData: fixtureConfigData(func(configData map[string]string) {
+				configData[config.IdentityProviderCustomWellKnownConfigurationKey] = "http://accounts.stackit.cloud"
+			}),
+			expectedKeys: []string{config.IdentityProviderCustomWellKnownConfigurationKey},
+		},
+		{
+			description: "multiple problems",
+			configData: fixtureConfigData(func(configData map[string]string) {
+				configData[config.ProjectIdKey] = "invalid"
+				configData[config.SessionTimeLimitKey] = "forever"
+				configData[config.SKECustomEndpointKey] = "ftp://ske.api.stackit.cloud"
+			}),
+			expectedKeys: []string{config.ProjectIdKey, config.SessionTimeLimitKey, config.SKECustomEndpointKey},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			problems := validateConfig(tt.configData)
+
+			keys := []string{}
+			for _, problem := range problems {
+				keys = append(keys, problem.Key)
+				if problem.Problem == "" {
+					t.Errorf("expected problem description for key %q", problem.Key)
+				}
+			}
+			diff := cmp.Diff(keys, tt.expectedKeys)
+			if diff != "" {
+				t.Fatalf("unexpected invalid keys: %s", diff)
+			}
+		})
+	}
+}
+
+func TestOutputResult(t *testing.T) {
+	type args struct {
+		outputFormat string
+		problems     []configProblem
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name:    "empty",
+			args:    args{},
+			wantErr: false,
+		},
+		{
+			name: "with problems",
+			args: args{
+				problems: []configProblem{
+					{
+						Key:     config.ProjectIdKey,
+						Value:   "invalid",
+						Origin:  "default",
+						Problem: "invalid UUID",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "json",
+			args: args{
+				outputFormat: "json",
+				problems: []configProblem{
+					{
+						Key:     config.ProjectIdKey,
+						Value:   "invalid",
+						Origin:  "default",
+						Problem: "invalid UUID",
+					},
+				},
+			},
+			wantErr: false,
+		},
+	}
+	params := testparams.NewTestParams()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := outputResult(params.Printer, tt.args.outputFormat, tt.args.problems); (err != nil) != tt.wantErr {
+				t.Errorf("outputResult() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package backup
+
+import (
+	"fmt"
+
+	"github.com/stackitcloud/stackit-cli/internal/cmd/dsa/backup/download"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/dsa/backup/list"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/dsa/backup/restore"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/dsa/backup/trigger"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dsa"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+// NewCmd creates the backup command of a Data Service Access service.
+// There is no "stackit dsa" command, the command is added to the command of each service.
+func NewCmd(params *types.CmdParams, service *dsa.Service) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backup",
+		Short: fmt.Sprintf("Provides functionality for %s instance backups", service.Name),
+		Long:  fmt.Sprintf("Provides functionality for %s instance backups.", service.Name),
+		Args:  args.NoArgs,
+		Run:   utils.CmdHelp,
+	}
+	addSubcommands(cmd, params, service)
+	return cmd
+}
+
+func addSubcommands(cmd *cobra.Command, params *types.CmdParams, service *dsa.Service) {
+	cmd.AddCommand(list.NewCmd(params, service))
+	cmd.AddCommand(trigger.NewCmd(params, service))
+	cmd.AddCommand(restore.NewCmd(params, service))
+	cmd.AddCommand(download.NewCmd(params, service))
+}
@@ -0,0 +1,123 @@
+package download
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dsa"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/spinner"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	backupIdArg = "BACKUP_ID"
+
+	instanceIdFlag = "instance-id"
+	outputFlag     = "output"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+
+	BackupId   int32
+	InstanceId string
+	Output     string
+}
+
+func NewCmd(params *types.CmdParams, service *dsa.Service) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("download %s", backupIdArg),
+		Short: fmt.Sprintf("Downloads a backup of %s instance", service.NameWithArticle()),
+		Long: fmt.Sprintf("%s\n%s",
+			fmt.Sprintf("Downloads a backup of %s instance to a local file.", service.NameWithArticle()),
+			"Only backups which are marked as downloadable in the backup list can be downloaded.",
+		),
+		Example: examples.Build(
+			examples.NewExample(
+				`Download backup with ID "1" of instance with ID "xxx" to the file "backup.tar.gz"`,
+				fmt.Sprintf("$ stackit %s backup download 1 --instance-id xxx --output backup.tar.gz", service.Cmd)),
+		),
+		Args: args.SingleArg(backupIdArg, nil),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			// Configure API client
+			apiClient, err := service.ConfigureClient(params.Printer, params.CliVersion)
+			if err != nil {
+				return err
+			}
+
+			instanceLabel, err := apiClient.GetInstanceName(ctx, model.ProjectId, model.Region, model.InstanceId)
+			if err != nil {
+				params.Printer.Debug(print.ErrorLevel, "get instance name: %v", err)
+				instanceLabel = model.InstanceId
+			}
+
+			// Call API
+			var written int64
+			err = spinner.Run(params.Printer, "Downloading backup", func() error {
+				file, err := apiClient.DownloadBackup(ctx, model.ProjectId, model.Region, model.InstanceId, model.BackupId)
+				if err != nil {
+					return fmt.Errorf("download backup %d of %s instance %q: %w", model.BackupId, service.Name, instanceLabel, err)
+				}
+				if file == nil {
+					return fmt.Errorf("download backup %d of %s instance %q: empty response", model.BackupId, service.Name, instanceLabel)
+				}
+				written, err = dsa.SaveFile(file, model.Output)
+				return err
+			})
+			if err != nil {
+				return err
+			}
+
+			params.Printer.Outputf("Downloaded backup %d of instance %q to %q (%d bytes)\n", model.BackupId, instanceLabel, model.Output, written)
+			return nil
+		},
+	}
+
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().Var(flags.UUIDFlag(), instanceIdFlag, "Instance ID")
+	cmd.Flags().String(outputFlag, "", "Path of the file the backup is written to")
+
+	err := flags.MarkFlagsRequired(cmd, instanceIdFlag, outputFlag)
+	cobra.CheckErr(err)
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inputModel, error) {
+	backupId, err := strconv.ParseInt(inputArgs[0], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("parse backup ID %q: %w", inputArgs[0], err)
+	}
+
+	globalFlags := globalflags.Parse(p, cmd)
+	if globalFlags.ProjectId == "" {
+		return nil, &errors.ProjectIdError{}
+	}
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		BackupId:        int32(backupId),
+		InstanceId:      flags.FlagToStringValue(p, cmd, instanceIdFlag),
+		Output:          flags.FlagToStringValue(p, cmd, outputFlag),
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
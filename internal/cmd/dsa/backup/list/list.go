@@ -0,0 +1,147 @@
+package list
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dsa"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/tables"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	instanceIdFlag = "instance-id"
+	limitFlag      = "limit"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+
+	InstanceId string
+	Limit      *int64
+}
+
+func NewCmd(params *types.CmdParams, service *dsa.Service) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: fmt.Sprintf("Lists all backups of %s instance", service.NameWithArticle()),
+		Long:  fmt.Sprintf("Lists all backups of %s instance.", service.NameWithArticle()),
+		Example: examples.Build(
+			examples.NewExample(
+				`List all backups of instance with ID "xxx"`,
+				fmt.Sprintf("$ stackit %s backup list --instance-id xxx", service.Cmd)),
+			examples.NewExample(
+				`List all backups of instance with ID "xxx" in JSON format`,
+				fmt.Sprintf("$ stackit %s backup list --instance-id xxx --output-format json", service.Cmd)),
+			examples.NewExample(
+				`List up to 10 backups of instance with ID "xxx"`,
+				fmt.Sprintf("$ stackit %s backup list --instance-id xxx --limit 10", service.Cmd)),
+		),
+		Args: args.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			// Configure API client
+			apiClient, err := service.ConfigureClient(params.Printer, params.CliVersion)
+			if err != nil {
+				return err
+			}
+
+			instanceLabel, err := apiClient.GetInstanceName(ctx, model.ProjectId, model.Region, model.InstanceId)
+			if err != nil {
+				params.Printer.Debug(print.ErrorLevel, "get instance name: %v", err)
+				instanceLabel = model.InstanceId
+			}
+
+			// Call API
+			backups, err := apiClient.ListBackups(ctx, model.ProjectId, model.Region, model.InstanceId)
+			if err != nil {
+				return fmt.Errorf("get backups for %s instance %q: %w", service.Name, instanceLabel, err)
+			}
+
+			// Truncate output
+			if model.Limit != nil && len(backups) > int(*model.Limit) {
+				backups = backups[:*model.Limit]
+			}
+
+			return outputResult(params.Printer, model.OutputFormat, instanceLabel, backups)
+		},
+	}
+
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().Var(flags.UUIDFlag(), instanceIdFlag, "Instance ID")
+	cmd.Flags().Int64(limitFlag, 0, "Maximum number of entries to list")
+
+	err := flags.MarkFlagsRequired(cmd, instanceIdFlag)
+	cobra.CheckErr(err)
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
+	globalFlags := globalflags.Parse(p, cmd)
+	if globalFlags.ProjectId == "" {
+		return nil, &errors.ProjectIdError{}
+	}
+
+	limit := flags.FlagToInt64Pointer(p, cmd, limitFlag)
+	if limit != nil && *limit < 1 {
+		return nil, &errors.FlagValidationError{
+			Flag:    limitFlag,
+			Details: "must be greater than 0",
+		}
+	}
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		InstanceId:      flags.FlagToStringValue(p, cmd, instanceIdFlag),
+		Limit:           limit,
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+func outputResult(p *print.Printer, outputFormat, instanceLabel string, backups []dsa.Backup) error {
+	return p.OutputResult(outputFormat, backups, func() error {
+		if len(backups) == 0 {
+			p.Outputf("No backups found for instance %q\n", instanceLabel)
+			return nil
+		}
+
+		table := tables.NewTable()
+		table.SetHeader("ID", "STATUS", "TRIGGERED AT", "FINISHED AT", "SIZE", "DOWNLOADABLE")
+		for i := range backups {
+			backup := backups[i]
+			table.AddRow(
+				backup.Id,
+				backup.Status,
+				utils.PtrString(backup.TriggeredAt),
+				backup.FinishedAt,
+				utils.PtrString(backup.Size),
+				utils.PtrString(backup.Downloadable),
+			)
+		}
+		err := table.Display(p)
+		if err != nil {
+			return fmt.Errorf("render table: %w", err)
+		}
+
+		return nil
+	})
+}
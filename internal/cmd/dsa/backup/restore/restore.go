@@ -0,0 +1,150 @@
+package restore
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dsa"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/spinner"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	backupIdArg = "BACKUP_ID"
+
+	instanceIdFlag = "instance-id"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+
+	BackupId   int32
+	InstanceId string
+}
+
+func NewCmd(params *types.CmdParams, service *dsa.Service) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("restore %s", backupIdArg),
+		Short: fmt.Sprintf("Restores %s instance from a backup", service.NameWithArticle()),
+		Long: fmt.Sprintf("%s\n%s",
+			fmt.Sprintf("Restores %s instance from a backup. The current data of the instance is overwritten.", service.NameWithArticle()),
+			"By default, the command waits until the restore is finished.",
+		),
+		Example: examples.Build(
+			examples.NewExample(
+				`Restore instance with ID "xxx" from backup with ID "1"`,
+				fmt.Sprintf("$ stackit %s backup restore 1 --instance-id xxx", service.Cmd)),
+			examples.NewExample(
+				`Restore instance with ID "xxx" from backup with ID "1" without waiting for the restore to finish`,
+				fmt.Sprintf("$ stackit %s backup restore 1 --instance-id xxx --async", service.Cmd)),
+		),
+		Args: args.SingleArg(backupIdArg, nil),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			// Configure API client
+			apiClient, err := service.ConfigureClient(params.Printer, params.CliVersion)
+			if err != nil {
+				return err
+			}
+
+			instanceLabel, err := apiClient.GetInstanceName(ctx, model.ProjectId, model.Region, model.InstanceId)
+			if err != nil {
+				params.Printer.Debug(print.ErrorLevel, "get instance name: %v", err)
+				instanceLabel = model.InstanceId
+			}
+
+			prompt := fmt.Sprintf("Are you sure you want to restore instance %q from backup %d? (The current data of the instance will be overwritten)", instanceLabel, model.BackupId)
+			err = params.Printer.PromptForConfirmation(prompt)
+			if err != nil {
+				return err
+			}
+
+			// Call API
+			resp, err := apiClient.TriggerRestore(ctx, model.ProjectId, model.Region, model.InstanceId, model.BackupId)
+			if err != nil {
+				return fmt.Errorf("restore %s instance %q: %w", service.Name, instanceLabel, err)
+			}
+
+			// Wait for async operation, if async mode not enabled
+			var restore *dsa.Restore
+			if !model.Async {
+				err := spinner.Run(params.Printer, "Restoring instance", func() error {
+					restore, err = dsa.RestoreWaitHandler(ctx, apiClient, model.ProjectId, model.Region, model.InstanceId, resp.Id).WaitWithContext(ctx)
+					return err
+				})
+				if err != nil {
+					return fmt.Errorf("wait for %s instance restore: %w", service.Name, err)
+				}
+			}
+
+			return outputResult(params.Printer, model.OutputFormat, model.Async, instanceLabel, model.BackupId, resp, restore)
+		},
+	}
+
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().Var(flags.UUIDFlag(), instanceIdFlag, "Instance ID")
+
+	err := flags.MarkFlagsRequired(cmd, instanceIdFlag)
+	cobra.CheckErr(err)
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inputModel, error) {
+	backupId, err := strconv.ParseInt(inputArgs[0], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("parse backup ID %q: %w", inputArgs[0], err)
+	}
+
+	globalFlags := globalflags.Parse(p, cmd)
+	if globalFlags.ProjectId == "" {
+		return nil, &errors.ProjectIdError{}
+	}
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		BackupId:        int32(backupId),
+		InstanceId:      flags.FlagToStringValue(p, cmd, instanceIdFlag),
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+// outputResult outputs the finished restore, or the triggered restore in async mode
+func outputResult(p *print.Printer, outputFormat string, async bool, instanceLabel string, backupId int32, resp *dsa.TriggeredRestore, restore *dsa.Restore) error {
+	if resp == nil {
+		return fmt.Errorf("restore response is empty")
+	}
+
+	if async {
+		return p.OutputResult(outputFormat, resp, func() error {
+			p.Outputf("Triggered restore of instance %q from backup %d. Restore ID: %d\n", instanceLabel, backupId, resp.Id)
+			return nil
+		})
+	}
+
+	if restore == nil {
+		return fmt.Errorf("restore is empty")
+	}
+	return p.OutputResult(outputFormat, restore, func() error {
+		p.Outputf("Restored instance %q from backup %d. Restore ID: %d, status: %s\n", instanceLabel, backupId, restore.Id, restore.Status)
+		return nil
+	})
+}
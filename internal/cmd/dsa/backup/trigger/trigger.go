@@ -0,0 +1,143 @@
+package trigger
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dsa"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/spinner"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	instanceIdFlag = "instance-id"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+
+	InstanceId string
+}
+
+func NewCmd(params *types.CmdParams, service *dsa.Service) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trigger",
+		Short: fmt.Sprintf("Triggers a backup of %s instance", service.NameWithArticle()),
+		Long: fmt.Sprintf("%s\n%s",
+			fmt.Sprintf("Triggers a backup of %s instance.", service.NameWithArticle()),
+			"By default, the command waits until the backup is finished. The status of the backup is shown afterwards.",
+		),
+		Example: examples.Build(
+			examples.NewExample(
+				`Trigger a backup of instance with ID "xxx"`,
+				fmt.Sprintf("$ stackit %s backup trigger --instance-id xxx", service.Cmd)),
+			examples.NewExample(
+				`Trigger a backup of instance with ID "xxx" without waiting for it to finish`,
+				fmt.Sprintf("$ stackit %s backup trigger --instance-id xxx --async", service.Cmd)),
+		),
+		Args: args.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			// Configure API client
+			apiClient, err := service.ConfigureClient(params.Printer, params.CliVersion)
+			if err != nil {
+				return err
+			}
+
+			instanceLabel, err := apiClient.GetInstanceName(ctx, model.ProjectId, model.Region, model.InstanceId)
+			if err != nil {
+				params.Printer.Debug(print.ErrorLevel, "get instance name: %v", err)
+				instanceLabel = model.InstanceId
+			}
+
+			prompt := fmt.Sprintf("Are you sure you want to trigger a backup of instance %q?", instanceLabel)
+			err = params.Printer.PromptForConfirmation(prompt)
+			if err != nil {
+				return err
+			}
+
+			// Call API
+			createdBackups, err := apiClient.CreateBackup(ctx, model.ProjectId, model.Region, model.InstanceId)
+			if err != nil {
+				return fmt.Errorf("trigger backup of %s instance %q: %w", service.Name, instanceLabel, err)
+			}
+
+			// Wait for async operation, if async mode not enabled
+			var backups []dsa.Backup
+			if !model.Async {
+				err := spinner.Run(params.Printer, "Creating backup", func() error {
+					for _, createdBackup := range createdBackups {
+						backup, err := dsa.BackupWaitHandler(ctx, apiClient, model.ProjectId, model.Region, model.InstanceId, createdBackup.Id).WaitWithContext(ctx)
+						if err != nil {
+							return err
+						}
+						backups = append(backups, *backup)
+					}
+					return nil
+				})
+				if err != nil {
+					return fmt.Errorf("wait for %s backup creation: %w", service.Name, err)
+				}
+			}
+
+			return outputResult(params.Printer, model.OutputFormat, model.Async, instanceLabel, createdBackups, backups)
+		},
+	}
+
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().Var(flags.UUIDFlag(), instanceIdFlag, "Instance ID")
+
+	err := flags.MarkFlagsRequired(cmd, instanceIdFlag)
+	cobra.CheckErr(err)
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
+	globalFlags := globalflags.Parse(p, cmd)
+	if globalFlags.ProjectId == "" {
+		return nil, &errors.ProjectIdError{}
+	}
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		InstanceId:      flags.FlagToStringValue(p, cmd, instanceIdFlag),
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+// outputResult outputs the finished backups, or the triggered backups in async mode
+func outputResult(p *print.Printer, outputFormat string, async bool, instanceLabel string, createdBackups []dsa.CreatedBackup, backups []dsa.Backup) error {
+	if async {
+		return p.OutputResult(outputFormat, createdBackups, func() error {
+			for _, createdBackup := range createdBackups {
+				p.Outputf("Triggered backup of instance %q. Backup ID: %d\n", instanceLabel, createdBackup.Id)
+			}
+			return nil
+		})
+	}
+
+	return p.OutputResult(outputFormat, backups, func() error {
+		for _, backup := range backups {
+			p.Outputf("Created backup of instance %q. Backup ID: %d, status: %s\n", instanceLabel, backup.Id, backup.Status)
+		}
+		return nil
+	})
+}
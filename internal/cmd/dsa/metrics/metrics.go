@@ -0,0 +1,127 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dsa"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/tables"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	instanceIdArg = "INSTANCE_ID"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+	InstanceId string
+}
+
+// NewCmd creates the metrics command of a Data Service Access service, which is added to the instance command of each service
+func NewCmd(params *types.CmdParams, service *dsa.Service) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("metrics %s", instanceIdArg),
+		Short: fmt.Sprintf("Shows the current metrics of %s instance", service.NameWithArticle()),
+		Long:  fmt.Sprintf("Shows the current CPU, memory and disk metrics of %s instance.", service.NameWithArticle()),
+		Args:  args.SingleArg(instanceIdArg, utils.ValidateUUID),
+		Example: examples.Build(
+			examples.NewExample(
+				`Show the metrics of instance with ID "xxx"`,
+				fmt.Sprintf("$ stackit %s instance metrics xxx", service.Cmd)),
+			examples.NewExample(
+				`Show the metrics of instance with ID "xxx" in JSON format`,
+				fmt.Sprintf("$ stackit %s instance metrics xxx --output-format json", service.Cmd)),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			// Configure API client
+			apiClient, err := service.ConfigureClient(params.Printer, params.CliVersion)
+			if err != nil {
+				return err
+			}
+
+			instanceLabel, err := apiClient.GetInstanceName(ctx, model.ProjectId, model.Region, model.InstanceId)
+			if err != nil {
+				params.Printer.Debug(print.ErrorLevel, "get instance name: %v", err)
+				instanceLabel = model.InstanceId
+			}
+
+			// Call API
+			metrics, err := apiClient.GetMetrics(ctx, model.ProjectId, model.Region, model.InstanceId)
+			if err != nil {
+				return fmt.Errorf("get metrics of %s instance %q: %w", service.Name, instanceLabel, err)
+			}
+
+			return outputResult(params.Printer, model.OutputFormat, metrics)
+		},
+	}
+	return cmd
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inputModel, error) {
+	instanceId := inputArgs[0]
+
+	globalFlags := globalflags.Parse(p, cmd)
+	if globalFlags.ProjectId == "" {
+		return nil, &errors.ProjectIdError{}
+	}
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		InstanceId:      instanceId,
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+func outputResult(p *print.Printer, outputFormat string, metrics *dsa.Metrics) error {
+	if metrics == nil {
+		return fmt.Errorf("no metrics passed")
+	}
+
+	return p.OutputResult(outputFormat, metrics, func() error {
+		table := tables.NewTable()
+		table.AddRow("CPU LOAD", fmt.Sprintf("%.2f%%", metrics.CpuLoadPercent))
+		table.AddSeparator()
+		table.AddRow("LOAD (1/5/15 MIN)", fmt.Sprintf("%.2f / %.2f / %.2f", metrics.Load1, metrics.Load5, metrics.Load15))
+		table.AddSeparator()
+		table.AddRow("MEMORY (USED/TOTAL)", usage(metrics.MemoryUsed, metrics.MemoryTotal))
+		table.AddSeparator()
+		table.AddRow("PERSISTENT DISK (USED/TOTAL)", usage(metrics.DiskPersistentUsed, metrics.DiskPersistentTotal))
+		table.AddSeparator()
+		table.AddRow("EPHEMERAL DISK (USED/TOTAL)", usage(metrics.DiskEphemeralUsed, metrics.DiskEphemeralTotal))
+		table.AddSeparator()
+		table.AddRow("PERSISTENT DISK PARACHUTE ACTIVATED", metrics.ParachuteDiskPersistentActivated)
+		table.AddSeparator()
+		table.AddRow("EPHEMERAL DISK PARACHUTE ACTIVATED", metrics.ParachuteDiskEphemeralActivated)
+		err := table.Display(p)
+		if err != nil {
+			return fmt.Errorf("render table: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// usage returns the used and total amount with the used percentage, e.g. "512 / 1024 (50.0%)"
+func usage(used, total int64) string {
+	if total == 0 {
+		return fmt.Sprintf("%d / %d", used, total)
+	}
+	return fmt.Sprintf("%d / %d (%.1f%%)", used, total, float64(used)/float64(total)*100)
+}
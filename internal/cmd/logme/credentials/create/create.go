@@ -10,6 +10,7 @@ import (
 	logme "github.com/stackitcloud/stackit-sdk-go/services/logme/v2api"
 
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dbcredentials"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
@@ -27,8 +28,9 @@ const (
 
 type inputModel struct {
 	*globalflags.GlobalFlagModel
-	InstanceId   string
-	ShowPassword bool
+	InstanceId        string
+	ShowPassword      bool
+	CredentialsFormat *dbcredentials.Options
 }
 
 func NewCmd(params *types.CmdParams) *cobra.Command {
@@ -44,6 +46,9 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 			examples.NewExample(
 				`Create credentials for a LogMe instance and show the password in the output`,
 				"$ stackit logme credentials create --instance-id xxx --show-password"),
+			examples.NewExample(
+				`Create credentials for a LogMe instance and output them as a dotenv file`,
+				"$ stackit logme credentials create --instance-id xxx --format-as dotenv --output-file .env"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.Background()
@@ -76,6 +81,10 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 				return fmt.Errorf("create LogMe credentials: %w", err)
 			}
 
+			if model.CredentialsFormat.FormatAs != "" {
+				return dbcredentials.Output(params.Printer, model.CredentialsFormat, buildCredentials(resp), "")
+			}
+
 			return outputResult(params.Printer, model.OutputFormat, model.ShowPassword, instanceLabel, resp)
 		},
 	}
@@ -86,6 +95,7 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().Var(flags.UUIDFlag(), instanceIdFlag, "Instance ID")
 	cmd.Flags().BoolP(showPasswordFlag, "s", false, "Show password in output")
+	dbcredentials.ConfigureFlags(cmd)
 
 	err := flags.MarkFlagsRequired(cmd, instanceIdFlag)
 	cobra.CheckErr(err)
@@ -97,10 +107,16 @@ func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel,
 		return nil, &errors.ProjectIdError{}
 	}
 
+	credentialsFormat, err := dbcredentials.ParseFlags(p, cmd)
+	if err != nil {
+		return nil, err
+	}
+
 	model := inputModel{
-		GlobalFlagModel: globalFlags,
-		InstanceId:      flags.FlagToStringValue(p, cmd, instanceIdFlag),
-		ShowPassword:    flags.FlagToBoolValue(p, cmd, showPasswordFlag),
+		GlobalFlagModel:   globalFlags,
+		InstanceId:        flags.FlagToStringValue(p, cmd, instanceIdFlag),
+		ShowPassword:      flags.FlagToBoolValue(p, cmd, showPasswordFlag),
+		CredentialsFormat: credentialsFormat,
 	}
 
 	p.DebugInputModel(model)
@@ -140,3 +156,17 @@ func outputResult(p *print.Printer, outputFormat string, showPassword bool, inst
 		return nil
 	})
 }
+
+func buildCredentials(resp *logme.CredentialsResponse) *dbcredentials.Credentials {
+	credentials := &dbcredentials.Credentials{
+		Engine: dbcredentials.EngineLogMe,
+		URI:    resp.Uri,
+	}
+	if resp.HasRaw() {
+		credentials.Host = resp.Raw.Credentials.Host
+		credentials.Port = utils.PtrString(resp.Raw.Credentials.Port)
+		credentials.Username = resp.Raw.Credentials.Username
+		credentials.Password = resp.Raw.Credentials.Password
+	}
+	return credentials
+}
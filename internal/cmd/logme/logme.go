@@ -1,10 +1,12 @@
 package logme
 
 import (
+	"github.com/stackitcloud/stackit-cli/internal/cmd/dsa/backup"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/logme/credentials"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/logme/instance"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/logme/plans"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/services/logme/client"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
 
@@ -27,4 +29,5 @@ func addSubcommands(cmd *cobra.Command, params *types.CmdParams) {
 	cmd.AddCommand(instance.NewCmd(params))
 	cmd.AddCommand(plans.NewCmd(params))
 	cmd.AddCommand(credentials.NewCmd(params))
+	cmd.AddCommand(backup.NewCmd(params, client.DSAService))
 }
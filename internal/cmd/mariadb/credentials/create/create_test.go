@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dbcredentials"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/testparams"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/testutils"
@@ -44,7 +45,8 @@ func fixtureInputModel(mods ...func(model *inputModel)) *inputModel {
 			Region:    testRegion,
 			Verbosity: globalflags.VerbosityDefault,
 		},
-		InstanceId: testInstanceId,
+		InstanceId:        testInstanceId,
+		CredentialsFormat: &dbcredentials.Options{},
 	}
 	for _, mod := range mods {
 		mod(model)
@@ -74,6 +76,34 @@ func TestParseInput(t *testing.T) {
 			isValid:       true,
 			expectedModel: fixtureInputModel(),
 		},
+		{
+			description: "format as",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[dbcredentials.FormatAsFlag] = "dotenv"
+				flagValues[dbcredentials.OutputFileFlag] = ".env"
+			}),
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.CredentialsFormat = &dbcredentials.Options{
+					FormatAs:   dbcredentials.FormatDotenv,
+					OutputFile: ".env",
+				}
+			}),
+		},
+		{
+			description: "format as invalid",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[dbcredentials.FormatAsFlag] = "invalid"
+			}),
+			isValid: false,
+		},
+		{
+			description: "output file without format as",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[dbcredentials.OutputFileFlag] = ".env"
+			}),
+			isValid: false,
+		},
 		{
 			description: "no values",
 			flagValues:  map[string]string{},
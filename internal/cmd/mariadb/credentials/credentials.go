@@ -5,6 +5,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/cmd/mariadb/credentials/delete"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/mariadb/credentials/describe"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/mariadb/credentials/list"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/mariadb/credentials/rotate"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
@@ -29,4 +30,5 @@ func addSubcommands(cmd *cobra.Command, params *types.CmdParams) {
 	cmd.AddCommand(delete.NewCmd(params))
 	cmd.AddCommand(describe.NewCmd(params))
 	cmd.AddCommand(list.NewCmd(params))
+	cmd.AddCommand(rotate.NewCmd(params))
 }
@@ -1,10 +1,12 @@
 package mariadb
 
 import (
+	"github.com/stackitcloud/stackit-cli/internal/cmd/dsa/backup"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/mariadb/credentials"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/mariadb/instance"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/mariadb/plans"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/services/mariadb/client"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
 
@@ -27,4 +29,5 @@ func addSubcommands(cmd *cobra.Command, params *types.CmdParams) {
 	cmd.AddCommand(instance.NewCmd(params))
 	cmd.AddCommand(plans.NewCmd(params))
 	cmd.AddCommand(credentials.NewCmd(params))
+	cmd.AddCommand(backup.NewCmd(params, client.DSAService))
 }
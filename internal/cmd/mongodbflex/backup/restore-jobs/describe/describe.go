@@ -0,0 +1,206 @@
+package describe
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+
+	"github.com/spf13/cobra"
+	mongodbflex "github.com/stackitcloud/stackit-sdk-go/services/mongodbflex/v2api"
+	"github.com/stackitcloud/stackit-sdk-go/services/mongodbflex/v2api/wait"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/services/mongodbflex/client"
+	mongoUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/mongodbflex/utils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/tables"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+)
+
+const (
+	restoreJobIdArg = "RESTORE_JOB_ID"
+
+	instanceIdFlag = "instance-id"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+
+	InstanceId   string
+	RestoreJobId string
+}
+
+type restoreJobDetails struct {
+	Id               string `json:"id"`
+	BackupId         string `json:"backupId"`
+	BackupInstanceId string `json:"backupInstanceId"`
+	Date             string `json:"date"`
+	Status           string `json:"status"`
+	Progress         string `json:"progress"`
+	Error            string `json:"error,omitempty"`
+}
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("describe %s", restoreJobIdArg),
+		Short: "Shows details of a restore job for a MongoDB Flex instance",
+		Long:  "Shows details of a restore job for a MongoDB Flex instance, including its progress and, if it failed, the error of the backup it restores.",
+		Example: examples.Build(
+			examples.NewExample(
+				`Get details of a restore job with ID "xxx" for a MongoDB Flex instance with ID "yyy"`,
+				"$ stackit mongodbflex backup restore-jobs describe xxx --instance-id yyy"),
+			examples.NewExample(
+				`Get details of a restore job with ID "xxx" for a MongoDB Flex instance with ID "yyy" in JSON format`,
+				"$ stackit mongodbflex backup restore-jobs describe xxx --instance-id yyy --output-format json"),
+		),
+		Args: args.SingleArg(restoreJobIdArg, nil),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			// Configure API client
+			apiClient, err := client.ConfigureClient(params.Printer, params.CliVersion)
+			if err != nil {
+				return err
+			}
+
+			instanceLabel, err := mongoUtils.GetInstanceName(ctx, apiClient.DefaultAPI, model.ProjectId, model.InstanceId, model.Region)
+			if err != nil {
+				params.Printer.Debug(print.ErrorLevel, "get instance name: %v", err)
+				instanceLabel = model.InstanceId
+			}
+
+			// Call API
+			req := buildRequest(ctx, model, apiClient)
+			resp, err := req.Execute()
+			if err != nil {
+				return fmt.Errorf("get restore jobs for MongoDB Flex instance %q: %w", instanceLabel, err)
+			}
+			restoreJob, err := mongoUtils.GetRestoreJob(model.RestoreJobId, resp)
+			if err != nil {
+				return fmt.Errorf("describe restore job for MongoDB Flex instance %q: %w", instanceLabel, err)
+			}
+
+			// The restore job itself carries no error message, the backup it restores may
+			var backup *mongodbflex.Backup
+			if isFailed(restoreJob) && restoreJob.BackupID != nil {
+				backupInstanceId := utils.PtrValue(restoreJob.InstanceId)
+				if backupInstanceId == "" {
+					backupInstanceId = model.InstanceId
+				}
+				backupResp, err := apiClient.DefaultAPI.GetBackup(ctx, model.ProjectId, backupInstanceId, *restoreJob.BackupID, model.Region).Execute()
+				if err != nil {
+					params.Printer.Debug(print.ErrorLevel, "get backup: %v", err)
+				} else {
+					backup = backupResp.Item
+				}
+			}
+
+			return outputResult(params.Printer, model.OutputFormat, buildDetails(restoreJob, backup, time.Now()))
+		},
+	}
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().Var(flags.UUIDFlag(), instanceIdFlag, "Instance ID")
+
+	err := flags.MarkFlagsRequired(cmd, instanceIdFlag)
+	cobra.CheckErr(err)
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inputModel, error) {
+	restoreJobId := inputArgs[0]
+
+	globalFlags := globalflags.Parse(p, cmd)
+	if globalFlags.ProjectId == "" {
+		return nil, &errors.ProjectIdError{}
+	}
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		InstanceId:      flags.FlagToStringValue(p, cmd, instanceIdFlag),
+		RestoreJobId:    restoreJobId,
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+func buildRequest(ctx context.Context, model *inputModel, apiClient *mongodbflex.APIClient) mongodbflex.ApiListRestoreJobsRequest {
+	req := apiClient.DefaultAPI.ListRestoreJobs(ctx, model.ProjectId, model.InstanceId, model.Region)
+	return req
+}
+
+func isFailed(restoreJob *mongodbflex.RestoreInstanceStatus) bool {
+	status := utils.PtrValue(restoreJob.Status)
+	return status == wait.RestoreJobBroken || status == wait.RestoreJobKilled
+}
+
+func buildDetails(restoreJob *mongodbflex.RestoreInstanceStatus, backup *mongodbflex.Backup, now time.Time) *restoreJobDetails {
+	details := &restoreJobDetails{
+		Id:               utils.PtrString(restoreJob.Id),
+		BackupId:         utils.PtrString(restoreJob.BackupID),
+		BackupInstanceId: utils.PtrString(restoreJob.InstanceId),
+		Date:             utils.PtrString(restoreJob.Date),
+		Status:           utils.PtrString(restoreJob.Status),
+	}
+
+	switch {
+	case details.Status == wait.RestoreJobFinished:
+		details.Progress = "Finished"
+	case isFailed(restoreJob):
+		details.Progress = "Failed"
+		details.Error = fmt.Sprintf("restore job ended with status %s", details.Status)
+		if backup != nil && utils.PtrValue(backup.Error) != "" {
+			details.Error = fmt.Sprintf("%s: %s", details.Error, *backup.Error)
+		}
+	default:
+		details.Progress = "Running"
+		if started, err := time.Parse(time.RFC3339, details.Date); err == nil {
+			details.Progress = fmt.Sprintf("Running for %s", now.Sub(started).Truncate(time.Second))
+		}
+	}
+	return details
+}
+
+func outputResult(p *print.Printer, outputFormat string, details *restoreJobDetails) error {
+	return p.OutputResult(outputFormat, details, func() error {
+		if details == nil {
+			return fmt.Errorf("restore job details are nil")
+		}
+		table := tables.NewTable()
+		table.AddRow("ID", details.Id)
+		table.AddSeparator()
+		table.AddRow("BACKUP ID", details.BackupId)
+		table.AddSeparator()
+		table.AddRow("BACKUP INSTANCE ID", details.BackupInstanceId)
+		table.AddSeparator()
+		table.AddRow("DATE", details.Date)
+		table.AddSeparator()
+		table.AddRow("STATUS", details.Status)
+		table.AddSeparator()
+		table.AddRow("PROGRESS", details.Progress)
+		if details.Error != "" {
+			table.AddSeparator()
+			table.AddRow("ERROR", details.Error)
+		}
+
+		err := table.Display(p)
+		if err != nil {
+			return fmt.Errorf("render table: %w", err)
+		}
+
+		return nil
+	})
+}
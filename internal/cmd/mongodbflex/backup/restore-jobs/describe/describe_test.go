@@ -0,0 +1,256 @@
+package describe
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testparams"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testutils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	mongodbflex "github.com/stackitcloud/stackit-sdk-go/services/mongodbflex/v2api"
+)
+
+const (
+	testRegion       = "eu02"
+	testRestoreJobId = "restoreJobID"
+)
+
+type testCtxKey struct{}
+
+var testCtx = context.WithValue(context.Background(), testCtxKey{}, "foo")
+var testClient = &mongodbflex.APIClient{DefaultAPI: &mongodbflex.DefaultAPIService{}}
+var testProjectId = uuid.NewString()
+var testInstanceId = uuid.NewString()
+
+func fixtureArgValues(mods ...func(argValues []string)) []string {
+	argValues := []string{
+		testRestoreJobId,
+	}
+	for _, mod := range mods {
+		mod(argValues)
+	}
+	return argValues
+}
+
+func fixtureFlagValues(mods ...func(flagValues map[string]string)) map[string]string {
+	flagValues := map[string]string{
+		globalflags.ProjectIdFlag: testProjectId,
+		globalflags.RegionFlag:    testRegion,
+		instanceIdFlag:            testInstanceId,
+	}
+	for _, mod := range mods {
+		mod(flagValues)
+	}
+	return flagValues
+}
+
+func fixtureInputModel(mods ...func(model *inputModel)) *inputModel {
+	model := &inputModel{
+		GlobalFlagModel: &globalflags.GlobalFlagModel{
+			ProjectId: testProjectId,
+			Verbosity: globalflags.VerbosityDefault,
+			Region:    testRegion,
+		},
+		InstanceId:   testInstanceId,
+		RestoreJobId: testRestoreJobId,
+	}
+	for _, mod := range mods {
+		mod(model)
+	}
+	return model
+}
+
+func fixtureRestoreJob(mods ...func(restoreJob *mongodbflex.RestoreInstanceStatus)) *mongodbflex.RestoreInstanceStatus {
+	restoreJob := &mongodbflex.RestoreInstanceStatus{
+		Id:         utils.Ptr(testRestoreJobId),
+		BackupID:   utils.Ptr("backupID"),
+		InstanceId: utils.Ptr(testInstanceId),
+		Date:       utils.Ptr("2024-05-14T12:00:00Z"),
+		Status:     utils.Ptr("IN_PROGRESS"),
+	}
+	for _, mod := range mods {
+		mod(restoreJob)
+	}
+	return restoreJob
+}
+
+func TestParseInput(t *testing.T) {
+	tests := []struct {
+		description   string
+		argValues     []string
+		flagValues    map[string]string
+		isValid       bool
+		expectedModel *inputModel
+	}{
+		{
+			description:   "base",
+			argValues:     fixtureArgValues(),
+			flagValues:    fixtureFlagValues(),
+			isValid:       true,
+			expectedModel: fixtureInputModel(),
+		},
+		{
+			description: "no values",
+			argValues:   []string{},
+			flagValues:  map[string]string{},
+			isValid:     false,
+		},
+		{
+			description: "no arg values",
+			argValues:   []string{},
+			flagValues:  fixtureFlagValues(),
+			isValid:     false,
+		},
+		{
+			description: "project id missing",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, globalflags.ProjectIdFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "instance id missing",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, instanceIdFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "instance id invalid",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[instanceIdFlag] = "invalid-uuid"
+			}),
+			isValid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			testutils.TestParseInput(t, NewCmd, parseInput, tt.expectedModel, tt.argValues, tt.flagValues, tt.isValid)
+		})
+	}
+}
+
+func TestBuildRequest(t *testing.T) {
+	expectedRequest := testClient.DefaultAPI.ListRestoreJobs(testCtx, testProjectId, testInstanceId, testRegion)
+
+	request := buildRequest(testCtx, fixtureInputModel(), testClient)
+
+	diff := cmp.Diff(request, expectedRequest,
+		cmp.AllowUnexported(expectedRequest),
+		cmpopts.EquateComparable(testCtx, mongodbflex.DefaultAPIService{}),
+	)
+	if diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+}
+
+func TestBuildDetails(t *testing.T) {
+	now := time.Date(2024, 5, 14, 12, 5, 30, 0, time.UTC)
+
+	tests := []struct {
+		description      string
+		restoreJob       *mongodbflex.RestoreInstanceStatus
+		backup           *mongodbflex.Backup
+		expectedProgress string
+		expectedError    string
+	}{
+		{
+			description:      "running",
+			restoreJob:       fixtureRestoreJob(),
+			expectedProgress: "Running for 5m30s",
+		},
+		{
+			description: "running without date",
+			restoreJob: fixtureRestoreJob(func(restoreJob *mongodbflex.RestoreInstanceStatus) {
+				restoreJob.Date = nil
+			}),
+			expectedProgress: "Running",
+		},
+		{
+			description: "finished",
+			restoreJob: fixtureRestoreJob(func(restoreJob *mongodbflex.RestoreInstanceStatus) {
+				restoreJob.Status = utils.Ptr("FINISHED")
+			}),
+			expectedProgress: "Finished",
+		},
+		{
+			description: "broken",
+			restoreJob: fixtureRestoreJob(func(restoreJob *mongodbflex.RestoreInstanceStatus) {
+				restoreJob.Status = utils.Ptr("BROKEN")
+			}),
+			expectedProgress: "Failed",
+			expectedError:    "restore job ended with status BROKEN",
+		},
+		{
+			description: "killed with backup error",
+			restoreJob: fixtureRestoreJob(func(restoreJob *mongodbflex.RestoreInstanceStatus) {
+				restoreJob.Status = utils.Ptr("KILLED")
+			}),
+			backup:           &mongodbflex.Backup{Error: utils.Ptr("snapshot corrupted")},
+			expectedProgress: "Failed",
+			expectedError:    "restore job ended with status KILLED: snapshot corrupted",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			details := buildDetails(tt.restoreJob, tt.backup, now)
+			if details.Progress != tt.expectedProgress {
+				t.Fatalf("expected progress %q, got %q", tt.expectedProgress, details.Progress)
+			}
+			if details.Error != tt.expectedError {
+				t.Fatalf("expected error %q, got %q", tt.expectedError, details.Error)
+			}
+		})
+	}
+}
+
+func TestOutputResult(t *testing.T) {
+	type args struct {
+		outputFormat string
+		details      *restoreJobDetails
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name:    "empty",
+			args:    args{},
+			wantErr: true,
+		},
+		{
+			name: "set details",
+			args: args{
+				details: &restoreJobDetails{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "set details with error",
+			args: args{
+				details: &restoreJobDetails{Error: "error"},
+			},
+			wantErr: false,
+		},
+	}
+	params := testparams.NewTestParams()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := outputResult(params.Printer, tt.args.outputFormat, tt.args.details); (err != nil) != tt.wantErr {
+				t.Errorf("outputResult() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
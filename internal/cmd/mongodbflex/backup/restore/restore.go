@@ -3,6 +3,8 @@ package restore
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
 
@@ -15,6 +17,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/services/mongodbflex/client"
 	mongodbUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/mongodbflex/utils"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/spinner"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
 
 	"github.com/spf13/cobra"
 	mongodbflex "github.com/stackitcloud/stackit-sdk-go/services/mongodbflex/v2api"
@@ -26,6 +29,8 @@ const (
 	backupInstanceIdFlag = "backup-instance-id"
 	backupIdFlag         = "backup-id"
 	timestampFlag        = "timestamp"
+
+	pointInTimeWindowOption = "pointInTimeWindowHours"
 )
 
 type inputModel struct {
@@ -40,10 +45,11 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "restore",
 		Short: "Restores a MongoDB Flex instance from a backup",
-		Long: fmt.Sprintf("%s\n%s\n%s",
+		Long: fmt.Sprintf("%s\n%s\n%s\n%s",
 			"Restores a MongoDB Flex instance from a backup of an instance or clones a MongoDB Flex instance from a point-in-time backup.",
-			"The backup can be specified by a backup ID or a timestamp.",
+			"The backup can be specified by a backup ID or a timestamp. The timestamp must be within the point-in-time window of the instance and after its oldest backup.",
 			"You can specify the instance to which the backup will be applied. If not specified, the backup will be applied to the same instance from which it was taken.",
+			"Unless the --async flag is set, the command waits until the restore job has finished.",
 		),
 		Args: args.NoArgs,
 		Example: examples.Build(
@@ -77,6 +83,14 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 				instanceLabel = model.ProjectId
 			}
 
+			// Check the timestamp before anything is submitted, the API only fails once the clone is running
+			if model.Timestamp != "" {
+				err = validateTimestamp(ctx, model, apiClient.DefaultAPI, time.Now())
+				if err != nil {
+					return err
+				}
+			}
+
 			prompt := fmt.Sprintf("Are you sure you want to restore MongoDB Flex instance %q?", instanceLabel)
 			err = params.Printer.PromptForConfirmation(prompt)
 			if err != nil {
@@ -93,14 +107,25 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 			// If backupId is provided, restore the instance from the backup with the backupId
 			if isRestoreOperation {
 				req := buildRestoreRequest(ctx, model, apiClient)
-				_, err = req.Execute()
+				resp, err := req.Execute()
 				if err != nil {
 					return fmt.Errorf("restore MongoDB Flex instance: %w", err)
 				}
+				if resp == nil {
+					return fmt.Errorf("restore MongoDB Flex instance: empty response")
+				}
+				restoreJobId := ""
+				if resp.Item != nil {
+					restoreJobId = utils.PtrValue(resp.Item.Id)
+				}
 
 				if !model.Async {
 					err := spinner.Run(params.Printer, "Restoring instance", func() error {
-						_, err = wait.RestoreInstanceWaitHandler(ctx, apiClient.DefaultAPI, model.ProjectId, model.InstanceId, model.BackupId, model.Region).WaitWithContext(ctx)
+						if restoreJobId == "" {
+							_, err = wait.RestoreInstanceWaitHandler(ctx, apiClient.DefaultAPI, model.ProjectId, model.InstanceId, model.BackupId, model.Region).WaitWithContext(ctx)
+							return err
+						}
+						_, err = mongodbUtils.RestoreJobWaitHandler(ctx, apiClient.DefaultAPI, model.ProjectId, model.InstanceId, restoreJobId, model.Region).WaitWithContext(ctx)
 						return err
 					})
 					if err != nil {
@@ -113,6 +138,9 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 					operationState = "Triggered restore of"
 				}
 				params.Printer.Outputf("%s instance %q with backup %q\n", operationState, model.InstanceId, model.BackupId)
+				if model.Async && restoreJobId != "" {
+					params.Printer.Outputf("Track the restore job by running:\n  $ stackit mongodbflex backup restore-jobs describe %s --instance-id %s\n", restoreJobId, model.InstanceId)
+				}
 				return nil
 			}
 
@@ -169,6 +197,14 @@ func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel,
 			Flags: []string{backupIdFlag, timestampFlag},
 		}
 	}
+	if timestamp != "" {
+		if _, err := time.Parse(time.RFC3339, timestamp); err != nil {
+			return nil, &cliErr.FlagValidationError{
+				Flag:    timestampFlag,
+				Details: fmt.Sprintf("must be a date-time with the RFC3339 layout format, e.g. 2024-01-01T00:00:00Z: %v", err),
+			}
+		}
+	}
 
 	model := inputModel{
 		GlobalFlagModel:  globalFlags,
@@ -203,3 +239,65 @@ func buildCloneRequest(ctx context.Context, model *inputModel, apiClient *mongod
 func getIsRestoreOperation(backupId, timestamp string) bool {
 	return backupId != "" && timestamp == ""
 }
+
+// getBackupWindow returns the time range an instance can be restored to.
+// It starts at the oldest backup, but not before the point-in-time window of the instance, and ends now
+func getBackupWindow(instance *mongodbflex.Instance, backups []mongodbflex.Backup, now time.Time) (start, end time.Time, err error) {
+	for _, backup := range backups {
+		if backup.StartTime == nil {
+			continue
+		}
+		startTime, err := time.Parse(time.RFC3339, *backup.StartTime)
+		if err != nil {
+			return start, end, fmt.Errorf("parse start time of backup %q: %w", utils.PtrString(backup.Id), err)
+		}
+		if start.IsZero() || startTime.Before(start) {
+			start = startTime
+		}
+	}
+	if start.IsZero() {
+		return start, end, fmt.Errorf("no backups found")
+	}
+
+	if instance != nil && instance.Options != nil {
+		if hours, ok := (*instance.Options)[pointInTimeWindowOption]; ok {
+			windowHours, err := strconv.Atoi(hours)
+			if err != nil {
+				return start, end, fmt.Errorf("parse point-in-time window %q: %w", hours, err)
+			}
+			windowStart := now.Add(-time.Duration(windowHours) * time.Hour)
+			if windowStart.After(start) {
+				start = windowStart
+			}
+		}
+	}
+	return start, now, nil
+}
+
+func validateTimestamp(ctx context.Context, model *inputModel, apiClient mongodbflex.DefaultAPI, now time.Time) error {
+	timestamp, err := time.Parse(time.RFC3339, model.Timestamp)
+	if err != nil {
+		return fmt.Errorf("parse timestamp: %w", err)
+	}
+
+	instance, err := apiClient.GetInstance(ctx, model.ProjectId, model.InstanceId, model.Region).Execute()
+	if err != nil {
+		return fmt.Errorf("get MongoDB Flex instance: %w", err)
+	}
+	backups, err := apiClient.ListBackups(ctx, model.ProjectId, model.InstanceId, model.Region).Execute()
+	if err != nil {
+		return fmt.Errorf("get MongoDB Flex backups: %w", err)
+	}
+
+	start, end, err := getBackupWindow(instance.Item, backups.Items, now)
+	if err != nil {
+		return fmt.Errorf("get backup window of MongoDB Flex instance: %w", err)
+	}
+	if timestamp.Before(start) || timestamp.After(end) {
+		return &cliErr.FlagValidationError{
+			Flag:    timestampFlag,
+			Details: fmt.Sprintf("%s is outside of the backup window, the instance can be restored to a timestamp between %s and %s", model.Timestamp, start.Format(time.RFC3339), end.Format(time.RFC3339)),
+		}
+	}
+	return nil
+}
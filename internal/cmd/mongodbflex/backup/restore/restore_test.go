@@ -2,7 +2,9 @@ package restore
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/testutils"
@@ -160,6 +162,26 @@ func TestParseInput(t *testing.T) {
 			}),
 			isValid: false,
 		},
+		{
+			description: "timestamp",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, backupIdFlag)
+				flagValues[timestampFlag] = testTimestamp
+			}),
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.BackupId = ""
+				model.Timestamp = testTimestamp
+			}),
+		},
+		{
+			description: "timestamp invalid",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, backupIdFlag)
+				flagValues[timestampFlag] = "2021-01-01 00:00:00"
+			}),
+			isValid: false,
+		},
 		{
 			description: "timestamp and backup id missing",
 			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
@@ -263,3 +285,168 @@ func TestGetIsRestoreOperation(t *testing.T) {
 		})
 	}
 }
+
+func TestGetBackupWindow(t *testing.T) {
+	now := time.Date(2024, 5, 14, 12, 0, 0, 0, time.UTC)
+	backups := []mongodbflex.Backup{
+		{Id: utils.Ptr("new"), StartTime: utils.Ptr("2024-05-13T00:00:00Z")},
+		{Id: utils.Ptr("old"), StartTime: utils.Ptr("2024-05-10T00:00:00Z")},
+	}
+
+	tests := []struct {
+		description   string
+		instance      *mongodbflex.Instance
+		backups       []mongodbflex.Backup
+		isValid       bool
+		expectedStart time.Time
+	}{
+		{
+			description:   "oldest backup",
+			instance:      &mongodbflex.Instance{},
+			backups:       backups,
+			isValid:       true,
+			expectedStart: time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			description: "point-in-time window",
+			instance: &mongodbflex.Instance{
+				Options: &map[string]string{pointInTimeWindowOption: "24"},
+			},
+			backups:       backups,
+			isValid:       true,
+			expectedStart: time.Date(2024, 5, 13, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			description: "point-in-time window before oldest backup",
+			instance: &mongodbflex.Instance{
+				Options: &map[string]string{pointInTimeWindowOption: "240"},
+			},
+			backups:       backups,
+			isValid:       true,
+			expectedStart: time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			description: "invalid point-in-time window",
+			instance: &mongodbflex.Instance{
+				Options: &map[string]string{pointInTimeWindowOption: "foo"},
+			},
+			backups: backups,
+			isValid: false,
+		},
+		{
+			description: "no backups",
+			instance:    &mongodbflex.Instance{},
+			backups:     []mongodbflex.Backup{},
+			isValid:     false,
+		},
+		{
+			description: "invalid backup start time",
+			instance:    &mongodbflex.Instance{},
+			backups:     []mongodbflex.Backup{{StartTime: utils.Ptr("yesterday")}},
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			start, end, err := getBackupWindow(tt.instance, tt.backups, now)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("did not fail on invalid input")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			if !start.Equal(tt.expectedStart) {
+				t.Fatalf("expected start %s, got %s", tt.expectedStart, start)
+			}
+			if !end.Equal(now) {
+				t.Fatalf("expected end %s, got %s", now, end)
+			}
+		})
+	}
+}
+
+func TestValidateTimestamp(t *testing.T) {
+	now := time.Date(2024, 5, 14, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description     string
+		timestamp       string
+		getInstanceFail bool
+		listBackupsFail bool
+		isValid         bool
+	}{
+		{
+			description: "within window",
+			timestamp:   "2024-05-14T00:00:00Z",
+			isValid:     true,
+		},
+		{
+			description: "before window",
+			timestamp:   "2024-05-13T00:00:00Z",
+			isValid:     false,
+		},
+		{
+			description: "in the future",
+			timestamp:   "2024-05-15T00:00:00Z",
+			isValid:     false,
+		},
+		{
+			description:     "get instance fails",
+			timestamp:       "2024-05-14T00:00:00Z",
+			getInstanceFail: true,
+			isValid:         false,
+		},
+		{
+			description:     "list backups fails",
+			timestamp:       "2024-05-14T00:00:00Z",
+			listBackupsFail: true,
+			isValid:         false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			apiClient := mongodbflex.DefaultAPIServiceMock{
+				GetInstanceExecuteMock: utils.Ptr(func(_ mongodbflex.ApiGetInstanceRequest) (*mongodbflex.InstanceResponse, error) {
+					if tt.getInstanceFail {
+						return nil, fmt.Errorf("could not get instance")
+					}
+					return &mongodbflex.InstanceResponse{
+						Item: &mongodbflex.Instance{
+							Options: &map[string]string{pointInTimeWindowOption: "24"},
+						},
+					}, nil
+				}),
+				ListBackupsExecuteMock: utils.Ptr(func(_ mongodbflex.ApiListBackupsRequest) (*mongodbflex.ListBackupsResponse, error) {
+					if tt.listBackupsFail {
+						return nil, fmt.Errorf("could not list backups")
+					}
+					return &mongodbflex.ListBackupsResponse{
+						Items: []mongodbflex.Backup{
+							{StartTime: utils.Ptr("2024-05-12T00:00:00Z")},
+						},
+					}, nil
+				}),
+			}
+			model := fixtureInputModel(func(model *inputModel) {
+				model.BackupId = ""
+				model.Timestamp = tt.timestamp
+			})
+
+			err := validateTimestamp(testCtx, model, apiClient, now)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("did not fail on invalid input")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+		})
+	}
+}
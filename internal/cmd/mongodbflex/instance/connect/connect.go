@@ -0,0 +1,186 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	mongodbflex "github.com/stackitcloud/stackit-sdk-go/services/mongodbflex/v2api"
+	"github.com/stackitcloud/stackit-sdk-go/services/mongodbflex/v2api/wait"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dbconnect"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dbcredentials"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/services/mongodbflex/client"
+	mongodbflexUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/mongodbflex/utils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+)
+
+const (
+	instanceIdArg = "INSTANCE_ID"
+
+	userIdFlag = "user-id"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+
+	InstanceId     string
+	UserId         string
+	ConnectOptions *dbconnect.Options
+}
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("connect %s", instanceIdArg),
+		Short: "Connects to a MongoDB Flex instance",
+		Long: fmt.Sprintf("%s\n%s\n%s",
+			"Connects to a MongoDB Flex instance by temporarily adding the public IP address of the caller to the ACL of the instance.",
+			`If "mongosh" is installed, it is launched with the connection URI of the user and the ACL entry is removed when it exits. Otherwise the connection URI is printed and the ACL entry is removed on Ctrl-C.`,
+			"The public IP address is detected using api.ipify.org, unless it is set with the --ip flag.",
+		),
+		Args: args.SingleArg(instanceIdArg, utils.ValidateUUID),
+		Example: examples.Build(
+			examples.NewExample(
+				`Connect to a MongoDB Flex instance with ID "xxx" as user with ID "yyy"`,
+				"$ stackit mongodbflex instance connect xxx --user-id yyy"),
+			examples.NewExample(
+				`Allow IP address "1.2.3.4" to connect to a MongoDB Flex instance with ID "xxx" and print the connection URI of user with ID "yyy"`,
+				"$ stackit mongodbflex instance connect xxx --user-id yyy --ip 1.2.3.4 --no-launch"),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			// Configure API client
+			apiClient, err := client.ConfigureClient(params.Printer, params.CliVersion)
+			if err != nil {
+				return err
+			}
+
+			instanceLabel, err := mongodbflexUtils.GetInstanceName(ctx, apiClient.DefaultAPI, model.ProjectId, model.InstanceId, model.Region)
+			if err != nil {
+				params.Printer.Debug(print.ErrorLevel, "get instance name: %v", err)
+				instanceLabel = model.InstanceId
+			}
+
+			user, err := apiClient.DefaultAPI.GetUser(ctx, model.ProjectId, model.InstanceId, model.UserId, model.Region).Execute()
+			if err != nil {
+				return fmt.Errorf("get MongoDB Flex user: %w", err)
+			}
+
+			ip := model.ConnectOptions.IP
+			if ip == "" {
+				ip, err = dbconnect.GetPublicIP(ctx)
+				if err != nil {
+					return fmt.Errorf("detect public IP address, set it with the --%s flag instead: %w", dbconnect.IPFlag, err)
+				}
+			}
+			aclEntry := dbconnect.ACLEntry(ip)
+
+			prompt := fmt.Sprintf("Are you sure you want to temporarily add %q to the ACL of instance %q?", aclEntry, instanceLabel)
+			err = params.Printer.PromptForConfirmation(prompt)
+			if err != nil {
+				return err
+			}
+
+			acl := &instanceACL{
+				apiClient:  apiClient.DefaultAPI,
+				projectId:  model.ProjectId,
+				instanceId: model.InstanceId,
+				region:     model.Region,
+			}
+			return dbconnect.Run(ctx, params.Printer, acl, buildTarget(user), aclEntry, model.ConnectOptions)
+		},
+	}
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().Var(flags.UUIDFlag(), userIdFlag, "ID of the user to connect as")
+	dbconnect.ConfigureFlags(cmd)
+
+	err := flags.MarkFlagsRequired(cmd, userIdFlag)
+	cobra.CheckErr(err)
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inputModel, error) {
+	instanceId := inputArgs[0]
+
+	globalFlags := globalflags.Parse(p, cmd)
+	if globalFlags.ProjectId == "" {
+		return nil, &errors.ProjectIdError{}
+	}
+
+	connectOptions, err := dbconnect.ParseFlags(p, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		InstanceId:      instanceId,
+		UserId:          flags.FlagToStringValue(p, cmd, userIdFlag),
+		ConnectOptions:  connectOptions,
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+func buildTarget(user *mongodbflex.GetUserResponse) *dbconnect.Target {
+	if user == nil || user.Item == nil {
+		return &dbconnect.Target{Engine: dbcredentials.EngineMongoDB}
+	}
+	return &dbconnect.Target{
+		Engine:   dbcredentials.EngineMongoDB,
+		Host:     utils.PtrString(user.Item.Host),
+		Port:     utils.PtrString(user.Item.Port),
+		Username: utils.PtrString(user.Item.Username),
+		Database: utils.PtrString(user.Item.Database),
+	}
+}
+
+// instanceACL reads and updates the ACL of a MongoDB Flex instance
+type instanceACL struct {
+	apiClient  mongodbflex.DefaultAPI
+	projectId  string
+	instanceId string
+	region     string
+}
+
+func (a *instanceACL) Get(ctx context.Context) ([]string, error) {
+	resp, err := a.apiClient.GetInstance(ctx, a.projectId, a.instanceId, a.region).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get MongoDB Flex instance: %w", err)
+	}
+	if resp.Item == nil || resp.Item.Acl == nil {
+		return nil, nil
+	}
+	return resp.Item.Acl.Items, nil
+}
+
+func (a *instanceACL) Update(ctx context.Context, acl []string) error {
+	_, err := a.apiClient.PartialUpdateInstance(ctx, a.projectId, a.instanceId, a.region).
+		PartialUpdateInstancePayload(mongodbflex.PartialUpdateInstancePayload{
+			Acl: &mongodbflex.ACL{Items: acl},
+		}).Execute()
+	if err != nil {
+		return fmt.Errorf("update MongoDB Flex instance: %w", err)
+	}
+	_, err = wait.PartialUpdateInstanceWaitHandler(ctx, a.apiClient, a.projectId, a.instanceId, a.region).WaitWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("wait for MongoDB Flex instance update: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,267 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dbconnect"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dbcredentials"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testutils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	mongodbflex "github.com/stackitcloud/stackit-sdk-go/services/mongodbflex/v2api"
+)
+
+const (
+	testRegion = "eu02"
+)
+
+type testCtxKey struct{}
+
+var testCtx = context.WithValue(context.Background(), testCtxKey{}, "foo")
+var testProjectId = uuid.NewString()
+var testInstanceId = uuid.NewString()
+var testUserId = uuid.NewString()
+
+func fixtureArgValues(mods ...func(argValues []string)) []string {
+	argValues := []string{
+		testInstanceId,
+	}
+	for _, mod := range mods {
+		mod(argValues)
+	}
+	return argValues
+}
+
+func fixtureFlagValues(mods ...func(flagValues map[string]string)) map[string]string {
+	flagValues := map[string]string{
+		globalflags.ProjectIdFlag: testProjectId,
+		globalflags.RegionFlag:    testRegion,
+		userIdFlag:                testUserId,
+	}
+	for _, mod := range mods {
+		mod(flagValues)
+	}
+	return flagValues
+}
+
+func fixtureInputModel(mods ...func(model *inputModel)) *inputModel {
+	model := &inputModel{
+		GlobalFlagModel: &globalflags.GlobalFlagModel{
+			ProjectId: testProjectId,
+			Region:    testRegion,
+			Verbosity: globalflags.VerbosityDefault,
+		},
+		InstanceId:     testInstanceId,
+		UserId:         testUserId,
+		ConnectOptions: &dbconnect.Options{},
+	}
+	for _, mod := range mods {
+		mod(model)
+	}
+	return model
+}
+
+func TestParseInput(t *testing.T) {
+	tests := []struct {
+		description   string
+		argValues     []string
+		flagValues    map[string]string
+		isValid       bool
+		expectedModel *inputModel
+	}{
+		{
+			description:   "base",
+			argValues:     fixtureArgValues(),
+			flagValues:    fixtureFlagValues(),
+			isValid:       true,
+			expectedModel: fixtureInputModel(),
+		},
+		{
+			description: "with connect options",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[dbconnect.IPFlag] = "1.2.3.4"
+				flagValues[dbconnect.NoLaunchFlag] = "true"
+			}),
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.ConnectOptions = &dbconnect.Options{IP: "1.2.3.4", NoLaunch: true}
+			}),
+		},
+		{
+			description: "no values",
+			argValues:   []string{},
+			flagValues:  map[string]string{},
+			isValid:     false,
+		},
+		{
+			description: "no arg values",
+			argValues:   []string{},
+			flagValues:  fixtureFlagValues(),
+			isValid:     false,
+		},
+		{
+			description: "project id missing",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, globalflags.ProjectIdFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "instance id invalid",
+			argValues:   []string{"invalid-uuid"},
+			flagValues:  fixtureFlagValues(),
+			isValid:     false,
+		},
+		{
+			description: "user id missing",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, userIdFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "user id invalid",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[userIdFlag] = "invalid-uuid"
+			}),
+			isValid: false,
+		},
+		{
+			description: "ip invalid",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[dbconnect.IPFlag] = "1.2.3"
+			}),
+			isValid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			testutils.TestParseInput(t, NewCmd, parseInput, tt.expectedModel, tt.argValues, tt.flagValues, tt.isValid)
+		})
+	}
+}
+
+func TestBuildTarget(t *testing.T) {
+	tests := []struct {
+		description string
+		user        *mongodbflex.GetUserResponse
+		expected    *dbconnect.Target
+	}{
+		{
+			description: "base",
+			user: &mongodbflex.GetUserResponse{
+				Item: &mongodbflex.InstanceResponseUser{
+					Host:     utils.Ptr("host"),
+					Port:     utils.Ptr(int64(27017)),
+					Username: utils.Ptr("user"),
+					Database: utils.Ptr("db"),
+				},
+			},
+			expected: &dbconnect.Target{
+				Engine:   dbcredentials.EngineMongoDB,
+				Host:     "host",
+				Port:     "27017",
+				Username: "user",
+				Database: "db",
+			},
+		},
+		{
+			description: "empty response",
+			user:        &mongodbflex.GetUserResponse{},
+			expected:    &dbconnect.Target{Engine: dbcredentials.EngineMongoDB},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			target := buildTarget(tt.user)
+			diff := cmp.Diff(target, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestInstanceACL(t *testing.T) {
+	tests := []struct {
+		description string
+		getFails    bool
+		updateFails bool
+		isValid     bool
+	}{
+		{
+			description: "base",
+			isValid:     true,
+		},
+		{
+			description: "get instance fails",
+			getFails:    true,
+			isValid:     false,
+		},
+		{
+			description: "update instance fails",
+			updateFails: true,
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			apiClient := mongodbflex.DefaultAPIServiceMock{
+				GetInstanceExecuteMock: utils.Ptr(func(_ mongodbflex.ApiGetInstanceRequest) (*mongodbflex.InstanceResponse, error) {
+					if tt.getFails {
+						return nil, fmt.Errorf("get instance failed")
+					}
+					return &mongodbflex.InstanceResponse{
+						Item: &mongodbflex.Instance{
+							Id:     utils.Ptr(testInstanceId),
+							Status: utils.Ptr(mongodbflex.INSTANCESTATUS_READY),
+							Acl:    &mongodbflex.ACL{Items: []string{"10.0.0.0/24"}},
+						},
+					}, nil
+				}),
+				PartialUpdateInstanceExecuteMock: utils.Ptr(func(_ mongodbflex.ApiPartialUpdateInstanceRequest) (*mongodbflex.UpdateInstanceResponse, error) {
+					if tt.updateFails {
+						return nil, fmt.Errorf("update instance failed")
+					}
+					return &mongodbflex.UpdateInstanceResponse{}, nil
+				}),
+			}
+			acl := &instanceACL{
+				apiClient:  apiClient,
+				projectId:  testProjectId,
+				instanceId: testInstanceId,
+				region:     testRegion,
+			}
+
+			current, err := acl.Get(testCtx)
+			if err == nil {
+				if diff := cmp.Diff(current, []string{"10.0.0.0/24"}); diff != "" {
+					t.Fatalf("ACL does not match: %s", diff)
+				}
+				err = acl.Update(testCtx, append(current, "1.2.3.4/32"))
+			}
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("should have failed")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("should not have failed: %v", err)
+			}
+		})
+	}
+}
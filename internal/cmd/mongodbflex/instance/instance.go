@@ -1,6 +1,7 @@
 package instance
 
 import (
+	"github.com/stackitcloud/stackit-cli/internal/cmd/mongodbflex/instance/connect"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/mongodbflex/instance/create"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/mongodbflex/instance/delete"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/mongodbflex/instance/describe"
@@ -26,6 +27,7 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 }
 
 func addSubcommands(cmd *cobra.Command, params *types.CmdParams) {
+	cmd.AddCommand(connect.NewCmd(params))
 	cmd.AddCommand(create.NewCmd(params))
 	cmd.AddCommand(delete.NewCmd(params))
 	cmd.AddCommand(describe.NewCmd(params))
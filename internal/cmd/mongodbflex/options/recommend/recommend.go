@@ -0,0 +1,147 @@
+package recommend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	mongodbflex "github.com/stackitcloud/stackit-sdk-go/services/mongodbflex/v2api"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flexsizing"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/services/mongodbflex/client"
+	mongodbflexUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/mongodbflex/utils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+)
+
+const service = "mongodbflex"
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+
+	Query *flexsizing.Query
+}
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recommend",
+		Short: "Recommends MongoDB Flex flavors for the given resources",
+		Long: fmt.Sprintf("%s\n%s\n%s",
+			"Recommends MongoDB Flex flavors for the given resources, joining the flavors with their storage ranges, storage classes and the supported versions in one table.",
+			"The smallest matching flavor is marked.",
+			fmt.Sprintf("If --%s is set, the planned flavor, storage size and storage class are validated instead.", flexsizing.FlavorIdFlag),
+		),
+		Args: args.NoArgs,
+		Example: examples.Build(
+			examples.NewExample(
+				`Recommend MongoDB Flex flavors with at least 4 CPUs and 16 GB RAM, supporting 100 GB storage`,
+				"$ stackit mongodbflex options recommend --cpu 4 --ram 16 --storage 100"),
+			examples.NewExample(
+				`Compare all MongoDB Flex flavors`,
+				"$ stackit mongodbflex options recommend"),
+			examples.NewExample(
+				`Validate a planned MongoDB Flex instance with flavor ID "xxx", 100 GB storage and storage class "premium-perf2-mongodb"`,
+				"$ stackit mongodbflex options recommend --flavor-id xxx --storage 100 --storage-class premium-perf2-mongodb"),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			// Configure API client
+			apiClient, err := client.ConfigureClient(params.Printer, params.CliVersion)
+			if err != nil {
+				return err
+			}
+
+			// Call API
+			options, err := loadOptions(ctx, model, apiClient.DefaultAPI)
+			if err != nil {
+				return err
+			}
+
+			options, err = flexsizing.Compare(service, options, model.Query)
+			if err != nil {
+				return err
+			}
+
+			return flexsizing.OutputResult(params.Printer, model.OutputFormat, options)
+		},
+	}
+	flexsizing.ConfigureFlags(cmd)
+	return cmd
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
+	globalFlags := globalflags.Parse(p, cmd)
+	if globalFlags.ProjectId == "" {
+		return nil, &errors.ProjectIdError{}
+	}
+
+	query, err := flexsizing.ParseFlags(p, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		Query:           query,
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+type mongoDBFlexSizingClient interface {
+	ListFlavors(ctx context.Context, projectId, region string) mongodbflex.ApiListFlavorsRequest
+	ListVersions(ctx context.Context, projectId, region string) mongodbflex.ApiListVersionsRequest
+	ListStorages(ctx context.Context, projectId, flavorId, region string) mongodbflex.ApiListStoragesRequest
+}
+
+// loadOptions joins the flavors with their storages and the supported versions.
+// The storages are only loaded for the flavors which can match the query, as they are listed per flavor.
+func loadOptions(ctx context.Context, model *inputModel, apiClient mongoDBFlexSizingClient) ([]flexsizing.Option, error) {
+	flavors, err := apiClient.ListFlavors(ctx, model.ProjectId, model.Region).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get MongoDB Flex flavors: %w", err)
+	}
+	versions, err := apiClient.ListVersions(ctx, model.ProjectId, model.Region).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get MongoDB Flex versions: %w", err)
+	}
+
+	storages := map[string]*mongodbflex.ListStoragesResponse{}
+	for _, f := range flavors.Flavors {
+		if f.Id == nil || !canMatch(model.Query, f) {
+			continue
+		}
+		storage, err := apiClient.ListStorages(ctx, model.ProjectId, *f.Id, model.Region).Execute()
+		if err != nil {
+			return nil, fmt.Errorf("get MongoDB Flex storages of flavor %q: %w", *f.Id, err)
+		}
+		storages[*f.Id] = storage
+	}
+
+	return mongodbflexUtils.SizingOptions(flavors.Flavors, storages, versions.Versions), nil
+}
+
+func canMatch(query *flexsizing.Query, f mongodbflex.InstanceFlavor) bool {
+	if query.FlavorId != nil {
+		return strings.EqualFold(*query.FlavorId, utils.PtrString(f.Id))
+	}
+	if query.CPU != nil && int64(utils.PtrValue(f.Cpu)) < *query.CPU {
+		return false
+	}
+	if query.RAM != nil && int64(utils.PtrValue(f.Memory)) < *query.RAM {
+		return false
+	}
+	return true
+}
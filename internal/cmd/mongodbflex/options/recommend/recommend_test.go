@@ -0,0 +1,226 @@
+package recommend
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	mongodbflex "github.com/stackitcloud/stackit-sdk-go/services/mongodbflex/v2api"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flexsizing"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testutils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+)
+
+const (
+	testRegion = "eu02"
+)
+
+type testCtxKey struct{}
+
+var testCtx = context.WithValue(context.Background(), testCtxKey{}, "foo")
+var testProjectId = uuid.NewString()
+
+func fixtureFlagValues(mods ...func(flagValues map[string]string)) map[string]string {
+	flagValues := map[string]string{
+		globalflags.ProjectIdFlag: testProjectId,
+		globalflags.RegionFlag:    testRegion,
+		flexsizing.CPUFlag:        "4",
+		flexsizing.RAMFlag:        "16",
+		flexsizing.StorageFlag:    "100",
+	}
+	for _, mod := range mods {
+		mod(flagValues)
+	}
+	return flagValues
+}
+
+func fixtureInputModel(mods ...func(model *inputModel)) *inputModel {
+	model := &inputModel{
+		GlobalFlagModel: &globalflags.GlobalFlagModel{
+			ProjectId: testProjectId,
+			Region:    testRegion,
+			Verbosity: globalflags.VerbosityDefault,
+		},
+		Query: &flexsizing.Query{
+			CPU:     utils.Ptr(int64(4)),
+			RAM:     utils.Ptr(int64(16)),
+			Storage: utils.Ptr(int64(100)),
+		},
+	}
+	for _, mod := range mods {
+		mod(model)
+	}
+	return model
+}
+
+func TestParseInput(t *testing.T) {
+	tests := []struct {
+		description   string
+		argValues     []string
+		flagValues    map[string]string
+		isValid       bool
+		expectedModel *inputModel
+	}{
+		{
+			description:   "base",
+			flagValues:    fixtureFlagValues(),
+			isValid:       true,
+			expectedModel: fixtureInputModel(),
+		},
+		{
+			description: "no requirements",
+			flagValues: map[string]string{
+				globalflags.ProjectIdFlag: testProjectId,
+				globalflags.RegionFlag:    testRegion,
+			},
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.Query = &flexsizing.Query{}
+			}),
+		},
+		{
+			description: "planned flavor",
+			flagValues: map[string]string{
+				globalflags.ProjectIdFlag:   testProjectId,
+				globalflags.RegionFlag:      testRegion,
+				flexsizing.FlavorIdFlag:     "flavor",
+				flexsizing.StorageFlag:      "100",
+				flexsizing.StorageClassFlag: "class",
+			},
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.Query = &flexsizing.Query{
+					Storage:      utils.Ptr(int64(100)),
+					FlavorId:     utils.Ptr("flavor"),
+					StorageClass: utils.Ptr("class"),
+				}
+			}),
+		},
+		{
+			description: "project id missing",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, globalflags.ProjectIdFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "cpu invalid",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[flexsizing.CPUFlag] = "0"
+			}),
+			isValid: false,
+		},
+		{
+			description: "flavor id and cpu",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[flexsizing.FlavorIdFlag] = "flavor"
+			}),
+			isValid: false,
+		},
+		{
+			description: "storage class without flavor id",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[flexsizing.StorageClassFlag] = "class"
+			}),
+			isValid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			testutils.TestParseInput(t, NewCmd, parseInput, tt.expectedModel, tt.argValues, tt.flagValues, tt.isValid)
+		})
+	}
+}
+
+func TestLoadOptions(t *testing.T) {
+	flavors := &mongodbflex.ListFlavorsResponse{
+		Flavors: []mongodbflex.InstanceFlavor{
+			{Id: utils.Ptr("small"), Cpu: utils.Ptr(int32(2)), Memory: utils.Ptr(int32(4)), Categories: []string{"Single"}},
+			{Id: utils.Ptr("large"), Cpu: utils.Ptr(int32(8)), Memory: utils.Ptr(int32(32)), Categories: []string{"Single", "Replica"}},
+		},
+	}
+	storages := &mongodbflex.ListStoragesResponse{
+		StorageClasses: []string{"premium-perf2-mongodb"},
+		StorageRange:   &mongodbflex.StorageRange{Min: utils.Ptr(int64(10)), Max: utils.Ptr(int64(4000))},
+	}
+
+	tests := []struct {
+		description       string
+		model             *inputModel
+		listStoragesFails bool
+		isValid           bool
+		expectedOptions   []flexsizing.Option
+	}{
+		{
+			description: "storages of matching flavors only",
+			model:       fixtureInputModel(),
+			isValid:     true,
+			expectedOptions: []flexsizing.Option{
+				{FlavorId: "small", CPU: 2, RAM: 4, NodeType: "Single", Versions: []string{"7.0"}},
+				{
+					FlavorId: "large", CPU: 8, RAM: 32, NodeType: "Single, Replica", Versions: []string{"7.0"},
+					MinStorage: 10, MaxStorage: 4000, StorageClasses: []string{"premium-perf2-mongodb"},
+				},
+			},
+		},
+		{
+			description: "planned flavor",
+			model: fixtureInputModel(func(model *inputModel) {
+				model.Query = &flexsizing.Query{FlavorId: utils.Ptr("SMALL")}
+			}),
+			isValid: true,
+			expectedOptions: []flexsizing.Option{
+				{
+					FlavorId: "small", CPU: 2, RAM: 4, NodeType: "Single", Versions: []string{"7.0"},
+					MinStorage: 10, MaxStorage: 4000, StorageClasses: []string{"premium-perf2-mongodb"},
+				},
+				{FlavorId: "large", CPU: 8, RAM: 32, NodeType: "Single, Replica", Versions: []string{"7.0"}},
+			},
+		},
+		{
+			description:       "list storages fails",
+			model:             fixtureInputModel(),
+			listStoragesFails: true,
+			isValid:           false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			apiClient := mongodbflex.DefaultAPIServiceMock{
+				ListFlavorsExecuteMock: utils.Ptr(func(_ mongodbflex.ApiListFlavorsRequest) (*mongodbflex.ListFlavorsResponse, error) {
+					return flavors, nil
+				}),
+				ListVersionsExecuteMock: utils.Ptr(func(_ mongodbflex.ApiListVersionsRequest) (*mongodbflex.ListVersionsResponse, error) {
+					return &mongodbflex.ListVersionsResponse{Versions: []string{"7.0"}}, nil
+				}),
+				ListStoragesExecuteMock: utils.Ptr(func(_ mongodbflex.ApiListStoragesRequest) (*mongodbflex.ListStoragesResponse, error) {
+					if tt.listStoragesFails {
+						return nil, fmt.Errorf("list storages failed")
+					}
+					return storages, nil
+				}),
+			}
+
+			options, err := loadOptions(testCtx, tt.model, apiClient)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("should have failed")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("should not have failed: %v", err)
+			}
+			diff := cmp.Diff(options, tt.expectedOptions)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	mongodbflex "github.com/stackitcloud/stackit-sdk-go/services/mongodbflex/v2api"
 
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dbcredentials"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
@@ -39,10 +40,11 @@ var (
 type inputModel struct {
 	*globalflags.GlobalFlagModel
 
-	InstanceId string
-	Username   *string
-	Database   *string
-	Roles      *[]string
+	InstanceId        string
+	Username          *string
+	Database          *string
+	Roles             *[]string
+	CredentialsFormat *dbcredentials.Options
 }
 
 func NewCmd(params *types.CmdParams) *cobra.Command {
@@ -62,6 +64,9 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 			examples.NewExample(
 				`Create a MongoDB Flex user for instance with ID "xxx" with an automatically generated username`,
 				"$ stackit mongodbflex user create --instance-id xxx --role read --database default"),
+			examples.NewExample(
+				`Create a MongoDB Flex user for instance with ID "xxx" and output its connection details as a dotenv file`,
+				"$ stackit mongodbflex user create --instance-id xxx --username johndoe --role read --database default --format-as dotenv --output-file .env"),
 		),
 		Args: args.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -97,6 +102,10 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 			}
 			user := resp.Item
 
+			if model.CredentialsFormat.FormatAs != "" {
+				return dbcredentials.Output(params.Printer, model.CredentialsFormat, buildCredentials(user), "")
+			}
+
 			return outputResult(params.Printer, model.OutputFormat, instanceLabel, user)
 		},
 	}
@@ -110,6 +119,7 @@ func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().String(usernameFlag, "", "Username of the user. If not specified, a random username will be assigned")
 	cmd.Flags().String(databaseFlag, "", "The database inside the MongoDB instance that the user has access to. If it does not exist, it will be created once the user writes to it")
 	roleFlag.Register(cmd)
+	dbcredentials.ConfigureFlags(cmd)
 
 	err := flags.MarkFlagsRequired(cmd, instanceIdFlag, databaseFlag)
 	cobra.CheckErr(err)
@@ -121,12 +131,18 @@ func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel,
 		return nil, &errors.ProjectIdError{}
 	}
 
+	credentialsFormat, err := dbcredentials.ParseFlags(p, cmd)
+	if err != nil {
+		return nil, err
+	}
+
 	model := inputModel{
-		GlobalFlagModel: globalFlags,
-		InstanceId:      flags.FlagToStringValue(p, cmd, instanceIdFlag),
-		Username:        flags.FlagToStringPointer(p, cmd, usernameFlag),
-		Database:        flags.FlagToStringPointer(p, cmd, databaseFlag),
-		Roles:           roleFlag.Ptr(),
+		GlobalFlagModel:   globalFlags,
+		InstanceId:        flags.FlagToStringValue(p, cmd, instanceIdFlag),
+		Username:          flags.FlagToStringPointer(p, cmd, usernameFlag),
+		Database:          flags.FlagToStringPointer(p, cmd, databaseFlag),
+		Roles:             roleFlag.Ptr(),
+		CredentialsFormat: credentialsFormat,
 	}
 
 	p.DebugInputModel(model)
@@ -161,3 +177,18 @@ func outputResult(p *print.Printer, outputFormat, instanceLabel string, user *mo
 		return nil
 	})
 }
+
+func buildCredentials(user *mongodbflex.User) *dbcredentials.Credentials {
+	if user == nil {
+		return &dbcredentials.Credentials{Engine: dbcredentials.EngineMongoDB}
+	}
+	return &dbcredentials.Credentials{
+		Engine:   dbcredentials.EngineMongoDB,
+		Host:     utils.PtrString(user.Host),
+		Port:     utils.PtrString(user.Port),
+		Username: utils.PtrString(user.Username),
+		Password: utils.PtrString(user.Password),
+		Database: utils.PtrString(user.Database),
+		URI:      utils.PtrString(user.Uri),
+	}
+}
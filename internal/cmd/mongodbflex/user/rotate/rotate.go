@@ -0,0 +1,197 @@
+package rotate
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	mongodbflex "github.com/stackitcloud/stackit-sdk-go/services/mongodbflex/v2api"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dbcredentials"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/services/mongodbflex/client"
+	mongodbflexUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/mongodbflex/utils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+)
+
+const (
+	userIdArg = "USER_ID"
+
+	instanceIdFlag = "instance-id"
+	usernameFlag   = "username"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+
+	InstanceId    string
+	UserId        string
+	Username      string
+	RotateOptions *dbcredentials.RotateOptions
+}
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("rotate %s", userIdArg),
+		Short: "Rotates the credentials of a MongoDB Flex user",
+		Long: fmt.Sprintf("%s\n%s\n%s",
+			"Rotates the credentials of a MongoDB Flex user by replacing it with a new user with the same roles and database.",
+			"The credentials of the new user are output and can optionally be written to a Secrets Manager secret.",
+			`The old user is deleted after the grace period set with the "--grace-period" flag.`,
+		),
+		Example: examples.Build(
+			examples.NewExample(
+				`Rotate the credentials of a MongoDB Flex user with ID "xxx" of instance with ID "yyy"`,
+				"$ stackit mongodbflex user rotate xxx --instance-id yyy"),
+			examples.NewExample(
+				`Rotate the credentials of a MongoDB Flex user with ID "xxx" of instance with ID "yyy", name the new user "app" and delete the old user after 10 minutes`,
+				"$ stackit mongodbflex user rotate xxx --instance-id yyy --username app --grace-period 10m"),
+			examples.NewExample(
+				`Rotate the credentials of a MongoDB Flex user with ID "xxx" of instance with ID "yyy" and write them to the secret "mongodb/app" of Secrets Manager instance with ID "zzz"`,
+				"$ stackit mongodbflex user rotate xxx --instance-id yyy --secrets-manager-instance-id zzz --secrets-manager-secret-path mongodb/app --secrets-manager-username my-user"),
+		),
+		Args: args.SingleArg(userIdArg, utils.ValidateUUID),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			// Configure API client
+			apiClient, err := client.ConfigureClient(params.Printer, params.CliVersion)
+			if err != nil {
+				return err
+			}
+
+			instanceLabel, err := mongodbflexUtils.GetInstanceName(ctx, apiClient.DefaultAPI, model.ProjectId, model.InstanceId, model.Region)
+			if err != nil {
+				params.Printer.Debug(print.ErrorLevel, "get instance name: %v", err)
+				instanceLabel = model.InstanceId
+			}
+
+			// The new user gets the roles and database of the old user
+			resp, err := buildGetUserRequest(ctx, model, apiClient).Execute()
+			if err != nil {
+				return fmt.Errorf("get MongoDB Flex user: %w", err)
+			}
+			if resp == nil || resp.Item == nil {
+				return fmt.Errorf("get MongoDB Flex user: empty response")
+			}
+			oldUser := resp.Item
+
+			// Usernames can't contain underscores
+			username := model.Username
+			if username == "" {
+				username = dbcredentials.RotatedUsername(utils.PtrString(oldUser.Username), "-", time.Now())
+			}
+
+			return dbcredentials.Rotate(ctx, params.Printer, model.OutputFormat, model.Region, model.RotateOptions, &dbcredentials.Rotation{
+				Service:       "MongoDB Flex",
+				Kind:          "user",
+				Label:         fmt.Sprintf("%q", utils.PtrString(oldUser.Username)),
+				InstanceLabel: instanceLabel,
+				Create: func(ctx context.Context) (*dbcredentials.NewCredentials, error) {
+					resp, err := buildCreateRequest(ctx, model, apiClient, username, oldUser).Execute()
+					if err != nil {
+						return nil, err
+					}
+					if resp == nil {
+						return nil, fmt.Errorf("empty response")
+					}
+					return buildNewCredentials(resp.Item)
+				},
+				Delete: func(ctx context.Context) error {
+					return buildDeleteRequest(ctx, model, apiClient).Execute()
+				},
+			})
+		},
+	}
+
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().Var(flags.UUIDFlag(), instanceIdFlag, "ID of the instance")
+	cmd.Flags().String(usernameFlag, "", "Username of the new user. Defaults to the username of the old user with the time of the rotation appended")
+	dbcredentials.ConfigureRotateFlags(cmd, "user")
+
+	err := flags.MarkFlagsRequired(cmd, instanceIdFlag)
+	cobra.CheckErr(err)
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inputModel, error) {
+	userId := inputArgs[0]
+
+	globalFlags := globalflags.Parse(p, cmd)
+	if globalFlags.ProjectId == "" {
+		return nil, &errors.ProjectIdError{}
+	}
+
+	rotateOptions, err := dbcredentials.ParseRotateFlags(p, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		InstanceId:      flags.FlagToStringValue(p, cmd, instanceIdFlag),
+		UserId:          userId,
+		Username:        flags.FlagToStringValue(p, cmd, usernameFlag),
+		RotateOptions:   rotateOptions,
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+func buildGetUserRequest(ctx context.Context, model *inputModel, apiClient *mongodbflex.APIClient) mongodbflex.ApiGetUserRequest {
+	req := apiClient.DefaultAPI.GetUser(ctx, model.ProjectId, model.InstanceId, model.UserId, model.Region)
+	return req
+}
+
+func buildCreateRequest(ctx context.Context, model *inputModel, apiClient *mongodbflex.APIClient, username string, oldUser *mongodbflex.InstanceResponseUser) mongodbflex.ApiCreateUserRequest {
+	req := apiClient.DefaultAPI.CreateUser(ctx, model.ProjectId, model.InstanceId, model.Region)
+	req = req.CreateUserPayload(mongodbflex.CreateUserPayload{
+		Username: &username,
+		Database: utils.PtrString(oldUser.Database),
+		Roles:    oldUser.Roles,
+	})
+	return req
+}
+
+func buildDeleteRequest(ctx context.Context, model *inputModel, apiClient *mongodbflex.APIClient) mongodbflex.ApiDeleteUserRequest {
+	req := apiClient.DefaultAPI.DeleteUser(ctx, model.ProjectId, model.InstanceId, model.UserId, model.Region)
+	return req
+}
+
+func buildNewCredentials(user *mongodbflex.User) (*dbcredentials.NewCredentials, error) {
+	if user == nil {
+		return nil, fmt.Errorf("empty response")
+	}
+
+	return &dbcredentials.NewCredentials{
+		Id: utils.PtrString(user.Id),
+		Credentials: &dbcredentials.Credentials{
+			Engine:   dbcredentials.EngineMongoDB,
+			Host:     utils.PtrString(user.Host),
+			Port:     utils.PtrString(user.Port),
+			Username: utils.PtrString(user.Username),
+			Password: utils.PtrString(user.Password),
+			Database: utils.PtrString(user.Database),
+			URI:      utils.PtrString(user.Uri),
+		},
+		Response: user,
+		HidePassword: func() {
+			user.Password = utils.Ptr("hidden")
+		},
+	}, nil
+}
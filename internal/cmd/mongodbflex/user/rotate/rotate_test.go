@@ -0,0 +1,265 @@
+package rotate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	mongodbflex "github.com/stackitcloud/stackit-sdk-go/services/mongodbflex/v2api"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dbcredentials"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testutils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+)
+
+const (
+	testRegion = "eu02"
+)
+
+type testCtxKey struct{}
+
+var testCtx = context.WithValue(context.Background(), testCtxKey{}, "foo")
+var testClient = &mongodbflex.APIClient{DefaultAPI: &mongodbflex.DefaultAPIService{}}
+var testProjectId = uuid.NewString()
+var testInstanceId = uuid.NewString()
+var testUserId = uuid.NewString()
+var testSecretsManagerInstanceId = uuid.NewString()
+
+func fixtureArgValues(mods ...func(argValues []string)) []string {
+	argValues := []string{
+		testUserId,
+	}
+	for _, mod := range mods {
+		mod(argValues)
+	}
+	return argValues
+}
+
+func fixtureFlagValues(mods ...func(flagValues map[string]string)) map[string]string {
+	flagValues := map[string]string{
+		globalflags.ProjectIdFlag: testProjectId,
+		globalflags.RegionFlag:    testRegion,
+		instanceIdFlag:            testInstanceId,
+	}
+	for _, mod := range mods {
+		mod(flagValues)
+	}
+	return flagValues
+}
+
+func fixtureInputModel(mods ...func(model *inputModel)) *inputModel {
+	model := &inputModel{
+		GlobalFlagModel: &globalflags.GlobalFlagModel{
+			ProjectId: testProjectId,
+			Region:    testRegion,
+			Verbosity: globalflags.VerbosityDefault,
+		},
+		InstanceId: testInstanceId,
+		UserId:     testUserId,
+		RotateOptions: &dbcredentials.RotateOptions{
+			CredentialsFormat: &dbcredentials.Options{},
+		},
+	}
+	for _, mod := range mods {
+		mod(model)
+	}
+	return model
+}
+
+func TestParseInput(t *testing.T) {
+	tests := []struct {
+		description   string
+		argValues     []string
+		flagValues    map[string]string
+		isValid       bool
+		expectedModel *inputModel
+	}{
+		{
+			description:   "base",
+			argValues:     fixtureArgValues(),
+			flagValues:    fixtureFlagValues(),
+			isValid:       true,
+			expectedModel: fixtureInputModel(),
+		},
+		{
+			description: "all values",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[usernameFlag] = "app"
+				flagValues[dbcredentials.GracePeriodFlag] = "10m"
+				flagValues[dbcredentials.FormatAsFlag] = "uri"
+				flagValues[dbcredentials.SecretsManagerInstanceIdFlag] = testSecretsManagerInstanceId
+				flagValues[dbcredentials.SecretsManagerSecretPathFlag] = "mongodb/app"
+				flagValues[dbcredentials.SecretsManagerUsernameFlag] = "user"
+			}),
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.Username = "app"
+				model.RotateOptions.GracePeriod = 10 * time.Minute
+				model.RotateOptions.CredentialsFormat.FormatAs = dbcredentials.FormatURI
+				model.RotateOptions.SecretsManager = &dbcredentials.SecretsManagerTarget{
+					InstanceId: testSecretsManagerInstanceId,
+					SecretPath: "mongodb/app",
+					Username:   "user",
+				}
+			}),
+		},
+		{
+			description: "no values",
+			argValues:   []string{},
+			flagValues:  map[string]string{},
+			isValid:     false,
+		},
+		{
+			description: "no arg values",
+			argValues:   []string{},
+			flagValues:  fixtureFlagValues(),
+			isValid:     false,
+		},
+		{
+			description: "project id missing",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, globalflags.ProjectIdFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "instance id missing",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, instanceIdFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "user id invalid",
+			argValues:   []string{"invalid-id"},
+			flagValues:  fixtureFlagValues(),
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			testutils.TestParseInput(t, NewCmd, parseInput, tt.expectedModel, tt.argValues, tt.flagValues, tt.isValid)
+		})
+	}
+}
+
+func TestBuildGetUserRequest(t *testing.T) {
+	expectedRequest := testClient.DefaultAPI.GetUser(testCtx, testProjectId, testInstanceId, testUserId, testRegion)
+	request := buildGetUserRequest(testCtx, fixtureInputModel(), testClient)
+
+	diff := cmp.Diff(request, expectedRequest,
+		cmp.AllowUnexported(expectedRequest),
+		cmpopts.EquateComparable(testCtx, mongodbflex.DefaultAPIService{}),
+	)
+	if diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+}
+
+func TestBuildCreateRequest(t *testing.T) {
+	expectedRequest := testClient.DefaultAPI.CreateUser(testCtx, testProjectId, testInstanceId, testRegion).
+		CreateUserPayload(mongodbflex.CreateUserPayload{
+			Username: utils.Ptr("app"),
+			Database: "default",
+			Roles:    []string{"read"},
+		})
+	oldUser := &mongodbflex.InstanceResponseUser{
+		Username: utils.Ptr("old-app"),
+		Database: utils.Ptr("default"),
+		Roles:    []string{"read"},
+	}
+	request := buildCreateRequest(testCtx, fixtureInputModel(), testClient, "app", oldUser)
+
+	diff := cmp.Diff(request, expectedRequest,
+		cmp.AllowUnexported(expectedRequest),
+		cmpopts.EquateComparable(testCtx, mongodbflex.DefaultAPIService{}),
+	)
+	if diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+}
+
+func TestBuildDeleteRequest(t *testing.T) {
+	expectedRequest := testClient.DefaultAPI.DeleteUser(testCtx, testProjectId, testInstanceId, testUserId, testRegion)
+	request := buildDeleteRequest(testCtx, fixtureInputModel(), testClient)
+
+	diff := cmp.Diff(request, expectedRequest,
+		cmp.AllowUnexported(expectedRequest),
+		cmpopts.EquateComparable(testCtx, mongodbflex.DefaultAPIService{}),
+	)
+	if diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+}
+
+func TestBuildNewCredentials(t *testing.T) {
+	tests := []struct {
+		description         string
+		user                *mongodbflex.User
+		isValid             bool
+		expectedId          string
+		expectedCredentials *dbcredentials.Credentials
+	}{
+		{
+			description: "base",
+			user: &mongodbflex.User{
+				Id:       utils.Ptr("id"),
+				Username: utils.Ptr("app"),
+				Password: utils.Ptr("password"),
+				Database: utils.Ptr("default"),
+				Host:     utils.Ptr("host"),
+				Port:     utils.Ptr(int64(27017)),
+				Uri:      utils.Ptr("uri"),
+			},
+			isValid:    true,
+			expectedId: "id",
+			expectedCredentials: &dbcredentials.Credentials{
+				Engine:   dbcredentials.EngineMongoDB,
+				Host:     "host",
+				Port:     "27017",
+				Username: "app",
+				Password: "password",
+				Database: "default",
+				URI:      "uri",
+			},
+		},
+		{
+			description: "nil user",
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			newCredentials, err := buildNewCredentials(tt.user)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("should have failed")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("should not have failed: %v", err)
+			}
+			if newCredentials.Id != tt.expectedId {
+				t.Fatalf("expected id %q, got %q", tt.expectedId, newCredentials.Id)
+			}
+			diff := cmp.Diff(newCredentials.Credentials, tt.expectedCredentials)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+
+			newCredentials.HidePassword()
+			if *tt.user.Password != "hidden" {
+				t.Fatalf("password not hidden in response")
+			}
+		})
+	}
+}
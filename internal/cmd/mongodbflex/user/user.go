@@ -6,6 +6,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/cmd/mongodbflex/user/describe"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/mongodbflex/user/list"
 	resetpassword "github.com/stackitcloud/stackit-cli/internal/cmd/mongodbflex/user/reset-password"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/mongodbflex/user/rotate"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/mongodbflex/user/update"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
@@ -32,5 +33,6 @@ func addSubcommands(cmd *cobra.Command, params *types.CmdParams) {
 	cmd.AddCommand(describe.NewCmd(params))
 	cmd.AddCommand(list.NewCmd(params))
 	cmd.AddCommand(resetpassword.NewCmd(params))
+	cmd.AddCommand(rotate.NewCmd(params))
 	cmd.AddCommand(update.NewCmd(params))
 }
@@ -10,6 +10,7 @@ import (
 	opensearch "github.com/stackitcloud/stackit-sdk-go/services/opensearch/v2api"
 
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dbcredentials"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
@@ -17,6 +18,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/services/opensearch/client"
 	opensearchUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/opensearch/utils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
 )
 
 const (
@@ -26,8 +28,9 @@ const (
 
 type inputModel struct {
 	*globalflags.GlobalFlagModel
-	InstanceId   string
-	ShowPassword bool
+	InstanceId        string
+	ShowPassword      bool
+	CredentialsFormat *dbcredentials.Options
 }
 
 func NewCmd(params *types.CmdParams) *cobra.Command {
@@ -43,6 +46,9 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 			examples.NewExample(
 				`Create credentials for an OpenSearch instance and show the password in the output`,
 				"$ stackit opensearch credentials create --instance-id xxx --show-password"),
+			examples.NewExample(
+				`Create credentials for an OpenSearch instance and output them as a dotenv file`,
+				"$ stackit opensearch credentials create --instance-id xxx --format-as dotenv --output-file .env"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.Background()
@@ -76,6 +82,10 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 				return fmt.Errorf("create OpenSearch credentials: %w", err)
 			}
 
+			if model.CredentialsFormat.FormatAs != "" {
+				return dbcredentials.Output(params.Printer, model.CredentialsFormat, buildCredentials(resp), "")
+			}
+
 			return outputResult(params.Printer, *model, instanceLabel, resp)
 		},
 	}
@@ -86,6 +96,7 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().Var(flags.UUIDFlag(), instanceIdFlag, "Instance ID")
 	cmd.Flags().BoolP(showPasswordFlag, "s", false, "Show password in output")
+	dbcredentials.ConfigureFlags(cmd)
 
 	err := flags.MarkFlagsRequired(cmd, instanceIdFlag)
 	cobra.CheckErr(err)
@@ -97,10 +108,16 @@ func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel,
 		return nil, &errors.ProjectIdError{}
 	}
 
+	credentialsFormat, err := dbcredentials.ParseFlags(p, cmd)
+	if err != nil {
+		return nil, err
+	}
+
 	model := inputModel{
-		GlobalFlagModel: globalFlags,
-		InstanceId:      flags.FlagToStringValue(p, cmd, instanceIdFlag),
-		ShowPassword:    flags.FlagToBoolValue(p, cmd, showPasswordFlag),
+		GlobalFlagModel:   globalFlags,
+		InstanceId:        flags.FlagToStringValue(p, cmd, instanceIdFlag),
+		ShowPassword:      flags.FlagToBoolValue(p, cmd, showPasswordFlag),
+		CredentialsFormat: credentialsFormat,
 	}
 
 	p.DebugInputModel(model)
@@ -143,3 +160,17 @@ func outputResult(p *print.Printer, model inputModel, instanceLabel string, resp
 		return nil
 	})
 }
+
+func buildCredentials(resp *opensearch.CredentialsResponse) *dbcredentials.Credentials {
+	credentials := &dbcredentials.Credentials{
+		Engine: dbcredentials.EngineOpenSearch,
+		URI:    resp.Uri,
+	}
+	if resp.HasRaw() {
+		credentials.Host = resp.Raw.Credentials.Host
+		credentials.Port = utils.PtrString(resp.Raw.Credentials.Port)
+		credentials.Username = resp.Raw.Credentials.Username
+		credentials.Password = resp.Raw.Credentials.Password
+	}
+	return credentials
+}
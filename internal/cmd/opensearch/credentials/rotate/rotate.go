@@ -0,0 +1,173 @@
+package rotate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	opensearch "github.com/stackitcloud/stackit-sdk-go/services/opensearch/v2api"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dbcredentials"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/services/opensearch/client"
+	opensearchUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/opensearch/utils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+)
+
+const (
+	credentialsIdArg = "CREDENTIALS_ID" //nolint:gosec // linter false positive
+
+	instanceIdFlag = "instance-id"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+	InstanceId    string
+	CredentialsId string
+	RotateOptions *dbcredentials.RotateOptions
+}
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("rotate %s", credentialsIdArg),
+		Short: "Rotates credentials of an OpenSearch instance",
+		Long: fmt.Sprintf("%s\n%s\n%s",
+			"Rotates credentials of an OpenSearch instance.",
+			"New credentials are created and output, optionally written to a Secrets Manager secret,",
+			`and the old credentials are deleted after the grace period set with the "--grace-period" flag.`,
+		),
+		Args: args.SingleArg(credentialsIdArg, utils.ValidateUUID),
+		Example: examples.Build(
+			examples.NewExample(
+				`Rotate credentials with ID "xxx" of OpenSearch instance with ID "yyy"`,
+				"$ stackit opensearch credentials rotate xxx --instance-id yyy"),
+			examples.NewExample(
+				`Rotate credentials with ID "xxx" of OpenSearch instance with ID "yyy" and delete the old credentials after 10 minutes`,
+				"$ stackit opensearch credentials rotate xxx --instance-id yyy --grace-period 10m"),
+			examples.NewExample(
+				`Rotate credentials with ID "xxx" of OpenSearch instance with ID "yyy" and write the new credentials to the secret "opensearch/app" of Secrets Manager instance with ID "zzz"`,
+				"$ stackit opensearch credentials rotate xxx --instance-id yyy --secrets-manager-instance-id zzz --secrets-manager-secret-path opensearch/app --secrets-manager-username my-user"),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			// Configure API client
+			apiClient, err := client.ConfigureClient(params.Printer, params.CliVersion)
+			if err != nil {
+				return err
+			}
+
+			instanceLabel, err := opensearchUtils.GetInstanceName(ctx, apiClient.DefaultAPI, model.ProjectId, model.Region, model.InstanceId)
+			if err != nil {
+				params.Printer.Debug(print.ErrorLevel, "get instance name: %v", err)
+				instanceLabel = model.InstanceId
+			}
+
+			credentialsLabel, err := opensearchUtils.GetCredentialsUsername(ctx, apiClient.DefaultAPI, model.ProjectId, model.Region, model.InstanceId, model.CredentialsId)
+			if err != nil {
+				params.Printer.Debug(print.ErrorLevel, "get credentials user name: %v", err)
+				credentialsLabel = model.CredentialsId
+			}
+
+			return dbcredentials.Rotate(ctx, params.Printer, model.OutputFormat, model.Region, model.RotateOptions, &dbcredentials.Rotation{
+				Service:       "OpenSearch",
+				Kind:          "credentials",
+				Label:         credentialsLabel,
+				InstanceLabel: instanceLabel,
+				Create: func(ctx context.Context) (*dbcredentials.NewCredentials, error) {
+					resp, err := buildCreateRequest(ctx, model, apiClient).Execute()
+					if err != nil {
+						return nil, err
+					}
+					return buildNewCredentials(resp)
+				},
+				Delete: func(ctx context.Context) error {
+					return buildDeleteRequest(ctx, model, apiClient).Execute()
+				},
+			})
+		},
+	}
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().Var(flags.UUIDFlag(), instanceIdFlag, "Instance ID")
+	dbcredentials.ConfigureRotateFlags(cmd, "credentials")
+
+	err := flags.MarkFlagsRequired(cmd, instanceIdFlag)
+	cobra.CheckErr(err)
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inputModel, error) {
+	credentialsId := inputArgs[0]
+
+	globalFlags := globalflags.Parse(p, cmd)
+	if globalFlags.ProjectId == "" {
+		return nil, &errors.ProjectIdError{}
+	}
+
+	rotateOptions, err := dbcredentials.ParseRotateFlags(p, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		InstanceId:      flags.FlagToStringValue(p, cmd, instanceIdFlag),
+		CredentialsId:   credentialsId,
+		RotateOptions:   rotateOptions,
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+func buildCreateRequest(ctx context.Context, model *inputModel, apiClient *opensearch.APIClient) opensearch.ApiCreateCredentialsRequest {
+	req := apiClient.DefaultAPI.CreateCredentials(ctx, model.ProjectId, model.Region, model.InstanceId)
+	return req
+}
+
+func buildDeleteRequest(ctx context.Context, model *inputModel, apiClient *opensearch.APIClient) opensearch.ApiDeleteCredentialsRequest {
+	req := apiClient.DefaultAPI.DeleteCredentials(ctx, model.ProjectId, model.Region, model.InstanceId, model.CredentialsId)
+	return req
+}
+
+func buildNewCredentials(resp *opensearch.CredentialsResponse) (*dbcredentials.NewCredentials, error) {
+	if resp == nil {
+		return nil, fmt.Errorf("empty response")
+	}
+
+	credentials := &dbcredentials.Credentials{
+		Engine: dbcredentials.EngineOpenSearch,
+		URI:    resp.Uri,
+	}
+	if resp.HasRaw() {
+		credentials.Host = resp.Raw.Credentials.Host
+		credentials.Port = utils.PtrString(resp.Raw.Credentials.Port)
+		credentials.Username = resp.Raw.Credentials.Username
+		credentials.Password = resp.Raw.Credentials.Password
+	}
+
+	return &dbcredentials.NewCredentials{
+		Id:          resp.Id,
+		Credentials: credentials,
+		Response:    resp,
+		HidePassword: func() {
+			if resp.Raw == nil {
+				resp.Raw = &opensearch.RawCredentials{Credentials: opensearch.Credentials{}}
+			}
+			resp.Raw.Credentials.Password = "hidden"
+		},
+	}, nil
+}
@@ -0,0 +1,246 @@
+package rotate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	opensearch "github.com/stackitcloud/stackit-sdk-go/services/opensearch/v2api"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dbcredentials"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testutils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+)
+
+var projectIdFlag = globalflags.ProjectIdFlag
+
+type testCtxKey struct{}
+
+var testCtx = context.WithValue(context.Background(), testCtxKey{}, "foo")
+var testClient = &opensearch.APIClient{DefaultAPI: &opensearch.DefaultAPIService{}}
+var testProjectId = uuid.NewString()
+var testInstanceId = uuid.NewString()
+var testCredentialsId = uuid.NewString()
+var testSecretsManagerInstanceId = uuid.NewString()
+var testRegion = "eu01"
+
+func fixtureArgValues(mods ...func(argValues []string)) []string {
+	argValues := []string{
+		testCredentialsId,
+	}
+	for _, mod := range mods {
+		mod(argValues)
+	}
+	return argValues
+}
+
+func fixtureFlagValues(mods ...func(flagValues map[string]string)) map[string]string {
+	flagValues := map[string]string{
+		projectIdFlag:          testProjectId,
+		instanceIdFlag:         testInstanceId,
+		globalflags.RegionFlag: testRegion,
+	}
+	for _, mod := range mods {
+		mod(flagValues)
+	}
+	return flagValues
+}
+
+func fixtureInputModel(mods ...func(model *inputModel)) *inputModel {
+	model := &inputModel{
+		GlobalFlagModel: &globalflags.GlobalFlagModel{
+			ProjectId: testProjectId,
+			Verbosity: globalflags.VerbosityDefault,
+			Region:    testRegion,
+		},
+		InstanceId:    testInstanceId,
+		CredentialsId: testCredentialsId,
+		RotateOptions: &dbcredentials.RotateOptions{
+			CredentialsFormat: &dbcredentials.Options{},
+		},
+	}
+	for _, mod := range mods {
+		mod(model)
+	}
+	return model
+}
+
+func TestParseInput(t *testing.T) {
+	tests := []struct {
+		description   string
+		argValues     []string
+		flagValues    map[string]string
+		isValid       bool
+		expectedModel *inputModel
+	}{
+		{
+			description:   "base",
+			argValues:     fixtureArgValues(),
+			flagValues:    fixtureFlagValues(),
+			isValid:       true,
+			expectedModel: fixtureInputModel(),
+		},
+		{
+			description: "all values",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[dbcredentials.GracePeriodFlag] = "10m"
+				flagValues[dbcredentials.ShowPasswordFlag] = "true"
+				flagValues[dbcredentials.FormatAsFlag] = "uri"
+				flagValues[dbcredentials.SecretsManagerInstanceIdFlag] = testSecretsManagerInstanceId
+				flagValues[dbcredentials.SecretsManagerSecretPathFlag] = "opensearch/app"
+				flagValues[dbcredentials.SecretsManagerUsernameFlag] = "user"
+			}),
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.RotateOptions.GracePeriod = 10 * time.Minute
+				model.RotateOptions.ShowPassword = true
+				model.RotateOptions.CredentialsFormat.FormatAs = dbcredentials.FormatURI
+				model.RotateOptions.SecretsManager = &dbcredentials.SecretsManagerTarget{
+					InstanceId: testSecretsManagerInstanceId,
+					SecretPath: "opensearch/app",
+					Username:   "user",
+				}
+			}),
+		},
+		{
+			description: "no values",
+			argValues:   []string{},
+			flagValues:  map[string]string{},
+			isValid:     false,
+		},
+		{
+			description: "no arg values",
+			argValues:   []string{},
+			flagValues:  fixtureFlagValues(),
+			isValid:     false,
+		},
+		{
+			description: "project id missing",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, projectIdFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "instance id missing",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, instanceIdFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "credentials id invalid",
+			argValues:   []string{"invalid-uuid"},
+			flagValues:  fixtureFlagValues(),
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			testutils.TestParseInput(t, NewCmd, parseInput, tt.expectedModel, tt.argValues, tt.flagValues, tt.isValid)
+		})
+	}
+}
+
+func TestBuildCreateRequest(t *testing.T) {
+	expectedRequest := testClient.DefaultAPI.CreateCredentials(testCtx, testProjectId, testRegion, testInstanceId)
+	request := buildCreateRequest(testCtx, fixtureInputModel(), testClient)
+
+	diff := cmp.Diff(request, expectedRequest,
+		cmp.AllowUnexported(expectedRequest, opensearch.DefaultAPIService{}),
+		cmpopts.EquateComparable(testCtx),
+	)
+	if diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+}
+
+func TestBuildDeleteRequest(t *testing.T) {
+	expectedRequest := testClient.DefaultAPI.DeleteCredentials(testCtx, testProjectId, testRegion, testInstanceId, testCredentialsId)
+	request := buildDeleteRequest(testCtx, fixtureInputModel(), testClient)
+
+	diff := cmp.Diff(request, expectedRequest,
+		cmp.AllowUnexported(expectedRequest, opensearch.DefaultAPIService{}),
+		cmpopts.EquateComparable(testCtx),
+	)
+	if diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+}
+
+func TestBuildNewCredentials(t *testing.T) {
+	tests := []struct {
+		description         string
+		resp                *opensearch.CredentialsResponse
+		isValid             bool
+		expectedCredentials *dbcredentials.Credentials
+	}{
+		{
+			description: "base",
+			resp: &opensearch.CredentialsResponse{
+				Id:  "id",
+				Uri: "uri",
+				Raw: &opensearch.RawCredentials{
+					Credentials: opensearch.Credentials{
+						Host:     "host",
+						Port:     utils.Ptr(int32(443)),
+						Username: "user",
+						Password: "password",
+					},
+				},
+			},
+			isValid: true,
+			expectedCredentials: &dbcredentials.Credentials{
+				Engine:   dbcredentials.EngineOpenSearch,
+				Host:     "host",
+				Port:     "443",
+				Username: "user",
+				Password: "password",
+				URI:      "uri",
+			},
+		},
+		{
+			description: "empty",
+			resp:        &opensearch.CredentialsResponse{},
+			isValid:     true,
+			expectedCredentials: &dbcredentials.Credentials{
+				Engine: dbcredentials.EngineOpenSearch,
+			},
+		},
+		{
+			description: "nil response",
+			isValid:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			newCredentials, err := buildNewCredentials(tt.resp)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("should have failed")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("should not have failed: %v", err)
+			}
+			diff := cmp.Diff(newCredentials.Credentials, tt.expectedCredentials)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+
+			newCredentials.HidePassword()
+			if tt.resp.Raw.Credentials.Password != "hidden" {
+				t.Fatalf("password not hidden in response")
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package list
+
+import (
+	"fmt"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/plugins"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/tables"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+
+	"github.com/spf13/cobra"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+}
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Lists all plugins",
+		Long:  `Lists all plugins, i.e. the executables named "stackit-<name>" found in the directories of the PATH environment variable.`,
+		Args:  args.NoArgs,
+		Example: examples.Build(
+			examples.NewExample(
+				`List all plugins`,
+				"$ stackit plugin list"),
+			examples.NewExample(
+				`List all plugins in a json format`,
+				"$ stackit plugin list --output-format json"),
+		),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			model := parseInput(params.Printer, cmd)
+
+			return outputResult(params.Printer, model.OutputFormat, plugins.List())
+		},
+	}
+	return cmd
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command) *inputModel {
+	globalFlags := globalflags.Parse(p, cmd)
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+	}
+
+	p.DebugInputModel(model)
+	return &model
+}
+
+func outputResult(p *print.Printer, outputFormat string, pluginList []plugins.Plugin) error {
+	return p.OutputResult(outputFormat, pluginList, func() error {
+		if len(pluginList) == 0 {
+			p.Info("No plugins found\n")
+			return nil
+		}
+
+		table := tables.NewTable()
+		table.SetHeader("NAME", "PATH")
+		for _, plugin := range pluginList {
+			table.AddRow(plugin.Name, plugin.Path)
+			table.AddSeparator()
+		}
+		err := table.Display(p)
+		if err != nil {
+			return fmt.Errorf("render table: %w", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,53 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/plugins"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testparams"
+)
+
+func TestOutputResult(t *testing.T) {
+	type args struct {
+		outputFormat string
+		plugins      []plugins.Plugin
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name:    "empty",
+			args:    args{},
+			wantErr: false,
+		},
+		{
+			name: "with plugins",
+			args: args{
+				plugins: []plugins.Plugin{
+					{Name: "cost", Path: "/usr/local/bin/stackit-cost"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "json",
+			args: args{
+				outputFormat: "json",
+				plugins: []plugins.Plugin{
+					{Name: "cost", Path: "/usr/local/bin/stackit-cost"},
+				},
+			},
+			wantErr: false,
+		},
+	}
+	params := testparams.NewTestParams()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := outputResult(params.Printer, tt.args.outputFormat, tt.args.plugins); (err != nil) != tt.wantErr {
+				t.Errorf("outputResult() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package plugin
+
+import (
+	"fmt"
+
+	"github.com/stackitcloud/stackit-cli/internal/cmd/plugin/list"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "plugin",
+		Short: "Provides functionality for STACKIT CLI plugins",
+		Long: fmt.Sprintf("%s\n%s\n%s\n%s",
+			"Provides functionality for STACKIT CLI plugins.",
+			`A plugin is an executable named "stackit-<name>" in one of the directories of the PATH environment variable, which is run by "stackit <name>".`,
+			"Plugins can't override existing commands. All arguments after the plugin name are passed to the plugin.",
+			`The active profile, project ID, region, output format and, if authenticated, an access token are passed to the plugin in the environment variables "STACKIT_CLI_PROFILE", "STACKIT_PROJECT_ID", "STACKIT_REGION", "STACKIT_OUTPUT_FORMAT" and "STACKIT_ACCESS_TOKEN".`,
+		),
+		Args: args.NoArgs,
+		Run:  utils.CmdHelp,
+	}
+	addSubcommands(cmd, params)
+	return cmd
+}
+
+func addSubcommands(cmd *cobra.Command, params *types.CmdParams) {
+	cmd.AddCommand(list.NewCmd(params))
+}
@@ -2,7 +2,9 @@ package backup
 
 import (
 	"github.com/stackitcloud/stackit-cli/internal/cmd/postgresflex/backup/describe"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/postgresflex/backup/download"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/postgresflex/backup/list"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/postgresflex/backup/restore"
 	updateschedule "github.com/stackitcloud/stackit-cli/internal/cmd/postgresflex/backup/update-schedule"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
@@ -26,5 +28,7 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 func addSubcommands(cmd *cobra.Command, params *types.CmdParams) {
 	cmd.AddCommand(list.NewCmd(params))
 	cmd.AddCommand(describe.NewCmd(params))
+	cmd.AddCommand(restore.NewCmd(params))
+	cmd.AddCommand(download.NewCmd(params))
 	cmd.AddCommand(updateschedule.NewCmd(params)) //nolint:staticcheck // Command is deprecated but must be kept for backward compatibility
 }
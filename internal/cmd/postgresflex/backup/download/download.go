@@ -0,0 +1,213 @@
+package download
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	postgresflex "github.com/stackitcloud/stackit-sdk-go/services/postgresflex/v3api"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	cliErr "github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/services/postgresflex/client"
+	postgresflexUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/postgresflex/utils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+)
+
+const (
+	instanceIdFlag          = "instance-id"
+	backupIdFlag            = "backup-id"
+	outputFlag              = "output"
+	noProgressIndicatorFlag = "no-progress-indicator"
+
+	progressInterval = 2 * time.Second
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+
+	InstanceId          string
+	BackupId            int64
+	Output              string
+	NoProgressIndicator bool
+}
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "download",
+		Short: "Downloads a backup of a PostgreSQL Flex instance",
+		Long: fmt.Sprintf("%s\n%s",
+			"Downloads a backup of a PostgreSQL Flex instance to a local file.",
+			"The backup is streamed to the file, the progress of the download is shown unless the --no-progress-indicator flag is set.",
+		),
+		Args: args.NoArgs,
+		Example: examples.Build(
+			examples.NewExample(
+				`Download the backup with ID "42" of a PostgreSQL Flex instance with ID "xxx" to the file "backup.tar.gz"`,
+				`$ stackit postgresflex backup download --instance-id xxx --backup-id 42 --output backup.tar.gz`),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			// Configure API client
+			apiClient, err := client.ConfigureClient(params.Printer, params.CliVersion)
+			if err != nil {
+				return err
+			}
+
+			instanceLabel, err := postgresflexUtils.GetInstanceName(ctx, apiClient.DefaultAPI, model.ProjectId, model.Region, model.InstanceId)
+			if err != nil {
+				params.Printer.Debug(print.ErrorLevel, "get instance name: %v", err)
+				instanceLabel = model.InstanceId
+			}
+
+			// Call API
+			resp, err := buildRequest(ctx, model, apiClient).Execute()
+			if err != nil {
+				return fmt.Errorf("get download URL of PostgreSQL Flex backup: %w", err)
+			}
+			if resp == nil {
+				return fmt.Errorf("get download URL of PostgreSQL Flex backup: empty response")
+			}
+
+			written, err := downloadFile(ctx, params.Printer, resp.Url, model.Output, !model.NoProgressIndicator)
+			if err != nil {
+				return fmt.Errorf("download PostgreSQL Flex backup: %w", err)
+			}
+
+			params.Printer.Outputf("Downloaded backup %d of instance %q to %q (%d bytes)\n", model.BackupId, instanceLabel, model.Output, written)
+			return nil
+		},
+	}
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().Var(flags.UUIDFlag(), instanceIdFlag, "Instance ID")
+	cmd.Flags().Int64(backupIdFlag, 0, "ID of the backup to download")
+	cmd.Flags().String(outputFlag, "", "Path of the file the backup is written to")
+	cmd.Flags().Bool(noProgressIndicatorFlag, false, "Show no progress indicator for the download")
+
+	err := flags.MarkFlagsRequired(cmd, instanceIdFlag, backupIdFlag, outputFlag)
+	cobra.CheckErr(err)
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
+	globalFlags := globalflags.Parse(p, cmd)
+	if globalFlags.ProjectId == "" {
+		return nil, &cliErr.ProjectIdError{}
+	}
+
+	model := inputModel{
+		GlobalFlagModel:     globalFlags,
+		InstanceId:          flags.FlagToStringValue(p, cmd, instanceIdFlag),
+		BackupId:            flags.FlagWithDefaultToInt64Value(p, cmd, backupIdFlag),
+		Output:              flags.FlagToStringValue(p, cmd, outputFlag),
+		NoProgressIndicator: flags.FlagToBoolValue(p, cmd, noProgressIndicatorFlag),
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+func buildRequest(ctx context.Context, model *inputModel, apiClient *postgresflex.APIClient) postgresflex.ApiGetBackupDownloadUrlRequest {
+	return apiClient.DefaultAPI.GetBackupDownloadUrl(ctx, model.ProjectId, model.Region, model.InstanceId, model.BackupId)
+}
+
+// downloadFile streams the file at downloadURL to path, readable only by the current user.
+// The backups can get arbitrarily large, so they are not loaded into memory.
+// The URL is presigned, so it is kept out of the logs and errors, anyone knowing it could download the backup.
+func downloadFile(ctx context.Context, p *print.Printer, downloadURL, path string, showProgress bool) (written int64, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, downloadURL, http.NoBody)
+	if err != nil {
+		return 0, fmt.Errorf("create request: %w", withoutURL(err))
+	}
+	p.Debug(print.DebugLevel, "downloading backup from %s", req.URL.Host)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("contact server: %w", withoutURL(err))
+	}
+	defer func() {
+		if inner := resp.Body.Close(); inner != nil && err == nil {
+			err = fmt.Errorf("close response body: %w", inner)
+		}
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("server responded with %s", resp.Status)
+	}
+
+	out, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600) //nolint:gosec // the file path is chosen by the user
+	if err != nil {
+		return 0, fmt.Errorf("create file: %w", err)
+	}
+
+	var reader io.Reader = resp.Body
+	if showProgress {
+		reader = io.TeeReader(resp.Body, &progressWriter{p: p, total: resp.ContentLength})
+	}
+	written, err = io.Copy(out, reader)
+	if err == nil {
+		err = out.Close()
+	} else {
+		_ = out.Close()
+	}
+	if err != nil {
+		_ = os.Remove(path)
+		return written, fmt.Errorf("write file: %w", err)
+	}
+	return written, nil
+}
+
+var _ io.Writer = (*progressWriter)(nil)
+
+// progressWriter counts the bytes written to it and periodically prints the progress
+// withoutURL strips the URL from the errors of the HTTP client
+func withoutURL(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return urlErr.Err
+	}
+	return err
+}
+
+type progressWriter struct {
+	p *print.Printer
+	// total is the expected number of bytes, or -1 if unknown
+	total      int64
+	written    int64
+	lastUpdate time.Time
+}
+
+// Write implements io.Writer.
+func (w *progressWriter) Write(b []byte) (int, error) {
+	w.written += int64(len(b))
+	if time.Since(w.lastUpdate) >= progressInterval {
+		w.lastUpdate = time.Now()
+		w.p.Info("%s\r", w.progress())
+	}
+	return len(b), nil
+}
+
+func (w *progressWriter) progress() string {
+	if w.total <= 0 {
+		return fmt.Sprintf("downloaded %d bytes", w.written)
+	}
+	return fmt.Sprintf("downloaded %3.1f%%", 100.0/float64(w.total)*float64(w.written))
+}
@@ -0,0 +1,270 @@
+package download
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	postgresflex "github.com/stackitcloud/stackit-sdk-go/services/postgresflex/v3api"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testparams"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testutils"
+)
+
+type testCtxKey struct{}
+
+const (
+	testRegion   = "eu01"
+	testBackupId = int64(42)
+	testOutput   = "backup.tar.gz"
+)
+
+var testCtx = context.WithValue(context.Background(), testCtxKey{}, "foo")
+var testClient = &postgresflex.APIClient{DefaultAPI: &postgresflex.DefaultAPIService{}}
+
+var testProjectId = uuid.NewString()
+var testInstanceId = uuid.NewString()
+
+func fixtureFlagValues(mods ...func(flagValues map[string]string)) map[string]string {
+	flagValues := map[string]string{
+		globalflags.ProjectIdFlag: testProjectId,
+		globalflags.RegionFlag:    testRegion,
+		instanceIdFlag:            testInstanceId,
+		backupIdFlag:              "42",
+		outputFlag:                testOutput,
+	}
+	for _, mod := range mods {
+		mod(flagValues)
+	}
+	return flagValues
+}
+
+func fixtureInputModel(mods ...func(model *inputModel)) *inputModel {
+	model := &inputModel{
+		GlobalFlagModel: &globalflags.GlobalFlagModel{
+			ProjectId: testProjectId,
+			Region:    testRegion,
+			Verbosity: globalflags.VerbosityDefault,
+		},
+		InstanceId: testInstanceId,
+		BackupId:   testBackupId,
+		Output:     testOutput,
+	}
+	for _, mod := range mods {
+		mod(model)
+	}
+	return model
+}
+
+func fixtureRequest(mods ...func(request *postgresflex.ApiGetBackupDownloadUrlRequest)) postgresflex.ApiGetBackupDownloadUrlRequest {
+	request := testClient.DefaultAPI.GetBackupDownloadUrl(testCtx, testProjectId, testRegion, testInstanceId, testBackupId)
+	for _, mod := range mods {
+		mod(&request)
+	}
+	return request
+}
+
+func TestParseInput(t *testing.T) {
+	tests := []struct {
+		description   string
+		argValues     []string
+		flagValues    map[string]string
+		isValid       bool
+		expectedModel *inputModel
+	}{
+		{
+			description:   "base",
+			flagValues:    fixtureFlagValues(),
+			isValid:       true,
+			expectedModel: fixtureInputModel(),
+		},
+		{
+			description: "no progress indicator",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[noProgressIndicatorFlag] = "true"
+			}),
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.NoProgressIndicator = true
+			}),
+		},
+		{
+			description: "no values",
+			flagValues:  map[string]string{},
+			isValid:     false,
+		},
+		{
+			description: "project id missing",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, globalflags.ProjectIdFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "instance id invalid",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[instanceIdFlag] = "invalid-uuid"
+			}),
+			isValid: false,
+		},
+		{
+			description: "backup id missing",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, backupIdFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "backup id invalid",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[backupIdFlag] = "invalid"
+			}),
+			isValid: false,
+		},
+		{
+			description: "output missing",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, outputFlag)
+			}),
+			isValid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			testutils.TestParseInput(t, NewCmd, parseInput, tt.expectedModel, tt.argValues, tt.flagValues, tt.isValid)
+		})
+	}
+}
+
+func TestBuildRequest(t *testing.T) {
+	request := buildRequest(testCtx, fixtureInputModel(), testClient)
+	expectedRequest := fixtureRequest()
+
+	diff := cmp.Diff(request, expectedRequest,
+		cmp.AllowUnexported(expectedRequest),
+		cmpopts.EquateComparable(testCtx, postgresflex.DefaultAPIService{}),
+	)
+	if diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+}
+
+func TestDownloadFile(t *testing.T) {
+	tests := []struct {
+		description  string
+		statusCode   int
+		showProgress bool
+		isValid      bool
+	}{
+		{
+			description: "base",
+			statusCode:  http.StatusOK,
+			isValid:     true,
+		},
+		{
+			description:  "with progress",
+			statusCode:   http.StatusOK,
+			showProgress: true,
+			isValid:      true,
+		},
+		{
+			description: "server error",
+			statusCode:  http.StatusForbidden,
+			isValid:     false,
+		},
+	}
+
+	params := testparams.NewTestParams()
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tt.statusCode)
+				_, _ = w.Write([]byte("backup"))
+			}))
+			defer server.Close()
+
+			path := filepath.Join(t.TempDir(), testOutput)
+			written, err := downloadFile(context.Background(), params.Printer, server.URL, path, tt.showProgress)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("did not fail on invalid input")
+				}
+				if _, err := os.Stat(path); !os.IsNotExist(err) {
+					t.Fatalf("expected no file to be written")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			if written != int64(len("backup")) {
+				t.Fatalf("expected %d bytes to be written, got %d", len("backup"), written)
+			}
+			content, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf("read downloaded file: %v", err)
+			}
+			if string(content) != "backup" {
+				t.Fatalf("expected content %q, got %q", "backup", string(content))
+			}
+		})
+	}
+}
+
+func TestDownloadFileHidesURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	downloadURL := server.URL + "/backup?X-Amz-Signature=secret"
+	server.Close()
+
+	params := testparams.NewTestParams()
+	path := filepath.Join(t.TempDir(), testOutput)
+	_, err := downloadFile(context.Background(), params.Printer, downloadURL, path, false)
+	if err == nil {
+		t.Fatalf("did not fail on unreachable server")
+	}
+	if strings.Contains(err.Error(), "secret") {
+		t.Fatalf("expected error without the download URL, got %q", err)
+	}
+}
+
+func TestProgress(t *testing.T) {
+	tests := []struct {
+		description string
+		total       int64
+		written     int64
+		expected    string
+	}{
+		{
+			description: "known size",
+			total:       200,
+			written:     50,
+			expected:    "downloaded 25.0%",
+		},
+		{
+			description: "unknown size",
+			total:       -1,
+			written:     50,
+			expected:    "downloaded 50 bytes",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			w := &progressWriter{total: tt.total, written: tt.written}
+			if got := w.progress(); got != tt.expected {
+				t.Fatalf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package restore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	postgresflex "github.com/stackitcloud/stackit-sdk-go/services/postgresflex/v3api"
+	"github.com/stackitcloud/stackit-sdk-go/services/postgresflex/v3api/wait"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	cliErr "github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/services/postgresflex/client"
+	postgresflexUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/postgresflex/utils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/spinner"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+)
+
+const (
+	instanceIdFlag = "instance-id"
+	backupIdFlag   = "backup-id"
+	timestampFlag  = "timestamp"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+
+	InstanceId string
+	BackupId   *int64
+	Timestamp  *time.Time
+}
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Restores a PostgreSQL Flex instance from a backup",
+		Long: fmt.Sprintf("%s\n%s\n%s",
+			"Restores a PostgreSQL Flex instance to a point in time. The current data of the instance is overwritten.",
+			`The point in time can be specified by a timestamp or by a backup ID, in which case the completion time of the backup is used.`,
+			`To restore into a new instance instead, use the "stackit postgresflex instance clone" command.`,
+		),
+		Args: args.NoArgs,
+		Example: examples.Build(
+			examples.NewExample(
+				`Restore a PostgreSQL Flex instance with ID "xxx" from backup with ID "42"`,
+				`$ stackit postgresflex backup restore --instance-id xxx --backup-id 42`),
+			examples.NewExample(
+				`Restore a PostgreSQL Flex instance with ID "xxx" to timestamp "2024-05-14T14:31:48Z"`,
+				`$ stackit postgresflex backup restore --instance-id xxx --timestamp 2024-05-14T14:31:48Z`),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			// Configure API client
+			apiClient, err := client.ConfigureClient(params.Printer, params.CliVersion)
+			if err != nil {
+				return err
+			}
+
+			instanceLabel, err := postgresflexUtils.GetInstanceName(ctx, apiClient.DefaultAPI, model.ProjectId, model.Region, model.InstanceId)
+			if err != nil {
+				params.Printer.Debug(print.ErrorLevel, "get instance name: %v", err)
+				instanceLabel = model.InstanceId
+			}
+
+			restoreTime, err := getRestoreTime(ctx, model, apiClient)
+			if err != nil {
+				return err
+			}
+
+			// Restoring overwrites the data of the instance, so the instance name has to be typed to confirm
+			prompt := fmt.Sprintf("Are you sure you want to restore instance %q to %s? (The current data of the instance will be overwritten)", instanceLabel, restoreTime.Format(time.RFC3339))
+			err = params.Printer.PromptForStrongConfirmation(prompt, instanceLabel)
+			if err != nil {
+				return err
+			}
+
+			// Call API
+			err = buildRequest(ctx, model, apiClient, restoreTime).Execute()
+			if err != nil {
+				return fmt.Errorf("restore PostgreSQL Flex instance: %w", err)
+			}
+
+			// Wait for async operation, if async mode not enabled
+			if !model.Async {
+				err := spinner.Run(params.Printer, "Restoring instance", func() error {
+					_, err = wait.PartialUpdateInstanceWaitHandler(ctx, apiClient.DefaultAPI, model.ProjectId, model.Region, model.InstanceId).WaitWithContext(ctx)
+					return err
+				})
+				if err != nil {
+					return fmt.Errorf("wait for PostgreSQL Flex instance restoration: %w", err)
+				}
+			}
+
+			operationState := "Restored"
+			if model.Async {
+				operationState = "Triggered restore of"
+			}
+			params.Printer.Outputf("%s instance %q to %s\n", operationState, instanceLabel, restoreTime.Format(time.RFC3339))
+			return nil
+		},
+	}
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().Var(flags.UUIDFlag(), instanceIdFlag, "Instance ID")
+	cmd.Flags().Int64(backupIdFlag, 0, "ID of the backup to restore")
+	cmd.Flags().String(timestampFlag, "", "Timestamp to restore to, in a date-time with the RFC3339 layout format, e.g. 2024-01-01T00:00:00Z")
+
+	err := flags.MarkFlagsRequired(cmd, instanceIdFlag)
+	cobra.CheckErr(err)
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
+	globalFlags := globalflags.Parse(p, cmd)
+	if globalFlags.ProjectId == "" {
+		return nil, &cliErr.ProjectIdError{}
+	}
+
+	backupId := flags.FlagToInt64Pointer(p, cmd, backupIdFlag)
+	timestamp, err := flags.FlagToDateTimePointer(p, cmd, timestampFlag, time.RFC3339)
+	if err != nil {
+		return nil, &cliErr.FlagValidationError{
+			Flag:    timestampFlag,
+			Details: err.Error(),
+		}
+	}
+	if (backupId == nil) == (timestamp == nil) {
+		return nil, &cliErr.RequiredMutuallyExclusiveFlagsError{
+			Flags: []string{backupIdFlag, timestampFlag},
+		}
+	}
+	if timestamp != nil && timestamp.After(time.Now()) {
+		return nil, &cliErr.FlagValidationError{
+			Flag:    timestampFlag,
+			Details: "must not be in the future",
+		}
+	}
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		InstanceId:      flags.FlagToStringValue(p, cmd, instanceIdFlag),
+		BackupId:        backupId,
+		Timestamp:       timestamp,
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+// getRestoreTime returns the point in time to restore to.
+// If a backup ID is set, the completion time of the backup is used.
+func getRestoreTime(ctx context.Context, model *inputModel, apiClient *postgresflex.APIClient) (time.Time, error) {
+	if model.Timestamp != nil {
+		return *model.Timestamp, nil
+	}
+	if model.BackupId == nil {
+		return time.Time{}, fmt.Errorf("neither timestamp nor backup ID set")
+	}
+
+	backup, err := apiClient.DefaultAPI.GetBackup(ctx, model.ProjectId, model.Region, model.InstanceId, *model.BackupId).Execute()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("get PostgreSQL Flex backup: %w", err)
+	}
+	completionTime, err := time.Parse(time.RFC3339, backup.CompletionTime)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse backup completion time: %w", err)
+	}
+	return completionTime, nil
+}
+
+func buildRequest(ctx context.Context, model *inputModel, apiClient *postgresflex.APIClient, restoreTime time.Time) postgresflex.ApiRestoreInstanceRequest {
+	req := apiClient.DefaultAPI.RestoreInstance(ctx, model.ProjectId, model.Region, model.InstanceId)
+	req = req.RestoreInstancePayload(postgresflex.RestoreInstancePayload{
+		PointInTime: restoreTime,
+	})
+	return req
+}
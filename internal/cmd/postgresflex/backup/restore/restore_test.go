@@ -0,0 +1,195 @@
+package restore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	postgresflex "github.com/stackitcloud/stackit-sdk-go/services/postgresflex/v3api"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testutils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+)
+
+type testCtxKey struct{}
+
+const (
+	testRegion    = "eu01"
+	testTimestamp = "2024-05-14T14:31:48Z"
+)
+
+var testCtx = context.WithValue(context.Background(), testCtxKey{}, "foo")
+var testClient = &postgresflex.APIClient{DefaultAPI: &postgresflex.DefaultAPIService{}}
+
+var testProjectId = uuid.NewString()
+var testInstanceId = uuid.NewString()
+var testTime, _ = time.Parse(time.RFC3339, testTimestamp)
+
+func fixtureFlagValues(mods ...func(flagValues map[string]string)) map[string]string {
+	flagValues := map[string]string{
+		globalflags.ProjectIdFlag: testProjectId,
+		globalflags.RegionFlag:    testRegion,
+		instanceIdFlag:            testInstanceId,
+		timestampFlag:             testTimestamp,
+	}
+	for _, mod := range mods {
+		mod(flagValues)
+	}
+	return flagValues
+}
+
+func fixtureInputModel(mods ...func(model *inputModel)) *inputModel {
+	model := &inputModel{
+		GlobalFlagModel: &globalflags.GlobalFlagModel{
+			ProjectId: testProjectId,
+			Region:    testRegion,
+			Verbosity: globalflags.VerbosityDefault,
+		},
+		InstanceId: testInstanceId,
+		Timestamp:  utils.Ptr(testTime),
+	}
+	for _, mod := range mods {
+		mod(model)
+	}
+	return model
+}
+
+func fixtureRequest(mods ...func(request *postgresflex.ApiRestoreInstanceRequest)) postgresflex.ApiRestoreInstanceRequest {
+	request := testClient.DefaultAPI.RestoreInstance(testCtx, testProjectId, testRegion, testInstanceId)
+	request = request.RestoreInstancePayload(postgresflex.RestoreInstancePayload{
+		PointInTime: testTime,
+	})
+	for _, mod := range mods {
+		mod(&request)
+	}
+	return request
+}
+
+func TestParseInput(t *testing.T) {
+	tests := []struct {
+		description   string
+		argValues     []string
+		flagValues    map[string]string
+		isValid       bool
+		expectedModel *inputModel
+	}{
+		{
+			description:   "base",
+			flagValues:    fixtureFlagValues(),
+			isValid:       true,
+			expectedModel: fixtureInputModel(),
+		},
+		{
+			description: "backup id instead of timestamp",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, timestampFlag)
+				flagValues[backupIdFlag] = "42"
+			}),
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.Timestamp = nil
+				model.BackupId = utils.Ptr(int64(42))
+			}),
+		},
+		{
+			description: "no values",
+			flagValues:  map[string]string{},
+			isValid:     false,
+		},
+		{
+			description: "project id missing",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, globalflags.ProjectIdFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "instance id missing",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, instanceIdFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "instance id invalid",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[instanceIdFlag] = "invalid-uuid"
+			}),
+			isValid: false,
+		},
+		{
+			description: "backup id and timestamp missing",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, timestampFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "backup id and timestamp both set",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[backupIdFlag] = "42"
+			}),
+			isValid: false,
+		},
+		{
+			description: "backup id invalid",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, timestampFlag)
+				flagValues[backupIdFlag] = "invalid"
+			}),
+			isValid: false,
+		},
+		{
+			description: "timestamp invalid",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[timestampFlag] = "2024-05-14 14:31:48"
+			}),
+			isValid: false,
+		},
+		{
+			description: "timestamp in the future",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[timestampFlag] = time.Now().Add(time.Hour).Format(time.RFC3339)
+			}),
+			isValid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			testutils.TestParseInput(t, NewCmd, parseInput, tt.expectedModel, tt.argValues, tt.flagValues, tt.isValid)
+		})
+	}
+}
+
+func TestBuildRequest(t *testing.T) {
+	tests := []struct {
+		description     string
+		model           *inputModel
+		expectedRequest postgresflex.ApiRestoreInstanceRequest
+	}{
+		{
+			description:     "base",
+			model:           fixtureInputModel(),
+			expectedRequest: fixtureRequest(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			request := buildRequest(testCtx, tt.model, testClient, testTime)
+
+			diff := cmp.Diff(request, tt.expectedRequest,
+				cmp.AllowUnexported(tt.expectedRequest),
+				cmpopts.EquateComparable(testCtx, postgresflex.DefaultAPIService{}),
+			)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
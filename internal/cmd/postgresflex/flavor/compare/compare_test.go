@@ -0,0 +1,189 @@
+package compare
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	postgresflex "github.com/stackitcloud/stackit-sdk-go/services/postgresflex/v3api"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flexsizing"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testutils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+)
+
+type testCtxKey struct{}
+
+var testCtx = context.WithValue(context.Background(), testCtxKey{}, "foo")
+var testProjectId = uuid.NewString()
+
+const (
+	testRegion = "eu01"
+)
+
+func fixtureFlagValues(mods ...func(flagValues map[string]string)) map[string]string {
+	flagValues := map[string]string{
+		globalflags.ProjectIdFlag: testProjectId,
+		globalflags.RegionFlag:    testRegion,
+		flexsizing.CPUFlag:        "4",
+		flexsizing.RAMFlag:        "16",
+		flexsizing.StorageFlag:    "100",
+	}
+	for _, mod := range mods {
+		mod(flagValues)
+	}
+	return flagValues
+}
+
+func fixtureInputModel(mods ...func(model *inputModel)) *inputModel {
+	model := &inputModel{
+		GlobalFlagModel: &globalflags.GlobalFlagModel{
+			ProjectId: testProjectId,
+			Region:    testRegion,
+			Verbosity: globalflags.VerbosityDefault,
+		},
+		Query: &flexsizing.Query{
+			CPU:     utils.Ptr(int64(4)),
+			RAM:     utils.Ptr(int64(16)),
+			Storage: utils.Ptr(int64(100)),
+		},
+	}
+	for _, mod := range mods {
+		mod(model)
+	}
+	return model
+}
+
+func TestParseInput(t *testing.T) {
+	tests := []struct {
+		description   string
+		argValues     []string
+		flagValues    map[string]string
+		isValid       bool
+		expectedModel *inputModel
+	}{
+		{
+			description:   "base",
+			flagValues:    fixtureFlagValues(),
+			isValid:       true,
+			expectedModel: fixtureInputModel(),
+		},
+		{
+			description: "planned flavor",
+			flagValues: map[string]string{
+				globalflags.ProjectIdFlag:   testProjectId,
+				globalflags.RegionFlag:      testRegion,
+				flexsizing.FlavorIdFlag:     "flavor",
+				flexsizing.StorageClassFlag: "premium-perf2-stackit",
+			},
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.Query = &flexsizing.Query{
+					FlavorId:     utils.Ptr("flavor"),
+					StorageClass: utils.Ptr("premium-perf2-stackit"),
+				}
+			}),
+		},
+		{
+			description: "project id missing",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, globalflags.ProjectIdFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "storage invalid",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[flexsizing.StorageFlag] = "-1"
+			}),
+			isValid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			testutils.TestParseInput(t, NewCmd, parseInput, tt.expectedModel, tt.argValues, tt.flagValues, tt.isValid)
+		})
+	}
+}
+
+func TestLoadOptions(t *testing.T) {
+	tests := []struct {
+		description       string
+		listVersionsFails bool
+		isValid           bool
+		expectedOptions   []flexsizing.Option
+	}{
+		{
+			description: "base",
+			isValid:     true,
+			expectedOptions: []flexsizing.Option{
+				{
+					FlavorId:       "flavor",
+					CPU:            4,
+					RAM:            16,
+					NodeType:       "Single",
+					MinStorage:     5,
+					MaxStorage:     4000,
+					StorageClasses: []string{"premium-perf2-stackit"},
+					Versions:       []string{"16", "17"},
+				},
+			},
+		},
+		{
+			description:       "list versions fails",
+			listVersionsFails: true,
+			isValid:           false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			apiClient := postgresflex.DefaultAPIServiceMock{
+				ListFlavorsExecuteMock: utils.Ptr(func(_ postgresflex.ApiListFlavorsRequest) (*postgresflex.ListFlavorsResponse, error) {
+					return &postgresflex.ListFlavorsResponse{
+						Flavors: []postgresflex.ListFlavors{
+							{
+								Id:       "flavor",
+								Cpu:      4,
+								Memory:   16,
+								NodeType: "Single",
+								MinGB:    5,
+								MaxGB:    4000,
+								StorageClasses: []postgresflex.FlavorStorageClassesStorageClass{
+									{Class: "premium-perf2-stackit"},
+								},
+							},
+						},
+					}, nil
+				}),
+				ListVersionsExecuteMock: utils.Ptr(func(_ postgresflex.ApiListVersionsRequest) (*postgresflex.ListVersionsResponse, error) {
+					if tt.listVersionsFails {
+						return nil, fmt.Errorf("list versions failed")
+					}
+					return &postgresflex.ListVersionsResponse{
+						Versions: []postgresflex.Version{{Version: "16"}, {Version: "17"}},
+					}, nil
+				}),
+			}
+
+			options, err := loadOptions(testCtx, fixtureInputModel(), apiClient)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("should have failed")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("should not have failed: %v", err)
+			}
+			diff := cmp.Diff(options, tt.expectedOptions)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package flavor
 
 import (
+	"github.com/stackitcloud/stackit-cli/internal/cmd/postgresflex/flavor/compare"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/postgresflex/flavor/describe"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/postgresflex/flavor/list"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
@@ -25,4 +26,5 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 func addSubcommands(cmd *cobra.Command, params *types.CmdParams) {
 	cmd.AddCommand(list.NewCmd(params))
 	cmd.AddCommand(describe.NewCmd(params))
+	cmd.AddCommand(compare.NewCmd(params))
 }
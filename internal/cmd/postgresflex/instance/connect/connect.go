@@ -0,0 +1,196 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	postgresflex "github.com/stackitcloud/stackit-sdk-go/services/postgresflex/v3api"
+	"github.com/stackitcloud/stackit-sdk-go/services/postgresflex/v3api/wait"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dbconnect"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dbcredentials"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/services/postgresflex/client"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+)
+
+const (
+	instanceIdArg = "INSTANCE_ID"
+
+	userIdFlag   = "user-id"
+	databaseFlag = "database"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+
+	InstanceId     string
+	UserId         int64
+	Database       string
+	ConnectOptions *dbconnect.Options
+}
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("connect %s", instanceIdArg),
+		Short: "Connects to a PostgreSQL Flex instance",
+		Long: fmt.Sprintf("%s\n%s\n%s",
+			"Connects to a PostgreSQL Flex instance by temporarily adding the public IP address of the caller to the ACL of the instance.",
+			`If "psql" is installed, it is launched with the connection URI of the user and the ACL entry is removed when it exits. Otherwise the connection URI is printed and the ACL entry is removed on Ctrl-C.`,
+			"The public IP address is detected using api.ipify.org, unless it is set with the --ip flag.",
+		),
+		Args: args.SingleArg(instanceIdArg, utils.ValidateUUID),
+		Example: examples.Build(
+			examples.NewExample(
+				`Connect to a PostgreSQL Flex instance with ID "xxx" as user with ID "1"`,
+				"$ stackit postgresflex instance connect xxx --user-id 1"),
+			examples.NewExample(
+				`Connect to database "my-database" of a PostgreSQL Flex instance with ID "xxx" as user with ID "1"`,
+				"$ stackit postgresflex instance connect xxx --user-id 1 --database my-database"),
+			examples.NewExample(
+				`Allow IP address "1.2.3.4" to connect to a PostgreSQL Flex instance with ID "xxx" and print the connection URI of user with ID "1"`,
+				"$ stackit postgresflex instance connect xxx --user-id 1 --ip 1.2.3.4 --no-launch"),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			// Configure API client
+			apiClient, err := client.ConfigureClient(params.Printer, params.CliVersion)
+			if err != nil {
+				return err
+			}
+
+			instance, err := apiClient.DefaultAPI.GetInstance(ctx, model.ProjectId, model.Region, model.InstanceId).Execute()
+			if err != nil {
+				return fmt.Errorf("get PostgreSQL Flex instance: %w", err)
+			}
+			instanceLabel := instance.Name
+			if instanceLabel == "" {
+				instanceLabel = model.InstanceId
+			}
+
+			user, err := apiClient.DefaultAPI.GetUser(ctx, model.ProjectId, model.Region, model.InstanceId, model.UserId).Execute()
+			if err != nil {
+				return fmt.Errorf("get PostgreSQL Flex user: %w", err)
+			}
+
+			ip := model.ConnectOptions.IP
+			if ip == "" {
+				ip, err = dbconnect.GetPublicIP(ctx)
+				if err != nil {
+					return fmt.Errorf("detect public IP address, set it with the --%s flag instead: %w", dbconnect.IPFlag, err)
+				}
+			}
+			aclEntry := dbconnect.ACLEntry(ip)
+
+			prompt := fmt.Sprintf("Are you sure you want to temporarily add %q to the ACL of instance %q?", aclEntry, instanceLabel)
+			err = params.Printer.PromptForConfirmation(prompt)
+			if err != nil {
+				return err
+			}
+
+			acl := &instanceACL{
+				apiClient:  apiClient.DefaultAPI,
+				projectId:  model.ProjectId,
+				region:     model.Region,
+				instanceId: model.InstanceId,
+			}
+			return dbconnect.Run(ctx, params.Printer, acl, buildTarget(instance, user, model.Database), aclEntry, model.ConnectOptions)
+		},
+	}
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().Int64(userIdFlag, 0, "ID of the user to connect as")
+	cmd.Flags().String(databaseFlag, "", "Name of the database to connect to. If not set, the default database of the client is used")
+	dbconnect.ConfigureFlags(cmd)
+
+	err := flags.MarkFlagsRequired(cmd, userIdFlag)
+	cobra.CheckErr(err)
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inputModel, error) {
+	instanceId := inputArgs[0]
+
+	globalFlags := globalflags.Parse(p, cmd)
+	if globalFlags.ProjectId == "" {
+		return nil, &errors.ProjectIdError{}
+	}
+
+	connectOptions, err := dbconnect.ParseFlags(p, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		InstanceId:      instanceId,
+		UserId:          flags.FlagWithDefaultToInt64Value(p, cmd, userIdFlag),
+		Database:        flags.FlagToStringValue(p, cmd, databaseFlag),
+		ConnectOptions:  connectOptions,
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+// buildTarget returns the target to connect to.
+// The user doesn't contain the host of the instance, so it is taken from the network of the instance.
+// The port is left to the default of the client.
+func buildTarget(instance *postgresflex.GetInstanceResponse, user *postgresflex.GetUserResponse, database string) *dbconnect.Target {
+	target := &dbconnect.Target{
+		Engine:   dbcredentials.EnginePostgreSQL,
+		Database: database,
+	}
+	if instance != nil {
+		target.Host = utils.PtrString(instance.Network.InstanceAddress)
+	}
+	if user != nil {
+		target.Username = user.Name
+	}
+	return target
+}
+
+// instanceACL reads and updates the ACL of a PostgreSQL Flex instance
+type instanceACL struct {
+	apiClient  postgresflex.DefaultAPI
+	projectId  string
+	region     string
+	instanceId string
+}
+
+func (a *instanceACL) Get(ctx context.Context) ([]string, error) {
+	resp, err := a.apiClient.GetInstance(ctx, a.projectId, a.region, a.instanceId).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get PostgreSQL Flex instance: %w", err)
+	}
+	return resp.Network.Acl, nil
+}
+
+func (a *instanceACL) Update(ctx context.Context, acl []string) error {
+	_, err := a.apiClient.PartialUpdateInstance(ctx, a.projectId, a.region, a.instanceId).
+		PartialUpdateInstancePayload(postgresflex.PartialUpdateInstancePayload{
+			Network: &postgresflex.InstanceNetworkOpt{Acl: acl},
+		}).Execute()
+	if err != nil {
+		return fmt.Errorf("update PostgreSQL Flex instance: %w", err)
+	}
+	_, err = wait.PartialUpdateInstanceWaitHandler(ctx, a.apiClient, a.projectId, a.region, a.instanceId).WaitWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("wait for PostgreSQL Flex instance update: %w", err)
+	}
+	return nil
+}
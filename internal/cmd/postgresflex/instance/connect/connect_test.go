@@ -0,0 +1,188 @@
+package connect
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	postgresflex "github.com/stackitcloud/stackit-sdk-go/services/postgresflex/v3api"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dbconnect"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dbcredentials"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testutils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+)
+
+var testProjectId = uuid.NewString()
+var testInstanceId = uuid.NewString()
+
+const (
+	testUserId = int64(12345)
+	testRegion = "eu01"
+)
+
+func fixtureArgValues(mods ...func(argValues []string)) []string {
+	argValues := []string{
+		testInstanceId,
+	}
+	for _, mod := range mods {
+		mod(argValues)
+	}
+	return argValues
+}
+
+func fixtureFlagValues(mods ...func(flagValues map[string]string)) map[string]string {
+	flagValues := map[string]string{
+		globalflags.ProjectIdFlag: testProjectId,
+		globalflags.RegionFlag:    testRegion,
+		userIdFlag:                strconv.FormatInt(testUserId, 10),
+	}
+	for _, mod := range mods {
+		mod(flagValues)
+	}
+	return flagValues
+}
+
+func fixtureInputModel(mods ...func(model *inputModel)) *inputModel {
+	model := &inputModel{
+		GlobalFlagModel: &globalflags.GlobalFlagModel{
+			ProjectId: testProjectId,
+			Region:    testRegion,
+			Verbosity: globalflags.VerbosityDefault,
+		},
+		InstanceId:     testInstanceId,
+		UserId:         testUserId,
+		ConnectOptions: &dbconnect.Options{},
+	}
+	for _, mod := range mods {
+		mod(model)
+	}
+	return model
+}
+
+func TestParseInput(t *testing.T) {
+	tests := []struct {
+		description   string
+		argValues     []string
+		flagValues    map[string]string
+		isValid       bool
+		expectedModel *inputModel
+	}{
+		{
+			description:   "base",
+			argValues:     fixtureArgValues(),
+			flagValues:    fixtureFlagValues(),
+			isValid:       true,
+			expectedModel: fixtureInputModel(),
+		},
+		{
+			description: "with database and connect options",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[databaseFlag] = "my-database"
+				flagValues[dbconnect.IPFlag] = "2001:db8::1"
+				flagValues[dbconnect.NoLaunchFlag] = "true"
+			}),
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.Database = "my-database"
+				model.ConnectOptions = &dbconnect.Options{IP: "2001:db8::1", NoLaunch: true}
+			}),
+		},
+		{
+			description: "no values",
+			argValues:   []string{},
+			flagValues:  map[string]string{},
+			isValid:     false,
+		},
+		{
+			description: "project id missing",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, globalflags.ProjectIdFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "instance id invalid",
+			argValues:   []string{"invalid-uuid"},
+			flagValues:  fixtureFlagValues(),
+			isValid:     false,
+		},
+		{
+			description: "user id missing",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, userIdFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "user id invalid",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[userIdFlag] = "invalid"
+			}),
+			isValid: false,
+		},
+		{
+			description: "ip invalid",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[dbconnect.IPFlag] = "invalid"
+			}),
+			isValid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			testutils.TestParseInput(t, NewCmd, parseInput, tt.expectedModel, tt.argValues, tt.flagValues, tt.isValid)
+		})
+	}
+}
+
+func TestBuildTarget(t *testing.T) {
+	tests := []struct {
+		description string
+		instance    *postgresflex.GetInstanceResponse
+		user        *postgresflex.GetUserResponse
+		database    string
+		expected    *dbconnect.Target
+	}{
+		{
+			description: "base",
+			instance: &postgresflex.GetInstanceResponse{
+				Network: postgresflex.InstanceNetwork{
+					InstanceAddress: utils.Ptr("host"),
+				},
+			},
+			user:     &postgresflex.GetUserResponse{Name: "user"},
+			database: "db",
+			expected: &dbconnect.Target{
+				Engine:   dbcredentials.EnginePostgreSQL,
+				Host:     "host",
+				Username: "user",
+				Database: "db",
+			},
+		},
+		{
+			description: "empty responses",
+			instance:    &postgresflex.GetInstanceResponse{},
+			user:        &postgresflex.GetUserResponse{},
+			expected:    &dbconnect.Target{Engine: dbcredentials.EnginePostgreSQL},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			target := buildTarget(tt.instance, tt.user, tt.database)
+			diff := cmp.Diff(target, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
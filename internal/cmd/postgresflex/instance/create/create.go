@@ -123,12 +123,17 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 				projectLabel = model.ProjectId
 			}
 
+			flavors, err := listFlavors(ctx, model, apiClient.DefaultAPI)
+			if err != nil {
+				return err
+			}
+
 			// load flavor id - remove after 2027-01-31
 			if model.FlavorId == nil {
 				// transform the model.FlavorId field from "*string" to "string" once this is removed
 				params.Printer.Warn("The --%s flag is not set, determining flavor ID by CPU und RAM. This behavior is deprecated, the --%s flag will be required after 2027-01-31.\n", flavorIdFlag, flavorIdFlag)
 			}
-			model.FlavorId, err = getFlavorId(ctx, model, apiClient.DefaultAPI)
+			model.FlavorId, err = getFlavorId(model, flavors)
 			if err != nil {
 				params.Printer.Debug(print.ErrorLevel, "determining flavor id: %v", err)
 			}
@@ -166,6 +171,12 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 				model.StorageClass = utils.Ptr(defaultStorageClass)
 			}
 
+			// Validate the planned flavor and storage before calling the API
+			err = validatePlan(model, flavors)
+			if err != nil {
+				return err
+			}
+
 			prompt := fmt.Sprintf("Are you sure you want to create a PostgreSQL Flex instance for project %q?", projectLabel)
 			err = params.Printer.PromptForConfirmation(prompt)
 			if err != nil {
@@ -300,8 +311,20 @@ func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel,
 	return &model, nil
 }
 
+// listFlavors returns the flavors available in the project, which are used to determine and validate the flavor
+func listFlavors(ctx context.Context, model *inputModel, apiClient postgresflex.DefaultAPI) ([]postgresflex.ListFlavors, error) {
+	flavors, err := apiClient.ListFlavors(ctx, model.ProjectId, model.Region).Size(100).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get PostgreSQL Flex flavors: %w", err)
+	}
+	if flavors == nil {
+		return nil, fmt.Errorf("get PostgreSQL Flex flavors: empty response")
+	}
+	return flavors.Flavors, nil
+}
+
 // Deprecated: remove after 2027-01-31
-func getFlavorId(ctx context.Context, model *inputModel, apiClient postgresflex.DefaultAPI) (*string, error) {
+func getFlavorId(model *inputModel, flavors []postgresflex.ListFlavors) (*string, error) {
 	if model == nil {
 		return nil, fmt.Errorf("model is nil")
 	}
@@ -310,13 +333,7 @@ func getFlavorId(ctx context.Context, model *inputModel, apiClient postgresflex.
 		return model.FlavorId, nil
 	}
 
-	// Load all flavors
-	flavors, err := apiClient.ListFlavors(ctx, model.ProjectId, model.Region).Size(100).Execute()
-	if err != nil {
-		return nil, fmt.Errorf("loading flavors: %w", err)
-	}
-
-	for _, flavor := range flavors.Flavors {
+	for _, flavor := range flavors {
 		if flavor.Cpu == *model.CPU && flavor.Memory == *model.RAM && flavor.NodeType == model.Type {
 			return &flavor.Id, nil
 		}
@@ -325,6 +342,15 @@ func getFlavorId(ctx context.Context, model *inputModel, apiClient postgresflex.
 	return nil, fmt.Errorf("no matching flavor found")
 }
 
+// validatePlan validates the flavor, storage class and storage size against the available flavors
+func validatePlan(model *inputModel, flavors []postgresflex.ListFlavors) error {
+	if model.FlavorId == nil {
+		// The flavor couldn't be determined, the API rejects the request
+		return nil
+	}
+	return postgresflexUtils.ValidatePlan(flavors, *model.FlavorId, model.StorageClass, model.StorageSize)
+}
+
 func buildRequest(ctx context.Context, model *inputModel, apiClient postgresflex.DefaultAPI) (postgresflex.ApiCreateInstanceRequest, error) {
 	req := apiClient.CreateInstance(ctx, model.ProjectId, model.Region)
 
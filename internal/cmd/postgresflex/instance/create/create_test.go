@@ -2,6 +2,7 @@ package create
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -294,6 +295,123 @@ func TestBuildRequest(t *testing.T) {
 	}
 }
 
+func TestListFlavors(t *testing.T) {
+	tests := []struct {
+		description      string
+		listFlavorsFails bool
+		listFlavorsResp  *postgresflex.ListFlavorsResponse
+		isValid          bool
+		expected         []postgresflex.ListFlavors
+	}{
+		{
+			description: "base",
+			listFlavorsResp: &postgresflex.ListFlavorsResponse{
+				Flavors: []postgresflex.ListFlavors{{Id: testFlavorId}},
+			},
+			isValid:  true,
+			expected: []postgresflex.ListFlavors{{Id: testFlavorId}},
+		},
+		{
+			description:      "list flavors fails",
+			listFlavorsFails: true,
+			isValid:          false,
+		},
+		{
+			description: "empty response",
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			apiClient := postgresflex.DefaultAPIServiceMock{
+				ListFlavorsExecuteMock: utils.Ptr(func(_ postgresflex.ApiListFlavorsRequest) (*postgresflex.ListFlavorsResponse, error) {
+					if tt.listFlavorsFails {
+						return nil, fmt.Errorf("list flavors failed")
+					}
+					return tt.listFlavorsResp, nil
+				}),
+			}
+
+			flavors, err := listFlavors(testCtx, fixtureInputModel(), apiClient)
+			if !tt.isValid && err == nil {
+				t.Fatalf("should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("should not have failed: %v", err)
+			}
+			if diff := cmp.Diff(flavors, tt.expected); diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestValidatePlan(t *testing.T) {
+	flavors := []postgresflex.ListFlavors{
+		{
+			Id:    testFlavorId,
+			MinGB: 5,
+			MaxGB: 4000,
+			StorageClasses: []postgresflex.FlavorStorageClassesStorageClass{
+				{Class: "premium-perf4-stackit"},
+			},
+		},
+	}
+
+	tests := []struct {
+		description string
+		model       *inputModel
+		isValid     bool
+	}{
+		{
+			description: "base",
+			model:       fixtureInputModel(),
+			isValid:     true,
+		},
+		{
+			description: "flavor not determined",
+			model: fixtureInputModel(func(model *inputModel) {
+				model.FlavorId = nil
+			}),
+			isValid: true,
+		},
+		{
+			description: "invalid flavor",
+			model: fixtureInputModel(func(model *inputModel) {
+				model.FlavorId = utils.Ptr("invalid-flavor")
+			}),
+			isValid: false,
+		},
+		{
+			description: "storage size out of range",
+			model: fixtureInputModel(func(model *inputModel) {
+				model.StorageSize = utils.Ptr(int64(4001))
+			}),
+			isValid: false,
+		},
+		{
+			description: "invalid storage class",
+			model: fixtureInputModel(func(model *inputModel) {
+				model.StorageClass = utils.Ptr("premium-perf6-stackit")
+			}),
+			isValid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := validatePlan(tt.model, flavors)
+			if !tt.isValid && err == nil {
+				t.Fatalf("should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("should not have failed: %v", err)
+			}
+		})
+	}
+}
+
 func Test_outputResult(t *testing.T) {
 	type args struct {
 		outputFormat string
@@ -2,11 +2,13 @@ package instance
 
 import (
 	"github.com/stackitcloud/stackit-cli/internal/cmd/postgresflex/instance/clone"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/postgresflex/instance/connect"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/postgresflex/instance/create"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/postgresflex/instance/delete"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/postgresflex/instance/describe"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/postgresflex/instance/list"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/postgresflex/instance/update"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/postgresflex/instance/upgrade"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
@@ -33,4 +35,6 @@ func addSubcommands(cmd *cobra.Command, params *types.CmdParams) {
 	cmd.AddCommand(update.NewCmd(params))
 	cmd.AddCommand(delete.NewCmd(params))
 	cmd.AddCommand(clone.NewCmd(params))
+	cmd.AddCommand(connect.NewCmd(params))
+	cmd.AddCommand(upgrade.NewCmd(params))
 }
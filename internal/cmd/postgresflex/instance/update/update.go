@@ -233,10 +233,13 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient postgresflex
 		return req, fmt.Errorf("failed to get instance %s: %w", model.InstanceId, err)
 	}
 
-	flavors, err := apiClient.ListFlavors(ctx, model.ProjectId, model.Region).Execute()
+	flavors, err := apiClient.ListFlavors(ctx, model.ProjectId, model.Region).Size(100).Execute()
 	if err != nil {
 		return req, fmt.Errorf("get PostgreSQL Flex flavors: %w", err)
 	}
+	if flavors == nil {
+		return req, fmt.Errorf("get PostgreSQL Flex flavors: empty response")
+	}
 
 	// if cpu/ram flags are used instead of the flavor id flag
 	if model.FlavorId == nil && (model.RAM != nil || model.CPU != nil) {
@@ -276,6 +279,12 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient postgresflex
 		flavorId = model.FlavorId
 	}
 
+	// Validate the planned flavor and storage size before calling the API
+	err = validatePlan(flavors.Flavors, currentInstance, flavorId, model.StorageSize)
+	if err != nil {
+		return req, err
+	}
+
 	var payloadNetwork *postgresflex.InstanceNetworkOpt
 	if model.ACL != nil {
 		payloadNetwork = &postgresflex.InstanceNetworkOpt{
@@ -303,6 +312,17 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient postgresflex
 	return req.PartialUpdateInstancePayload(payload), nil
 }
 
+// validatePlan validates the new flavor and storage size against the available flavors
+func validatePlan(flavors []postgresflex.ListFlavors, currentInstance *postgresflex.GetInstanceResponse, flavorId *string, storageSize *int64) error {
+	if flavorId == nil {
+		if storageSize == nil || currentInstance == nil {
+			return nil
+		}
+		flavorId = &currentInstance.FlavorId
+	}
+	return postgresflexUtils.ValidatePlan(flavors, *flavorId, nil, storageSize)
+}
+
 func outputResult(p *print.Printer, outputFormat string, async bool, instanceLabel string, resp *postgresflex.GetInstanceResponse) error {
 	return p.OutputResult(outputFormat, resp, func() error {
 		if resp == nil {
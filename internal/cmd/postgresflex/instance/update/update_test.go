@@ -344,9 +344,12 @@ func TestBuildRequest(t *testing.T) {
 		isValid            bool
 	}{
 		{
-			description:     "no values",
-			model:           fixtureRequiredInputModel(),
-			isValid:         true,
+			description: "no values",
+			model:       fixtureRequiredInputModel(),
+			isValid:     true,
+			mockClientSettings: mockSettings{
+				listFlavorsResp: &postgresflex.ListFlavorsResponse{},
+			},
 			expectedRequest: fixtureRequest(),
 		},
 		{
@@ -404,6 +407,15 @@ func TestBuildRequest(t *testing.T) {
 				getInstanceResp: &postgresflex.GetInstanceResponse{
 					FlavorId: testFlavorId,
 				},
+				listFlavorsResp: &postgresflex.ListFlavorsResponse{
+					Flavors: []postgresflex.ListFlavors{
+						{
+							Id:    testFlavorId,
+							MinGB: 5,
+							MaxGB: 4000,
+						},
+					},
+				},
 			},
 			expectedRequest: testClient.DefaultAPI.PartialUpdateInstance(testCtx, testProjectId, testRegion, testInstanceId).
 				PartialUpdateInstancePayload(postgresflex.PartialUpdateInstancePayload{
@@ -412,6 +424,48 @@ func TestBuildRequest(t *testing.T) {
 					},
 				}),
 		},
+		{
+			description: "storage size not supported by current flavor",
+			model: fixtureRequiredInputModel(func(model *inputModel) {
+				model.StorageSize = utils.Ptr(int64(5000))
+			}),
+			mockClientSettings: mockSettings{
+				getInstanceResp: &postgresflex.GetInstanceResponse{
+					FlavorId: testFlavorId,
+				},
+				listFlavorsResp: &postgresflex.ListFlavorsResponse{
+					Flavors: []postgresflex.ListFlavors{
+						{
+							Id:    testFlavorId,
+							MinGB: 5,
+							MaxGB: 4000,
+						},
+					},
+				},
+			},
+			isValid: false,
+		},
+		{
+			description: "flavor not available",
+			model: fixtureRequiredInputModel(func(model *inputModel) {
+				model.FlavorId = utils.Ptr("other-flavor")
+			}),
+			mockClientSettings: mockSettings{
+				listFlavorsResp: &postgresflex.ListFlavorsResponse{
+					Flavors: []postgresflex.ListFlavors{
+						{
+							Id: testFlavorId,
+						},
+					},
+				},
+			},
+			isValid: false,
+		},
+		{
+			description: "empty flavors response",
+			model:       fixtureRequiredInputModel(),
+			isValid:     false,
+		},
 		{
 			description: "get flavors fails",
 			model: fixtureRequiredInputModel(
@@ -0,0 +1,283 @@
+package upgrade
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	postgresflex "github.com/stackitcloud/stackit-sdk-go/services/postgresflex/v3api"
+	"github.com/stackitcloud/stackit-sdk-go/services/postgresflex/v3api/wait"
+	"golang.org/x/mod/semver"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	cliErr "github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/services/postgresflex/client"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/spinner"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+)
+
+const (
+	instanceIdArg = "INSTANCE_ID"
+
+	versionFlag   = "version"
+	skipCloneFlag = "skip-clone"
+	cloneNameFlag = "clone-name"
+
+	cloneNameSuffix = "-pre-upgrade"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+
+	InstanceId string
+	Version    string
+	SkipClone  bool
+	CloneName  *string
+}
+
+type upgradeResult struct {
+	InstanceId      string `json:"instanceId"`
+	PreviousVersion string `json:"previousVersion"`
+	Version         string `json:"version"`
+	CloneId         string `json:"cloneId,omitempty"`
+}
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("upgrade %s", instanceIdArg),
+		Short: "Upgrades a PostgreSQL Flex instance to a newer version",
+		Long: fmt.Sprintf("%s\n%s\n%s",
+			`Upgrades a PostgreSQL Flex instance to a newer version, which must be listed by "stackit postgresflex version list".`,
+			"Before the upgrade, the instance is cloned at the current point in time. The clone is kept as rollback target and is always waited for, so the upgrade is only triggered once it exists.",
+			fmt.Sprintf("Use the --%s flag to upgrade without a pre-upgrade clone.", skipCloneFlag),
+		),
+		Args: args.SingleArg(instanceIdArg, utils.ValidateUUID),
+		Example: examples.Build(
+			examples.NewExample(
+				`Upgrade a PostgreSQL Flex instance with ID "xxx" to version 17`,
+				"$ stackit postgresflex instance upgrade xxx --version 17"),
+			examples.NewExample(
+				`Upgrade a PostgreSQL Flex instance with ID "xxx" to version 17 and name the pre-upgrade clone "my-instance-backup"`,
+				"$ stackit postgresflex instance upgrade xxx --version 17 --clone-name my-instance-backup"),
+			examples.NewExample(
+				`Upgrade a PostgreSQL Flex instance with ID "xxx" to version 17 without a pre-upgrade clone`,
+				"$ stackit postgresflex instance upgrade xxx --version 17 --skip-clone"),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			// Configure API client
+			apiClient, err := client.ConfigureClient(params.Printer, params.CliVersion)
+			if err != nil {
+				return err
+			}
+
+			instance, err := apiClient.DefaultAPI.GetInstance(ctx, model.ProjectId, model.Region, model.InstanceId).Execute()
+			if err != nil {
+				return fmt.Errorf("get PostgreSQL Flex instance: %w", err)
+			}
+			instanceLabel := instance.Name
+			if instanceLabel == "" {
+				instanceLabel = model.InstanceId
+			}
+
+			versions, err := apiClient.DefaultAPI.ListVersions(ctx, model.ProjectId, model.Region).Execute()
+			if err != nil {
+				return fmt.Errorf("get PostgreSQL Flex versions: %w", err)
+			}
+			target, err := validateUpgrade(instance.Version, model.Version, versions.Versions)
+			if err != nil {
+				return err
+			}
+			if target.Deprecated != "" {
+				params.Printer.Warn("Version %s is deprecated (%s)\n", target.Version, target.Deprecated)
+			}
+			if target.Beta {
+				params.Printer.Warn("Version %s is a beta version\n", target.Version)
+			}
+
+			prompt := fmt.Sprintf("Are you sure you want to upgrade instance %q from version %s to %s? (This may cause downtime)", instanceLabel, instance.Version, model.Version)
+			if !model.SkipClone {
+				prompt = fmt.Sprintf("Are you sure you want to clone instance %q and upgrade it from version %s to %s? (This may cause downtime)", instanceLabel, instance.Version, model.Version)
+			}
+			err = params.Printer.PromptForConfirmation(prompt)
+			if err != nil {
+				return err
+			}
+
+			result := &upgradeResult{
+				InstanceId:      model.InstanceId,
+				PreviousVersion: instance.Version,
+				Version:         model.Version,
+			}
+
+			// Create the rollback target before touching the instance
+			if !model.SkipClone {
+				req, err := buildCloneRequest(ctx, model, apiClient.DefaultAPI, instance, time.Now())
+				if err != nil {
+					return err
+				}
+				cloneResp, err := req.Execute()
+				if err != nil {
+					return fmt.Errorf("clone PostgreSQL Flex instance before upgrade: %w", err)
+				}
+				if cloneResp == nil {
+					return fmt.Errorf("clone PostgreSQL Flex instance before upgrade: empty response")
+				}
+				result.CloneId = cloneResp.Id
+
+				err = spinner.Run(params.Printer, "Cloning instance", func() error {
+					_, err = wait.CreateInstanceWaitHandler(ctx, apiClient.DefaultAPI, model.ProjectId, model.Region, cloneResp.Id).WaitWithContext(ctx)
+					return err
+				})
+				if err != nil {
+					return fmt.Errorf("wait for pre-upgrade clone %q, the instance was not upgraded: %w", cloneResp.Id, err)
+				}
+			}
+
+			err = buildUpgradeRequest(ctx, model, apiClient.DefaultAPI).Execute()
+			if err != nil {
+				return fmt.Errorf("upgrade PostgreSQL Flex instance%s: %w", rollbackHint(result.CloneId), err)
+			}
+
+			// Wait for async operation, if async mode not enabled
+			if !model.Async {
+				err = spinner.Run(params.Printer, "Upgrading instance", func() error {
+					_, err = wait.PartialUpdateInstanceWaitHandler(ctx, apiClient.DefaultAPI, model.ProjectId, model.Region, model.InstanceId).WaitWithContext(ctx)
+					return err
+				})
+				if err != nil {
+					return fmt.Errorf("wait for PostgreSQL Flex instance upgrade%s: %w", rollbackHint(result.CloneId), err)
+				}
+			}
+
+			return outputResult(params.Printer, model.OutputFormat, model.Async, instanceLabel, result)
+		},
+	}
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().String(versionFlag, "", "Version to upgrade to")
+	cmd.Flags().Bool(skipCloneFlag, false, "If set, the instance is upgraded without creating a pre-upgrade clone")
+	cmd.Flags().String(cloneNameFlag, "", fmt.Sprintf(`Name of the pre-upgrade clone. Defaults to the name of the instance with the suffix %q`, cloneNameSuffix))
+
+	cmd.MarkFlagsMutuallyExclusive(skipCloneFlag, cloneNameFlag)
+	err := flags.MarkFlagsRequired(cmd, versionFlag)
+	cobra.CheckErr(err)
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inputModel, error) {
+	instanceId := inputArgs[0]
+
+	globalFlags := globalflags.Parse(p, cmd)
+	if globalFlags.ProjectId == "" {
+		return nil, &cliErr.ProjectIdError{}
+	}
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		InstanceId:      instanceId,
+		Version:         flags.FlagToStringValue(p, cmd, versionFlag),
+		SkipClone:       flags.FlagToBoolValue(p, cmd, skipCloneFlag),
+		CloneName:       flags.FlagToStringPointer(p, cmd, cloneNameFlag),
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+// validateUpgrade returns the target version, if it is listed and newer than the current version
+func validateUpgrade(currentVersion, targetVersion string, versions []postgresflex.Version) (*postgresflex.Version, error) {
+	var target *postgresflex.Version
+	for i := range versions {
+		if versions[i].Version == targetVersion {
+			target = &versions[i]
+			break
+		}
+	}
+	if target == nil {
+		available := []string{}
+		for _, v := range versions {
+			available = append(available, v.Version)
+		}
+		return nil, &cliErr.FlagValidationError{
+			Flag:    versionFlag,
+			Details: fmt.Sprintf("version %q is not available, the available versions are: %v", targetVersion, available),
+		}
+	}
+
+	current := fmt.Sprintf("v%s", currentVersion)
+	if semver.IsValid(current) && semver.Compare(fmt.Sprintf("v%s", targetVersion), current) != 1 {
+		return nil, &cliErr.FlagValidationError{
+			Flag:    versionFlag,
+			Details: fmt.Sprintf("version %s is not newer than the current version %s of the instance", targetVersion, currentVersion),
+		}
+	}
+	return target, nil
+}
+
+func buildCloneRequest(ctx context.Context, model *inputModel, apiClient postgresflex.DefaultAPI, instance *postgresflex.GetInstanceResponse, pointInTime time.Time) (postgresflex.ApiCloneInstanceRequest, error) {
+	if instance.Storage.Size == nil {
+		return postgresflex.ApiCloneInstanceRequest{}, fmt.Errorf("could not read storage size for instance %s", model.InstanceId)
+	}
+
+	cloneName := model.CloneName
+	if cloneName == nil {
+		cloneName = utils.Ptr(instance.Name + cloneNameSuffix)
+	}
+
+	payload := postgresflex.CloneInstancePayload{
+		InstanceOverrides: postgresflex.CloneInstanceOverrides{
+			Class: instance.Storage.Class,
+			Size:  *instance.Storage.Size,
+			Name:  cloneName,
+		},
+		PointInTime: pointInTime,
+	}
+	return apiClient.CloneInstance(ctx, model.ProjectId, model.Region, model.InstanceId).CloneInstancePayload(payload), nil
+}
+
+func buildUpgradeRequest(ctx context.Context, model *inputModel, apiClient postgresflex.DefaultAPI) postgresflex.ApiPartialUpdateInstanceRequest {
+	return apiClient.PartialUpdateInstance(ctx, model.ProjectId, model.Region, model.InstanceId).
+		PartialUpdateInstancePayload(postgresflex.PartialUpdateInstancePayload{
+			Version: utils.Ptr(model.Version),
+		})
+}
+
+func rollbackHint(cloneId string) string {
+	if cloneId == "" {
+		return ""
+	}
+	return fmt.Sprintf(" (the pre-upgrade clone %q can be used for a rollback)", cloneId)
+}
+
+func outputResult(p *print.Printer, outputFormat string, async bool, instanceLabel string, result *upgradeResult) error {
+	return p.OutputResult(outputFormat, result, func() error {
+		if result == nil {
+			return fmt.Errorf("no result passed")
+		}
+
+		operationState := "Upgraded"
+		if async {
+			operationState = "Triggered upgrade of"
+		}
+		p.Outputf("%s instance %q from version %s to %s\n", operationState, instanceLabel, result.PreviousVersion, result.Version)
+		if result.CloneId != "" {
+			p.Outputf("To roll back, use the pre-upgrade clone with ID %q, or delete it once the upgrade is verified:\n", result.CloneId)
+			p.Outputf("  $ stackit postgresflex instance delete %s\n", result.CloneId)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,381 @@
+package upgrade
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	postgresflex "github.com/stackitcloud/stackit-sdk-go/services/postgresflex/v3api"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testparams"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testutils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+)
+
+type testCtxKey struct{}
+
+var (
+	testCtx    = context.WithValue(context.Background(), testCtxKey{}, "foo")
+	testClient = &postgresflex.APIClient{DefaultAPI: &postgresflex.DefaultAPIService{}}
+
+	testProjectId  = uuid.NewString()
+	testInstanceId = uuid.NewString()
+)
+
+const (
+	testRegion  = "eu01"
+	testVersion = "17"
+)
+
+func fixtureArgValues(mods ...func(argValues []string)) []string {
+	argValues := []string{
+		testInstanceId,
+	}
+	for _, mod := range mods {
+		mod(argValues)
+	}
+	return argValues
+}
+
+func fixtureFlagValues(mods ...func(flagValues map[string]string)) map[string]string {
+	flagValues := map[string]string{
+		globalflags.ProjectIdFlag: testProjectId,
+		globalflags.RegionFlag:    testRegion,
+		versionFlag:               testVersion,
+	}
+	for _, mod := range mods {
+		mod(flagValues)
+	}
+	return flagValues
+}
+
+func fixtureInputModel(mods ...func(model *inputModel)) *inputModel {
+	model := &inputModel{
+		GlobalFlagModel: &globalflags.GlobalFlagModel{
+			ProjectId: testProjectId,
+			Region:    testRegion,
+			Verbosity: globalflags.VerbosityDefault,
+		},
+		InstanceId: testInstanceId,
+		Version:    testVersion,
+	}
+	for _, mod := range mods {
+		mod(model)
+	}
+	return model
+}
+
+func fixtureInstance(mods ...func(instance *postgresflex.GetInstanceResponse)) *postgresflex.GetInstanceResponse {
+	instance := &postgresflex.GetInstanceResponse{
+		Id:      testInstanceId,
+		Name:    "example",
+		Version: "16",
+		Storage: postgresflex.Storage{
+			Class: utils.Ptr("premium-perf2-stackit"),
+			Size:  utils.Ptr(int64(10)),
+		},
+	}
+	for _, mod := range mods {
+		mod(instance)
+	}
+	return instance
+}
+
+func TestParseInput(t *testing.T) {
+	tests := []struct {
+		description   string
+		argValues     []string
+		flagValues    map[string]string
+		isValid       bool
+		expectedModel *inputModel
+	}{
+		{
+			description:   "base",
+			argValues:     fixtureArgValues(),
+			flagValues:    fixtureFlagValues(),
+			isValid:       true,
+			expectedModel: fixtureInputModel(),
+		},
+		{
+			description: "skip clone",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[skipCloneFlag] = "true"
+			}),
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.SkipClone = true
+			}),
+		},
+		{
+			description: "clone name",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[cloneNameFlag] = "backup"
+			}),
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.CloneName = utils.Ptr("backup")
+			}),
+		},
+		{
+			description: "skip clone and clone name",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[skipCloneFlag] = "true"
+				flagValues[cloneNameFlag] = "backup"
+			}),
+			isValid: false,
+		},
+		{
+			description: "no values",
+			argValues:   []string{},
+			flagValues:  map[string]string{},
+			isValid:     false,
+		},
+		{
+			description: "no arg values",
+			argValues:   []string{},
+			flagValues:  fixtureFlagValues(),
+			isValid:     false,
+		},
+		{
+			description: "version missing",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, versionFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "project id missing",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, globalflags.ProjectIdFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "instance id invalid",
+			argValues:   []string{"invalid-uuid"},
+			flagValues:  fixtureFlagValues(),
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			testutils.TestParseInput(t, NewCmd, parseInput, tt.expectedModel, tt.argValues, tt.flagValues, tt.isValid)
+		})
+	}
+}
+
+func TestValidateUpgrade(t *testing.T) {
+	versions := []postgresflex.Version{
+		{Version: "14", Deprecated: "2026-11-01"},
+		{Version: "16"},
+		{Version: "17", Recommend: true},
+		{Version: "18", Beta: true},
+	}
+
+	tests := []struct {
+		description     string
+		currentVersion  string
+		targetVersion   string
+		isValid         bool
+		expectedVersion *postgresflex.Version
+	}{
+		{
+			description:     "newer version",
+			currentVersion:  "16",
+			targetVersion:   "17",
+			isValid:         true,
+			expectedVersion: &versions[2],
+		},
+		{
+			description:     "beta version",
+			currentVersion:  "16",
+			targetVersion:   "18",
+			isValid:         true,
+			expectedVersion: &versions[3],
+		},
+		{
+			description:    "same version",
+			currentVersion: "16",
+			targetVersion:  "16",
+			isValid:        false,
+		},
+		{
+			description:    "downgrade",
+			currentVersion: "16",
+			targetVersion:  "14",
+			isValid:        false,
+		},
+		{
+			description:    "version not available",
+			currentVersion: "16",
+			targetVersion:  "19",
+			isValid:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			version, err := validateUpgrade(tt.currentVersion, tt.targetVersion, versions)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("did not fail on invalid input")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			diff := cmp.Diff(version, tt.expectedVersion)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildCloneRequest(t *testing.T) {
+	pointInTime := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description     string
+		model           *inputModel
+		instance        *postgresflex.GetInstanceResponse
+		isValid         bool
+		expectedRequest postgresflex.ApiCloneInstanceRequest
+	}{
+		{
+			description: "base",
+			model:       fixtureInputModel(),
+			instance:    fixtureInstance(),
+			isValid:     true,
+			expectedRequest: testClient.DefaultAPI.CloneInstance(testCtx, testProjectId, testRegion, testInstanceId).
+				CloneInstancePayload(postgresflex.CloneInstancePayload{
+					InstanceOverrides: postgresflex.CloneInstanceOverrides{
+						Class: utils.Ptr("premium-perf2-stackit"),
+						Size:  int64(10),
+						Name:  utils.Ptr("example-pre-upgrade"),
+					},
+					PointInTime: pointInTime,
+				}),
+		},
+		{
+			description: "clone name",
+			model: fixtureInputModel(func(model *inputModel) {
+				model.CloneName = utils.Ptr("backup")
+			}),
+			instance: fixtureInstance(),
+			isValid:  true,
+			expectedRequest: testClient.DefaultAPI.CloneInstance(testCtx, testProjectId, testRegion, testInstanceId).
+				CloneInstancePayload(postgresflex.CloneInstancePayload{
+					InstanceOverrides: postgresflex.CloneInstanceOverrides{
+						Class: utils.Ptr("premium-perf2-stackit"),
+						Size:  int64(10),
+						Name:  utils.Ptr("backup"),
+					},
+					PointInTime: pointInTime,
+				}),
+		},
+		{
+			description: "storage size missing",
+			model:       fixtureInputModel(),
+			instance: fixtureInstance(func(instance *postgresflex.GetInstanceResponse) {
+				instance.Storage.Size = nil
+			}),
+			isValid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			request, err := buildCloneRequest(testCtx, tt.model, testClient.DefaultAPI, tt.instance, pointInTime)
+			if err != nil {
+				if !tt.isValid {
+					return
+				}
+				t.Fatalf("error building request: %v", err)
+			}
+			if !tt.isValid {
+				t.Fatalf("did not fail on invalid input")
+			}
+
+			diff := cmp.Diff(request, tt.expectedRequest,
+				cmp.AllowUnexported(tt.expectedRequest),
+				cmpopts.EquateComparable(testCtx),
+				cmpopts.IgnoreFields(tt.expectedRequest, "ApiService"),
+			)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildUpgradeRequest(t *testing.T) {
+	expectedRequest := testClient.DefaultAPI.PartialUpdateInstance(testCtx, testProjectId, testRegion, testInstanceId).
+		PartialUpdateInstancePayload(postgresflex.PartialUpdateInstancePayload{
+			Version: utils.Ptr(testVersion),
+		})
+
+	request := buildUpgradeRequest(testCtx, fixtureInputModel(), testClient.DefaultAPI)
+
+	diff := cmp.Diff(request, expectedRequest,
+		cmp.AllowUnexported(expectedRequest),
+		cmpopts.EquateComparable(testCtx),
+		cmpopts.IgnoreFields(expectedRequest, "ApiService"),
+	)
+	if diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+}
+
+func Test_outputResult(t *testing.T) {
+	type args struct {
+		outputFormat  string
+		async         bool
+		instanceLabel string
+		result        *upgradeResult
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{"empty", args{}, true},
+		{"with clone", args{
+			instanceLabel: "example",
+			result: &upgradeResult{
+				InstanceId:      testInstanceId,
+				PreviousVersion: "16",
+				Version:         "17",
+				CloneId:         "clone-id",
+			},
+		}, false},
+		{"without clone, async", args{
+			async:         true,
+			instanceLabel: "example",
+			result: &upgradeResult{
+				InstanceId:      testInstanceId,
+				PreviousVersion: "16",
+				Version:         "17",
+			},
+		}, false},
+	}
+	params := testparams.NewTestParams()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := outputResult(params.Printer, tt.args.outputFormat, tt.args.async, tt.args.instanceLabel, tt.args.result); (err != nil) != tt.wantErr {
+				t.Errorf("outputResult() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
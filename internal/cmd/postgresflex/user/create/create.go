@@ -10,6 +10,7 @@ import (
 	postgresflex "github.com/stackitcloud/stackit-sdk-go/services/postgresflex/v3api"
 
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dbcredentials"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
@@ -37,9 +38,10 @@ var (
 type inputModel struct {
 	*globalflags.GlobalFlagModel
 
-	InstanceId string
-	Username   string
-	Roles      []string
+	InstanceId        string
+	Username          string
+	Roles             []string
+	CredentialsFormat *dbcredentials.Options
 }
 
 func NewCmd(params *types.CmdParams) *cobra.Command {
@@ -59,6 +61,9 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 			examples.NewExample(
 				`Create a PostgreSQL Flex user for instance with ID "xxx" and permission "createdb"`,
 				"$ stackit postgresflex user create --instance-id xxx --username johndoe --role createdb"),
+			examples.NewExample(
+				`Create a PostgreSQL Flex user for instance with ID "xxx" and write its credentials to a Kubernetes Secret manifest`,
+				"$ stackit postgresflex user create --instance-id xxx --username johndoe --format-as k8s-secret --output-file secret.yaml"),
 		),
 		Args: args.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -80,6 +85,16 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 				instanceLabel = model.InstanceId
 			}
 
+			// The host is needed to render the credentials. It is retrieved before creating the user,
+			// so that the password of the created user isn't lost if it can't be retrieved
+			var host string
+			if model.CredentialsFormat.FormatAs != "" {
+				host, err = postgresflexUtils.GetInstanceAddress(ctx, apiClient.DefaultAPI, model.ProjectId, model.Region, model.InstanceId)
+				if err != nil {
+					return fmt.Errorf("get host of PostgreSQL Flex instance: %w", err)
+				}
+			}
+
 			prompt := fmt.Sprintf("Are you sure you want to create a user for instance %q?", instanceLabel)
 			err = params.Printer.PromptForConfirmation(prompt)
 			if err != nil {
@@ -93,6 +108,10 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 				return fmt.Errorf("create PostgreSQL Flex user: %w", err)
 			}
 
+			if model.CredentialsFormat.FormatAs != "" {
+				return dbcredentials.Output(params.Printer, model.CredentialsFormat, buildCredentials(resp, host), "")
+			}
+
 			return outputResult(params.Printer, model.OutputFormat, instanceLabel, resp)
 		},
 	}
@@ -105,6 +124,7 @@ func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().Var(flags.UUIDFlag(), instanceIdFlag, "ID of the instance")
 	cmd.Flags().String(usernameFlag, "", "Username of the user")
 	roleFlag.Register(cmd)
+	dbcredentials.ConfigureFlags(cmd)
 
 	err := flags.MarkFlagsRequired(cmd, instanceIdFlag, usernameFlag)
 	cobra.CheckErr(err)
@@ -116,11 +136,17 @@ func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel,
 		return nil, &errors.ProjectIdError{}
 	}
 
+	credentialsFormat, err := dbcredentials.ParseFlags(p, cmd)
+	if err != nil {
+		return nil, err
+	}
+
 	model := inputModel{
-		GlobalFlagModel: globalFlags,
-		InstanceId:      flags.FlagToStringValue(p, cmd, instanceIdFlag),
-		Username:        flags.FlagToStringValue(p, cmd, usernameFlag),
-		Roles:           roleFlag.Get(),
+		GlobalFlagModel:   globalFlags,
+		InstanceId:        flags.FlagToStringValue(p, cmd, instanceIdFlag),
+		Username:          flags.FlagToStringValue(p, cmd, usernameFlag),
+		Roles:             roleFlag.Get(),
+		CredentialsFormat: credentialsFormat,
 	}
 
 	p.DebugInputModel(model)
@@ -149,3 +175,15 @@ func outputResult(p *print.Printer, outputFormat, instanceLabel string, user *po
 		return nil
 	})
 }
+
+// buildCredentials returns the credentials of the created user.
+// The user doesn't contain the host of the instance, so it is passed separately.
+func buildCredentials(user *postgresflex.CreateUserResponse, host string) *dbcredentials.Credentials {
+	return &dbcredentials.Credentials{
+		Engine:   dbcredentials.EnginePostgreSQL,
+		Host:     host,
+		Port:     postgresflexUtils.InstancePort,
+		Username: user.Name,
+		Password: user.Password,
+	}
+}
@@ -0,0 +1,202 @@
+package rotate
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	postgresflex "github.com/stackitcloud/stackit-sdk-go/services/postgresflex/v3api"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dbcredentials"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/services/postgresflex/client"
+	postgresflexUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/postgresflex/utils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+)
+
+const (
+	userIdArg = "USER_ID"
+
+	instanceIdFlag = "instance-id"
+	usernameFlag   = "username"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+
+	InstanceId    string
+	UserId        int64
+	Username      string
+	RotateOptions *dbcredentials.RotateOptions
+}
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("rotate %s", userIdArg),
+		Short: "Rotates the credentials of a PostgreSQL Flex user",
+		Long: fmt.Sprintf("%s\n%s\n%s",
+			"Rotates the credentials of a PostgreSQL Flex user by replacing it with a new user with the same roles.",
+			"The credentials of the new user are output and can optionally be written to a Secrets Manager secret.",
+			`The old user is deleted after the grace period set with the "--grace-period" flag.`,
+		),
+		Example: examples.Build(
+			examples.NewExample(
+				`Rotate the credentials of a PostgreSQL Flex user with ID "xxx" of instance with ID "yyy"`,
+				"$ stackit postgresflex user rotate xxx --instance-id yyy"),
+			examples.NewExample(
+				`Rotate the credentials of a PostgreSQL Flex user with ID "xxx" of instance with ID "yyy", name the new user "app" and delete the old user after 10 minutes`,
+				"$ stackit postgresflex user rotate xxx --instance-id yyy --username app --grace-period 10m"),
+			examples.NewExample(
+				`Rotate the credentials of a PostgreSQL Flex user with ID "xxx" of instance with ID "yyy" and write them to the secret "postgresql/app" of Secrets Manager instance with ID "zzz"`,
+				"$ stackit postgresflex user rotate xxx --instance-id yyy --secrets-manager-instance-id zzz --secrets-manager-secret-path postgresql/app --secrets-manager-username my-user"),
+		),
+		Args: args.SingleArg(userIdArg, nil),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			// Configure API client
+			apiClient, err := client.ConfigureClient(params.Printer, params.CliVersion)
+			if err != nil {
+				return err
+			}
+
+			instanceLabel, err := postgresflexUtils.GetInstanceName(ctx, apiClient.DefaultAPI, model.ProjectId, model.Region, model.InstanceId)
+			if err != nil {
+				params.Printer.Debug(print.ErrorLevel, "get instance name: %v", err)
+				instanceLabel = model.InstanceId
+			}
+
+			// The new user gets the roles of the old user
+			oldUser, err := buildGetUserRequest(ctx, model, apiClient).Execute()
+			if err != nil {
+				return fmt.Errorf("get PostgreSQL Flex user: %w", err)
+			}
+			if oldUser == nil {
+				return fmt.Errorf("get PostgreSQL Flex user: empty response")
+			}
+
+			// The API doesn't return the host of the instance with the user
+			host, err := postgresflexUtils.GetInstanceAddress(ctx, apiClient.DefaultAPI, model.ProjectId, model.Region, model.InstanceId)
+			if err != nil {
+				return err
+			}
+
+			username := model.Username
+			if username == "" {
+				username = dbcredentials.RotatedUsername(oldUser.Name, "_", time.Now())
+			}
+
+			return dbcredentials.Rotate(ctx, params.Printer, model.OutputFormat, model.Region, model.RotateOptions, &dbcredentials.Rotation{
+				Service:       "PostgreSQL Flex",
+				Kind:          "user",
+				Label:         fmt.Sprintf("%q", oldUser.Name),
+				InstanceLabel: instanceLabel,
+				Create: func(ctx context.Context) (*dbcredentials.NewCredentials, error) {
+					resp, err := buildCreateRequest(ctx, model, apiClient, username, oldUser.Roles).Execute()
+					if err != nil {
+						return nil, err
+					}
+					return buildNewCredentials(resp, host)
+				},
+				Delete: func(ctx context.Context) error {
+					return buildDeleteRequest(ctx, model, apiClient).Execute()
+				},
+			})
+		},
+	}
+
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().Var(flags.UUIDFlag(), instanceIdFlag, "ID of the instance")
+	cmd.Flags().String(usernameFlag, "", "Username of the new user. Defaults to the username of the old user with the time of the rotation appended")
+	dbcredentials.ConfigureRotateFlags(cmd, "user")
+
+	err := flags.MarkFlagsRequired(cmd, instanceIdFlag)
+	cobra.CheckErr(err)
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inputModel, error) {
+	userIdStr := inputArgs[0]
+
+	globalFlags := globalflags.Parse(p, cmd)
+	if globalFlags.ProjectId == "" {
+		return nil, &errors.ProjectIdError{}
+	}
+
+	userId, err := strconv.ParseInt(userIdStr, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user id format, must be an integer: %w", err)
+	}
+
+	rotateOptions, err := dbcredentials.ParseRotateFlags(p, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		InstanceId:      flags.FlagToStringValue(p, cmd, instanceIdFlag),
+		UserId:          userId,
+		Username:        flags.FlagToStringValue(p, cmd, usernameFlag),
+		RotateOptions:   rotateOptions,
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+func buildGetUserRequest(ctx context.Context, model *inputModel, apiClient *postgresflex.APIClient) postgresflex.ApiGetUserRequest {
+	req := apiClient.DefaultAPI.GetUser(ctx, model.ProjectId, model.Region, model.InstanceId, model.UserId)
+	return req
+}
+
+func buildCreateRequest(ctx context.Context, model *inputModel, apiClient *postgresflex.APIClient, username string, roles []string) postgresflex.ApiCreateUserRequest {
+	req := apiClient.DefaultAPI.CreateUser(ctx, model.ProjectId, model.Region, model.InstanceId)
+	req = req.CreateUserPayload(postgresflex.CreateUserPayload{
+		Name:  username,
+		Roles: roles,
+	})
+	return req
+}
+
+func buildDeleteRequest(ctx context.Context, model *inputModel, apiClient *postgresflex.APIClient) postgresflex.ApiDeleteUserRequest {
+	req := apiClient.DefaultAPI.DeleteUser(ctx, model.ProjectId, model.Region, model.InstanceId, model.UserId)
+	return req
+}
+
+// buildNewCredentials returns the credentials of the new user.
+// The user doesn't contain the host of the instance, so it is passed separately.
+func buildNewCredentials(user *postgresflex.CreateUserResponse, host string) (*dbcredentials.NewCredentials, error) {
+	if user == nil {
+		return nil, fmt.Errorf("empty response")
+	}
+
+	return &dbcredentials.NewCredentials{
+		Id: strconv.FormatInt(user.Id, 10),
+		Credentials: &dbcredentials.Credentials{
+			Engine:   dbcredentials.EnginePostgreSQL,
+			Host:     host,
+			Port:     postgresflexUtils.InstancePort,
+			Username: user.Name,
+			Password: user.Password,
+		},
+		Response: user,
+		HidePassword: func() {
+			user.Password = "hidden"
+		},
+	}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/cmd/postgresflex/user/describe"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/postgresflex/user/list"
 	resetpassword "github.com/stackitcloud/stackit-cli/internal/cmd/postgresflex/user/reset-password"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/postgresflex/user/rotate"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/postgresflex/user/update"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
@@ -33,4 +34,5 @@ func addSubcommands(cmd *cobra.Command, params *types.CmdParams) {
 	cmd.AddCommand(update.NewCmd(params))
 	cmd.AddCommand(delete.NewCmd(params))
 	cmd.AddCommand(resetpassword.NewCmd(params))
+	cmd.AddCommand(rotate.NewCmd(params))
 }
@@ -1,12 +1,14 @@
 package instance
 
 import (
+	"github.com/stackitcloud/stackit-cli/internal/cmd/dsa/metrics"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/redis/instance/create"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/redis/instance/delete"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/redis/instance/describe"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/redis/instance/list"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/redis/instance/update"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/services/redis/client"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
 
@@ -31,4 +33,5 @@ func addSubcommands(cmd *cobra.Command, params *types.CmdParams) {
 	cmd.AddCommand(describe.NewCmd(params))
 	cmd.AddCommand(list.NewCmd(params))
 	cmd.AddCommand(update.NewCmd(params))
+	cmd.AddCommand(metrics.NewCmd(params, client.DSAService))
 }
@@ -1,10 +1,12 @@
 package redis
 
 import (
+	"github.com/stackitcloud/stackit-cli/internal/cmd/dsa/backup"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/redis/credentials"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/redis/instance"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/redis/plans"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/services/redis/client"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
 
@@ -27,4 +29,5 @@ func addSubcommands(cmd *cobra.Command, params *types.CmdParams) {
 	cmd.AddCommand(instance.NewCmd(params))
 	cmd.AddCommand(plans.NewCmd(params))
 	cmd.AddCommand(credentials.NewCmd(params))
+	cmd.AddCommand(backup.NewCmd(params, client.DSAService))
 }
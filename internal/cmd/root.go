@@ -1,7 +1,9 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
+	"os/exec"
 	"strings"
 	"time"
 
@@ -9,6 +11,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
 
 	affinityGroups "github.com/stackitcloud/stackit-cli/internal/cmd/affinity-groups"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/alias"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/auth"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/beta"
 	configCmd "github.com/stackitcloud/stackit-cli/internal/cmd/config"
@@ -30,6 +33,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/cmd/observability"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/opensearch"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/organization"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/plugin"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/postgresflex"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/project"
 	publicip "github.com/stackitcloud/stackit-cli/internal/cmd/public-ip"
@@ -42,12 +46,15 @@ import (
 	serviceaccount "github.com/stackitcloud/stackit-cli/internal/cmd/service-account"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/ske"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/volume"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/aliases"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/config"
-	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	pkgErrors "github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/plugins"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/projectname"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -74,6 +81,10 @@ func NewRootCmd(params *types.CmdParams) *cobra.Command {
 			configFilePath := viper.ConfigFileUsed()
 			p.Debug(print.DebugLevel, "configuration is persisted and read from: %s", configFilePath)
 
+			if projectConfigFilePath := config.GetProjectConfigFilePath(); projectConfigFilePath != "" {
+				p.Debug(print.DebugLevel, "project configuration is read from: %s", projectConfigFilePath)
+			}
+
 			profileSet, activeProfile, configMethod, err := config.GetConfiguredProfile()
 			if err != nil {
 				return fmt.Errorf("get configured profile: %w", err)
@@ -94,6 +105,11 @@ func NewRootCmd(params *types.CmdParams) *cobra.Command {
 
 			p.Debug(print.DebugLevel, "active configuration profile: %s", activeProfile)
 
+			p.Protection = getProtection(cmd, params, activeProfile, globalFlags.ProjectId)
+			if p.Protection != nil {
+				p.Debug(print.DebugLevel, "running in protected context: %s", p.Protection.Context)
+			}
+
 			configKeys := viper.AllSettings()
 			configKeysStr := print.BuildDebugStrFromMap(configKeys)
 			p.Debug(print.DebugLevel, "configuration keys: %s", configKeysStr)
@@ -159,6 +175,38 @@ func beautifyUsageTemplate(cmd *cobra.Command) {
 	cmd.SetUsageTemplate(usageTemplate)
 }
 
+// getProtection returns the confirmation settings if the active profile or the project is protected, and nil otherwise.
+// In a protected context, the user has to type the project name (or the profile name, if no project is set) to confirm.
+func getProtection(cmd *cobra.Command, params *types.CmdParams, activeProfile, projectId string) *print.Protection {
+	p := params.Printer
+
+	var protectedContext string
+	switch {
+	case config.IsProjectProtected(projectId):
+		protectedContext = fmt.Sprintf("project %q", projectId)
+	case config.IsProfileProtected():
+		protectedContext = fmt.Sprintf("profile %q", activeProfile)
+	default:
+		return nil
+	}
+
+	return &print.Protection{
+		Context: protectedContext,
+		ConfirmationText: func() (string, error) {
+			if projectId == "" {
+				return activeProfile, nil
+			}
+			projectName, err := projectname.GetProjectName(cmd.Context(), p, params.CliVersion, cmd)
+			if err != nil {
+				p.Debug(print.ErrorLevel, "get project name: %v", err)
+				return projectId, nil
+			}
+			return projectName, nil
+		},
+		AllowAssumeYes: flags.FlagToBoolValue(p, cmd, globalflags.IKnowWhatIAmDoingFlag),
+	}
+}
+
 func configureFlags(cmd *cobra.Command) error {
 	cmd.Flags().BoolP("version", "v", false, `Show "stackit" version`)
 
@@ -170,6 +218,7 @@ func configureFlags(cmd *cobra.Command) error {
 }
 
 func addSubcommands(cmd *cobra.Command, params *types.CmdParams) {
+	cmd.AddCommand(alias.NewCmd(params))
 	cmd.AddCommand(auth.NewCmd(params))
 	cmd.AddCommand(configCmd.NewCmd(params))
 	cmd.AddCommand(beta.NewCmd(params))
@@ -184,6 +233,7 @@ func addSubcommands(cmd *cobra.Command, params *types.CmdParams) {
 	cmd.AddCommand(observability.NewCmd(params))
 	cmd.AddCommand(opensearch.NewCmd(params))
 	cmd.AddCommand(organization.NewCmd(params))
+	cmd.AddCommand(plugin.NewCmd(params))
 	cmd.AddCommand(postgresflex.NewCmd(params))
 	cmd.AddCommand(project.NewCmd(params))
 	cmd.AddCommand(rabbitmq.NewCmd(params))
@@ -215,19 +265,101 @@ func traverseCommands(c *cobra.Command, f func(*cobra.Command)) {
 	}
 }
 
-// Execute executes the RootCmd and returns true on success and false on failure.
-func Execute(params *types.CmdParams) bool {
+// Execute executes the RootCmd and returns the exit code of the CLI: 0 on success, otherwise non-zero.
+// For plugins, the exit code of the plugin is returned.
+func Execute(params *types.CmdParams) int {
 	cmd := NewRootCmd(params)
 
 	p := params.Printer
-	err := cmd.Execute()
+	expandedArgs, err := expandAlias(cmd, params.Args, aliases.List())
+	if err != nil {
+		p.Debug(print.ErrorLevel, "expand alias: %v", err)
+		p.Error("%s", err.Error())
+		return 1
+	}
+	params.Args = expandedArgs
+	cmd.SetArgs(expandedArgs)
+
+	if pluginPath, ok := findPlugin(cmd, params.Args, plugins.Find); ok {
+		return executePlugin(p, pluginPath, params.Args[1:])
+	}
+
+	err = cmd.Execute()
 	if err != nil {
 		err := beautifyUnknownAndMissingCommandsError(cmd, err, params.Args)
 		p.Debug(print.ErrorLevel, "execute command: %v", err)
 		p.Error("%s", err.Error())
-		return false
+		return 1
+	}
+	return 0
+}
+
+// expandAlias replaces the alias in the first argument by its expansion.
+// Aliases are only resolved if the first argument isn't an existing command, so they can't shadow commands.
+// If no alias is used, the arguments are returned unchanged.
+func expandAlias(rootCmd *cobra.Command, args []string, aliasesMap map[string]string) ([]string, error) { // nolint:gocritic // args is a nice name despite shadowing
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+		return args, nil
+	}
+	if foundCmd, _, err := rootCmd.Find(args[:1]); err == nil && foundCmd != rootCmd {
+		return args, nil
+	}
+
+	expansion, ok := aliasesMap[args[0]]
+	if !ok {
+		return args, nil
+	}
+	expandedArgs, err := aliases.Expand(expansion, args[1:])
+	if err != nil {
+		return nil, fmt.Errorf("expand alias %q: %w", args[0], err)
+	}
+	return expandedArgs, nil
+}
+
+// findPlugin returns the path of the plugin executable named in the first argument.
+// Plugins are only resolved if the first argument isn't an existing command, so they can't shadow commands.
+func findPlugin(rootCmd *cobra.Command, args []string, find func(name string) (string, bool)) (string, bool) { // nolint:gocritic // args is a nice name despite shadowing
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+		return "", false
+	}
+	if foundCmd, _, err := rootCmd.Find(args[:1]); err == nil && foundCmd != rootCmd {
+		return "", false
+	}
+	return find(args[0])
+}
+
+// executePlugin runs the plugin and returns its exit code.
+// If the plugin fails with a non-zero exit code, it is expected to have reported the error itself.
+func executePlugin(p *print.Printer, pluginPath string, pluginArgs []string) int {
+	env, err := plugins.Env(p)
+	if err != nil {
+		p.Debug(print.ErrorLevel, "build plugin environment: %v", err)
+		p.Error("%s", err.Error())
+		return 1
+	}
+
+	err = plugins.Run(p, pluginPath, pluginArgs, env)
+	if err != nil {
+		p.Debug(print.ErrorLevel, "run plugin: %v", err)
+		var exitErr *exec.ExitError
+		if !errors.As(err, &exitErr) {
+			p.Error("run plugin %q: %s", pluginPath, err.Error())
+		}
+	}
+	return pluginExitCode(err)
+}
+
+// pluginExitCode returns the exit code for the error of running a plugin.
+// If the plugin didn't exit on its own, e.g. because it was killed by a signal, 1 is returned.
+func pluginExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() > 0 {
+		return exitErr.ExitCode()
 	}
-	return true
+	return 1
 }
 
 // Returns a more user-friendly error if the input error is due to unknown/missing subcommands (issue: https://github.com/spf13/cobra/issues/706)
@@ -252,12 +384,12 @@ func beautifyUnknownAndMissingCommandsError(rootCmd *cobra.Command, cmdErr error
 	// We want the error message to state that either a cmd's subcommand is missing, or that the cmd's subcommand called is wrong
 	if cmd.HasSubCommands() {
 		if strings.HasPrefix(unparsedInputs[0], "-") {
-			return &errors.SubcommandMissingError{
+			return &pkgErrors.SubcommandMissingError{
 				Cmd: cmd,
 			}
 		}
 
-		return &errors.InputUnknownError{
+		return &pkgErrors.InputUnknownError{
 			ProvidedInput: unparsedInputs[0],
 			Cmd:           cmd,
 		}
@@ -268,12 +400,12 @@ func beautifyUnknownAndMissingCommandsError(rootCmd *cobra.Command, cmdErr error
 	// To be more user-friendly, we add a usage tip
 	err = cmd.ParseFlags(unparsedInputs)
 	if err != nil {
-		return errors.AppendUsageTip(err, cmd)
+		return pkgErrors.AppendUsageTip(err, cmd)
 	}
 
 	// This shouldn't happen
 	// If we're here, Cobra was able to parse cmd's flags, thus it wouldn't raise "unknown flag" errors
-	return &errors.InputUnknownError{
+	return &pkgErrors.InputUnknownError{
 		ProvidedInput: unparsedInputs[0],
 		Cmd:           cmd,
 	}
@@ -2,8 +2,11 @@ package cmd
 
 import (
 	"errors"
+	"os/exec"
+	"runtime"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/spf13/cobra"
 
 	pkgErrors "github.com/stackitcloud/stackit-cli/internal/pkg/errors"
@@ -74,3 +77,162 @@ func TestBeautifyUnknownAndMissingCommandsError(t *testing.T) {
 		})
 	}
 }
+
+func TestExpandAlias(t *testing.T) {
+	aliasesMap := map[string]string{
+		"kc":      "ske kubeconfig create $1 --login",
+		"service": "resource operation",
+		"broken":  "ske kubeconfig create $2",
+	}
+
+	tests := []struct {
+		description  string
+		args         []string
+		isValid      bool
+		expectedArgs []string
+	}{
+		{
+			description:  "no args",
+			args:         []string{},
+			isValid:      true,
+			expectedArgs: []string{},
+		},
+		{
+			description:  "alias",
+			args:         []string{"kc", "prod", "-o", "json"},
+			isValid:      true,
+			expectedArgs: []string{"ske", "kubeconfig", "create", "prod", "--login", "-o", "json"},
+		},
+		{
+			description:  "existing command is not shadowed",
+			args:         []string{"service", "resource"},
+			isValid:      true,
+			expectedArgs: []string{"service", "resource"},
+		},
+		{
+			description:  "flag as first arg",
+			args:         []string{"--version"},
+			isValid:      true,
+			expectedArgs: []string{"--version"},
+		},
+		{
+			description:  "unknown command",
+			args:         []string{"unknown"},
+			isValid:      true,
+			expectedArgs: []string{"unknown"},
+		},
+		{
+			description: "missing argument",
+			args:        []string{"broken", "prod"},
+			isValid:     false,
+		},
+	}
+
+	setupCmd()
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			actualArgs, err := expandAlias(cmd, tt.args, aliasesMap)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected error to be nil, got %v", err)
+			}
+			if diff := cmp.Diff(actualArgs, tt.expectedArgs); diff != "" {
+				t.Fatalf("unexpected args: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFindPlugin(t *testing.T) {
+	find := func(name string) (string, bool) {
+		if name == "cost" || name == "service" {
+			return "/usr/local/bin/stackit-" + name, true
+		}
+		return "", false
+	}
+
+	tests := []struct {
+		description  string
+		args         []string
+		expectedPath string
+		expectedOk   bool
+	}{
+		{
+			description: "no args",
+			args:        []string{},
+		},
+		{
+			description:  "plugin",
+			args:         []string{"cost", "--month", "2024-01"},
+			expectedPath: "/usr/local/bin/stackit-cost",
+			expectedOk:   true,
+		},
+		{
+			description: "existing command is not shadowed",
+			args:        []string{"service", "resource"},
+		},
+		{
+			description: "flag as first arg",
+			args:        []string{"--cost"},
+		},
+		{
+			description: "unknown command",
+			args:        []string{"unknown"},
+		},
+	}
+
+	setupCmd()
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			path, ok := findPlugin(cmd, tt.args, find)
+			if ok != tt.expectedOk {
+				t.Fatalf("expected found to be %t, got %t", tt.expectedOk, ok)
+			}
+			if path != tt.expectedPath {
+				t.Fatalf("expected path %q, got %q", tt.expectedPath, path)
+			}
+		})
+	}
+}
+
+func TestPluginExitCode(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugin exit codes are tested with a shell")
+	}
+
+	tests := []struct {
+		description      string
+		err              error
+		expectedExitCode int
+	}{
+		{
+			description:      "success",
+			err:              nil,
+			expectedExitCode: 0,
+		},
+		{
+			description:      "plugin exit code",
+			err:              exec.Command("sh", "-c", "exit 3").Run(),
+			expectedExitCode: 3,
+		},
+		{
+			description:      "plugin not started",
+			err:              errors.New("exec format error"),
+			expectedExitCode: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			exitCode := pluginExitCode(tt.err)
+			if exitCode != tt.expectedExitCode {
+				t.Fatalf("expected exit code %d, got %d", tt.expectedExitCode, exitCode)
+			}
+		})
+	}
+}
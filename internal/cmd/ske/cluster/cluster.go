@@ -5,11 +5,14 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/cmd/ske/cluster/delete"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/ske/cluster/describe"
 	generatepayload "github.com/stackitcloud/stackit-cli/internal/cmd/ske/cluster/generate-payload"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/ske/cluster/health"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/ske/cluster/hibernate"
+	hibernationschedule "github.com/stackitcloud/stackit-cli/internal/cmd/ske/cluster/hibernation-schedule"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/ske/cluster/list"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/ske/cluster/maintenance"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/ske/cluster/reconcile"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/ske/cluster/update"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/ske/cluster/upgrade"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/ske/cluster/wakeup"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
@@ -35,9 +38,12 @@ func addSubcommands(cmd *cobra.Command, params *types.CmdParams) {
 	cmd.AddCommand(create.NewCmd(params))
 	cmd.AddCommand(delete.NewCmd(params))
 	cmd.AddCommand(describe.NewCmd(params))
+	cmd.AddCommand(health.NewCmd(params))
 	cmd.AddCommand(list.NewCmd(params))
 	cmd.AddCommand(update.NewCmd(params))
+	cmd.AddCommand(upgrade.NewCmd(params))
 	cmd.AddCommand(hibernate.NewCmd(params))
+	cmd.AddCommand(hibernationschedule.NewCmd(params))
 	cmd.AddCommand(maintenance.NewCmd(params))
 	cmd.AddCommand(reconcile.NewCmd(params))
 	cmd.AddCommand(wakeup.NewCmd(params))
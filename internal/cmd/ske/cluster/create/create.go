@@ -36,16 +36,18 @@ type inputModel struct {
 	*globalflags.GlobalFlagModel
 	ClusterName string
 	Payload     *ske.CreateOrUpdateClusterPayload
+	Spec        *skeUtils.ClusterSpecOptions
 }
 
 func NewCmd(params *types.CmdParams) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   fmt.Sprintf("create %s", clusterNameArg),
 		Short: "Creates a SKE cluster",
-		Long: fmt.Sprintf("%s\n%s\n%s",
+		Long: fmt.Sprintf("%s\n%s\n%s\n%s",
 			"Creates a STACKIT Kubernetes Engine (SKE) cluster.",
 			"The payload can be provided as a JSON string or a file path prefixed with \"@\".",
 			"See https://docs.api.stackit.cloud/documentation/ske/version/v1#tag/Cluster/operation/SkeService_CreateOrUpdateCluster for information regarding the payload structure.",
+			"Common settings, like the Kubernetes version, nodepools, maintenance window, hibernation schedule and extensions, can also be set with flags, which are merged into the payload or the default configuration.",
 		),
 		Args: args.SingleArg(clusterNameArg, nil),
 		Example: examples.Build(
@@ -58,6 +60,15 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 			examples.NewExample(
 				`Create a SKE cluster using an API payload provided as a JSON string`,
 				`$ stackit ske cluster create my-cluster --payload "{...}"`),
+			examples.NewExample(
+				`Create a SKE cluster using default configuration, with Kubernetes version 1.33.5 and the default nodepool scaling up to 5 nodes`,
+				"$ stackit ske cluster create my-cluster --kubernetes-version 1.33.5 --nodepool name=pool-default,max=5"),
+			examples.NewExample(
+				`Create a SKE cluster using default configuration, replacing the default nodepool with a nodepool in two availability zones`,
+				"$ stackit ske cluster create my-cluster --remove-nodepool pool-default --nodepool name=workers,machine-type=c2i.4,min=2,max=4,zones=eu01-1,eu01-2"),
+			examples.NewExample(
+				`Create a SKE cluster using default configuration, with a maintenance window from 3:00 to 5:00 UTC and the API restricted to the network 198.51.100.0/24`,
+				"$ stackit ske cluster create my-cluster --maintenance-window-start 03:00 --maintenance-window-end 05:00 --acl 198.51.100.0/24"),
 			examples.NewExample(
 				`Generate a payload with default values, and adapt it with custom values for the different configuration options`,
 				`$ stackit ske cluster generate-payload > ./payload.json`,
@@ -123,6 +134,19 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 				model.Payload = defaultPayload
 			}
 
+			// Merge the flags into the payload
+			var defaultNodepool *ske.Nodepool
+			if skeUtils.AddsNodepool(model.Payload, model.Spec) {
+				defaultNodepool, err = skeUtils.GetDefaultNodepool(ctx, apiClient.DefaultAPI, model.Region)
+				if err != nil {
+					return fmt.Errorf("get default nodepool: %w", err)
+				}
+			}
+			err = skeUtils.ApplyClusterSpec(model.Payload, model.Spec, defaultNodepool)
+			if err != nil {
+				return err
+			}
+
 			// Call API
 			req := buildRequest(ctx, model, apiClient)
 			resp, err := req.Execute()
@@ -151,6 +175,7 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 
 func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().Var(flags.ReadFromFileFlag(), payloadFlag, `Request payload (JSON). Can be a string or a file path, if prefixed with "@" (example: @./payload.json). If unset, will use a default payload (you can check it by running "stackit ske cluster generate-payload")`)
+	skeUtils.ConfigureClusterSpecFlags(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inputModel, error) {
@@ -171,10 +196,16 @@ func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inpu
 		}
 	}
 
+	spec, err := skeUtils.ParseClusterSpecFlags(p, cmd)
+	if err != nil {
+		return nil, err
+	}
+
 	model := inputModel{
 		GlobalFlagModel: globalFlags,
 		ClusterName:     clusterName,
 		Payload:         payload,
+		Spec:            spec,
 	}
 
 	p.DebugInputModel(model)
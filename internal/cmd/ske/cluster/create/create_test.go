@@ -9,6 +9,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/testutils"
 
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	skeUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/utils"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
 
 	"github.com/google/go-cmp/cmp"
@@ -149,6 +150,7 @@ func fixtureInputModel(mods ...func(model *inputModel)) *inputModel {
 		},
 		ClusterName: testClusterName,
 		Payload:     testPayload,
+		Spec:        &skeUtils.ClusterSpecOptions{},
 	}
 	for _, mod := range mods {
 		mod(model)
@@ -233,6 +235,33 @@ func TestParseInput(t *testing.T) {
 				model.Payload = nil
 			}),
 		},
+		{
+			description: "default config with flags",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, payloadFlag)
+				flagValues[skeUtils.KubernetesVersionFlag] = "1.33.5"
+				flagValues[skeUtils.NodepoolFlag] = "name=pool-default,max=5"
+			}),
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.Payload = nil
+				model.Spec = &skeUtils.ClusterSpecOptions{
+					KubernetesVersion: utils.Ptr("1.33.5"),
+					Nodepools: []skeUtils.NodepoolOptions{
+						{Name: "pool-default", Maximum: utils.Ptr(int32(5))},
+					},
+				}
+			}),
+		},
+		{
+			description: "invalid nodepool",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[skeUtils.NodepoolFlag] = "max=5"
+			}),
+			isValid: false,
+		},
 		{
 			description: "invalid json",
 			argValues:   fixtureArgValues(),
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/client"
+	skeUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/utils"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/tables"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
 )
@@ -33,8 +35,11 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   fmt.Sprintf("describe %s", clusterNameArg),
 		Short: "Shows details of a SKE cluster",
-		Long:  "Shows details of a STACKIT Kubernetes Engine (SKE) cluster.",
-		Args:  args.SingleArg(clusterNameArg, nil),
+		Long: fmt.Sprintf("%s\n%s",
+			"Shows details of a STACKIT Kubernetes Engine (SKE) cluster.",
+			`A warning is shown if the Kubernetes version or a machine image version of the cluster is deprecated. Use "stackit ske cluster upgrade" to upgrade it.`,
+		),
+		Args: args.SingleArg(clusterNameArg, nil),
 		Example: examples.Build(
 			examples.NewExample(
 				`Get details of a SKE cluster with name "my-cluster"`,
@@ -62,6 +67,16 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 				return fmt.Errorf("read SKE cluster: %w", err)
 			}
 
+			// Deprecated versions are upgraded automatically once they expire
+			options, err := apiClient.DefaultAPI.ListProviderOptions(ctx, model.Region).Execute()
+			if err != nil {
+				params.Printer.Debug(print.ErrorLevel, "get SKE provider options: %v", err)
+			} else {
+				for _, warning := range skeUtils.VersionWarnings(resp, options, time.Now()) {
+					params.Printer.Warn("%s\n", warning)
+				}
+			}
+
 			return outputResult(params.Printer, model.OutputFormat, resp)
 		},
 	}
@@ -107,6 +122,8 @@ func outputResult(p *print.Printer, outputFormat string, cluster *ske.Cluster) e
 		if cluster.HasStatus() {
 			table.AddRow("STATE", utils.PtrString(cluster.Status.Aggregated))
 			table.AddSeparator()
+			table.AddRow("CREDENTIALS ROTATION", skeUtils.CredentialsRotationPhase(cluster))
+			table.AddSeparator()
 			if clusterErrs := cluster.Status.GetErrors(); len(clusterErrs) != 0 {
 				handleClusterErrors(clusterErrs, &table)
 			}
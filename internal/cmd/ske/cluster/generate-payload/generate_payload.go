@@ -81,16 +81,7 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 				if err != nil {
 					return fmt.Errorf("read SKE cluster: %w", err)
 				}
-				payload = &ske.CreateOrUpdateClusterPayload{
-					Access:      resp.Access,
-					Extensions:  resp.Extensions,
-					Hibernation: resp.Hibernation,
-					Kubernetes:  resp.Kubernetes,
-					Maintenance: resp.Maintenance,
-					Network:     resp.Network,
-					Nodepools:   resp.Nodepools,
-					Status:      resp.Status,
-				}
+				payload = skeUtils.PayloadFromCluster(resp)
 			}
 
 			return outputResult(params.Printer, model.FilePath, payload)
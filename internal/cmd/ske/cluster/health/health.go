@@ -0,0 +1,179 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+
+	"github.com/spf13/cobra"
+	ske "github.com/stackitcloud/stackit-sdk-go/services/ske/v2api"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/client"
+	skeUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/utils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/tables"
+)
+
+const (
+	clusterNameArg = "CLUSTER_NAME"
+
+	filepathFlag = "filepath"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+	ClusterName string
+	Filepath    *string
+}
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("health %s", clusterNameArg),
+		Short: "Shows a health report of a SKE cluster",
+		Long: fmt.Sprintf("%s\n%s\n%s",
+			"Shows a health report of a STACKIT Kubernetes Engine (SKE) cluster.",
+			"It summarizes the state and errors of the cluster, the Kubernetes and machine image versions of its nodepools, its maintenance window, the credentials rotation and the expiration of the kubeconfigs of the cluster in the local kubeconfig file.",
+			"Each finding is rated as ok, warning or error, and the report as its most severe finding.",
+		),
+		Args: args.SingleArg(clusterNameArg, nil),
+		Example: examples.Build(
+			examples.NewExample(
+				`Show the health report of the SKE cluster with name "my-cluster"`,
+				"$ stackit ske cluster health my-cluster"),
+			examples.NewExample(
+				`Show the health report of the SKE cluster with name "my-cluster" in JSON format`,
+				"$ stackit ske cluster health my-cluster --output-format json"),
+			examples.NewExample(
+				`Show the health report of the SKE cluster with name "my-cluster" and check the kubeconfigs of a custom kubeconfig file`,
+				"$ stackit ske cluster health my-cluster --filepath /path/to/config"),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			// Configure API client
+			apiClient, err := client.ConfigureClient(params.Printer, params.CliVersion)
+			if err != nil {
+				return err
+			}
+
+			// Call API
+			req := buildRequest(ctx, model, apiClient)
+			cluster, err := req.Execute()
+			if err != nil {
+				return fmt.Errorf("read SKE cluster: %w", err)
+			}
+
+			// The version checks are skipped if the provider options can't be read
+			options, err := apiClient.DefaultAPI.ListProviderOptions(ctx, model.Region).Execute()
+			if err != nil {
+				params.Printer.Debug(print.ErrorLevel, "get SKE provider options: %v", err)
+			}
+
+			entries, err := getKubeconfigEntries(model)
+			if err != nil {
+				params.Printer.Debug(print.ErrorLevel, "read kubeconfig: %v", err)
+			}
+
+			report := skeUtils.ClusterHealth(cluster, options, entries, time.Now())
+			return outputResult(params.Printer, model.OutputFormat, report)
+		},
+	}
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().String(filepathFlag, "", "Path of the kubeconfig file whose kubeconfigs of the cluster are checked. Will fall back to KUBECONFIG env variable if not set. In case both aren't set, the file named 'config' in the .kube folder in the user's home directory is used.")
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inputModel, error) {
+	clusterName := inputArgs[0]
+
+	globalFlags := globalflags.Parse(p, cmd)
+	if globalFlags.ProjectId == "" {
+		return nil, &errors.ProjectIdError{}
+	}
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		ClusterName:     clusterName,
+		Filepath:        flags.FlagToStringPointer(p, cmd, filepathFlag),
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+func buildRequest(ctx context.Context, model *inputModel, apiClient *ske.APIClient) ske.ApiGetClusterRequest {
+	req := apiClient.DefaultAPI.GetCluster(ctx, model.ProjectId, model.Region, model.ClusterName)
+	return req
+}
+
+// getKubeconfigEntries returns the contexts of the cluster in the kubeconfig file, which are none if the file doesn't exist
+func getKubeconfigEntries(model *inputModel) ([]skeUtils.KubeconfigEntry, error) {
+	kubeconfigPath, err := skeUtils.GetKubeconfigPath(model.Filepath)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = os.Stat(kubeconfigPath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	config, err := clientcmd.LoadFromFile(kubeconfigPath)
+	if err != nil {
+		return nil, fmt.Errorf("load kubeconfig %q: %w", kubeconfigPath, err)
+	}
+	// The API server of the cluster isn't known, so admin kubeconfigs are identified by the cluster name in its domain
+	entries := skeUtils.ListKubeconfigEntries(config)
+	return skeUtils.FilterKubeconfigEntriesByCluster(config, entries, model.ProjectId, model.ClusterName, ""), nil
+}
+
+// formatStatus returns the status in upper case, colored by its severity
+func formatStatus(status string) string {
+	switch status {
+	case skeUtils.HealthStatusOK:
+		return print.GreenBold(strings.ToUpper(status))
+	case skeUtils.HealthStatusWarning:
+		return print.YellowBold(strings.ToUpper(status))
+	default:
+		return print.RedBold(strings.ToUpper(status))
+	}
+}
+
+func outputResult(p *print.Printer, outputFormat string, report *skeUtils.HealthReport) error {
+	if report == nil {
+		return fmt.Errorf("health report is nil")
+	}
+
+	return p.OutputResult(outputFormat, report, func() error {
+		p.Outputf("Health of cluster %q: %s\n", report.ClusterName, formatStatus(report.Status))
+
+		table := tables.NewTable()
+		table.SetHeader("CATEGORY", "NAME", "STATUS", "DETAILS")
+		for i := range report.Checks {
+			check := report.Checks[i]
+			table.AddRow(check.Category, check.Name, formatStatus(check.Status), check.Message)
+		}
+		err := table.Display(p)
+		if err != nil {
+			return fmt.Errorf("render table: %w", err)
+		}
+
+		return nil
+	})
+}
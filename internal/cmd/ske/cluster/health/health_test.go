@@ -0,0 +1,299 @@
+package health
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	ske "github.com/stackitcloud/stackit-sdk-go/services/ske/v2api"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	skeUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/utils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testparams"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testutils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+)
+
+var projectIdFlag = globalflags.ProjectIdFlag
+
+type testCtxKey struct{}
+
+var testCtx = context.WithValue(context.Background(), testCtxKey{}, "foo")
+var testClient = &ske.APIClient{DefaultAPI: &ske.DefaultAPIService{}}
+var testProjectId = uuid.NewString()
+var testClusterName = "cluster"
+
+const testRegion = "eu01"
+
+func fixtureArgValues(mods ...func(argValues []string)) []string {
+	argValues := []string{
+		testClusterName,
+	}
+	for _, mod := range mods {
+		mod(argValues)
+	}
+	return argValues
+}
+
+func fixtureFlagValues(mods ...func(flagValues map[string]string)) map[string]string {
+	flagValues := map[string]string{
+		globalflags.ProjectIdFlag: testProjectId,
+		globalflags.RegionFlag:    testRegion,
+	}
+	for _, mod := range mods {
+		mod(flagValues)
+	}
+	return flagValues
+}
+
+func fixtureInputModel(mods ...func(model *inputModel)) *inputModel {
+	model := &inputModel{
+		GlobalFlagModel: &globalflags.GlobalFlagModel{
+			ProjectId: testProjectId,
+			Region:    testRegion,
+			Verbosity: globalflags.VerbosityDefault,
+		},
+		ClusterName: testClusterName,
+	}
+	for _, mod := range mods {
+		mod(model)
+	}
+	return model
+}
+
+func fixtureRequest(mods ...func(request *ske.ApiGetClusterRequest)) ske.ApiGetClusterRequest {
+	request := testClient.DefaultAPI.GetCluster(testCtx, testProjectId, testRegion, testClusterName)
+	for _, mod := range mods {
+		mod(&request)
+	}
+	return request
+}
+
+func TestParseInput(t *testing.T) {
+	tests := []struct {
+		description   string
+		argValues     []string
+		flagValues    map[string]string
+		isValid       bool
+		expectedModel *inputModel
+	}{
+		{
+			description:   "base",
+			argValues:     fixtureArgValues(),
+			flagValues:    fixtureFlagValues(),
+			isValid:       true,
+			expectedModel: fixtureInputModel(),
+		},
+		{
+			description: "filepath",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[filepathFlag] = "/path/to/config"
+			}),
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.Filepath = utils.Ptr("/path/to/config")
+			}),
+		},
+		{
+			description: "no values",
+			argValues:   []string{},
+			flagValues:  map[string]string{},
+			isValid:     false,
+		},
+		{
+			description: "no arg values",
+			argValues:   []string{},
+			flagValues:  fixtureFlagValues(),
+			isValid:     false,
+		},
+		{
+			description: "project id missing",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, projectIdFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "project id invalid",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[projectIdFlag] = "invalid-uuid"
+			}),
+			isValid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			testutils.TestParseInput(t, NewCmd, parseInput, tt.expectedModel, tt.argValues, tt.flagValues, tt.isValid)
+		})
+	}
+}
+
+func TestBuildRequest(t *testing.T) {
+	tests := []struct {
+		description     string
+		model           *inputModel
+		expectedRequest ske.ApiGetClusterRequest
+	}{
+		{
+			description:     "base",
+			model:           fixtureInputModel(),
+			expectedRequest: fixtureRequest(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			request := buildRequest(testCtx, tt.model, testClient)
+
+			diff := cmp.Diff(request, tt.expectedRequest,
+				cmp.AllowUnexported(tt.expectedRequest),
+				cmpopts.EquateComparable(testCtx),
+				cmpopts.EquateComparable(testClient.DefaultAPI),
+			)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGetKubeconfigEntries(t *testing.T) {
+	const kubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: cluster
+  cluster:
+    server: https://api.cluster.abc1234.s.ske.eu01.onstackit.cloud
+- name: other-cluster
+  cluster:
+    server: https://api.other-cluster.abc1234.s.ske.eu01.onstackit.cloud
+contexts:
+- name: cluster
+  context:
+    cluster: cluster
+    user: cluster
+- name: other-cluster
+  context:
+    cluster: other-cluster
+    user: cluster
+users:
+- name: cluster
+  user:
+    token: token
+`
+	kubeconfigPath := filepath.Join(t.TempDir(), "config")
+	err := os.WriteFile(kubeconfigPath, []byte(kubeconfig), 0o600)
+	if err != nil {
+		t.Fatalf("write kubeconfig: %v", err)
+	}
+
+	tests := []struct {
+		description      string
+		filepath         string
+		isValid          bool
+		expectedContexts []string
+	}{
+		{
+			description:      "base",
+			filepath:         kubeconfigPath,
+			isValid:          true,
+			expectedContexts: []string{"cluster"},
+		},
+		{
+			description:      "file doesn't exist",
+			filepath:         filepath.Join(t.TempDir(), "missing"),
+			isValid:          true,
+			expectedContexts: []string{},
+		},
+		{
+			description: "invalid file",
+			filepath:    t.TempDir(),
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := fixtureInputModel(func(model *inputModel) {
+				model.Filepath = utils.Ptr(tt.filepath)
+			})
+			entries, err := getKubeconfigEntries(model)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("did not fail on invalid input")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+
+			contexts := []string{}
+			for i := range entries {
+				contexts = append(contexts, entries[i].Context)
+			}
+			diff := cmp.Diff(contexts, tt.expectedContexts)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestOutputResult(t *testing.T) {
+	type args struct {
+		outputFormat string
+		report       *skeUtils.HealthReport
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name:    "empty",
+			args:    args{},
+			wantErr: true,
+		},
+		{
+			name: "empty report",
+			args: args{
+				report: &skeUtils.HealthReport{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "report",
+			args: args{
+				report: &skeUtils.HealthReport{
+					ClusterName: testClusterName,
+					State:       "STATE_HEALTHY",
+					Status:      skeUtils.HealthStatusWarning,
+					Checks: []skeUtils.HealthCheck{
+						{Category: skeUtils.HealthCategoryState, Name: "aggregated", Status: skeUtils.HealthStatusOK, Message: "STATE_HEALTHY"},
+						{Category: skeUtils.HealthCategoryMaintenance, Name: "window", Status: skeUtils.HealthStatusWarning, Message: "no maintenance window set"},
+						{Category: skeUtils.HealthCategoryError, Name: "SKE_NODE_NO_VMS", Status: skeUtils.HealthStatusError, Message: "no nodes"},
+					},
+				},
+			},
+			wantErr: false,
+		},
+	}
+	params := testparams.NewTestParams()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := outputResult(params.Printer, tt.args.outputFormat, tt.args.report); (err != nil) != tt.wantErr {
+				t.Errorf("outputResult() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package hibernationschedule
+
+import (
+	"fmt"
+
+	"github.com/stackitcloud/stackit-cli/internal/cmd/ske/cluster/hibernation-schedule/list"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/ske/cluster/hibernation-schedule/remove"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/ske/cluster/hibernation-schedule/set"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "hibernation-schedule",
+		Short: "Provides functionality for the hibernation schedules of SKE clusters",
+		Long: fmt.Sprintf("%s\n%s",
+			"Provides functionality for the hibernation schedules of STACKIT Kubernetes Engine (SKE) clusters.",
+			`A cluster is hibernated automatically from the start to the end of each schedule. Use "stackit ske cluster hibernate" and "stackit ske cluster wakeup" to hibernate or wake up a cluster immediately.`,
+		),
+		Args: args.NoArgs,
+		Run:  utils.CmdHelp,
+	}
+	addSubcommands(cmd, params)
+	return cmd
+}
+
+func addSubcommands(cmd *cobra.Command, params *types.CmdParams) {
+	cmd.AddCommand(set.NewCmd(params))
+	cmd.AddCommand(list.NewCmd(params))
+	cmd.AddCommand(remove.NewCmd(params))
+}
@@ -0,0 +1,181 @@
+package list
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+
+	"github.com/spf13/cobra"
+	ske "github.com/stackitcloud/stackit-sdk-go/services/ske/v2api"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/client"
+	skeUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/utils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/tables"
+)
+
+const (
+	clusterNameArg = "CLUSTER_NAME"
+
+	previewFlag = "preview"
+
+	defaultPreview = 5
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+	ClusterName string
+	Preview     int64
+}
+
+// hibernationSchedules are the hibernation schedules of a cluster and their next windows
+type hibernationSchedules struct {
+	Schedules   []ske.HibernationSchedule    `json:"schedules"`
+	NextWindows []skeUtils.HibernationWindow `json:"nextWindows"`
+}
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("list %s", clusterNameArg),
+		Short: "Lists the hibernation schedules of a SKE cluster",
+		Long: fmt.Sprintf("%s\n%s",
+			"Lists the hibernation schedules of a STACKIT Kubernetes Engine (SKE) cluster.",
+			"The next hibernation windows of all schedules are shown as well.",
+		),
+		Args: args.SingleArg(clusterNameArg, nil),
+		Example: examples.Build(
+			examples.NewExample(
+				`List the hibernation schedules of the SKE cluster "my-cluster"`,
+				"$ stackit ske cluster hibernation-schedule list my-cluster"),
+			examples.NewExample(
+				`List the hibernation schedules of the SKE cluster "my-cluster" and the next 10 hibernation windows`,
+				"$ stackit ske cluster hibernation-schedule list my-cluster --preview 10"),
+			examples.NewExample(
+				`List the hibernation schedules of the SKE cluster "my-cluster" in JSON format`,
+				"$ stackit ske cluster hibernation-schedule list my-cluster --output-format json"),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			// Configure API client
+			apiClient, err := client.ConfigureClient(params.Printer, params.CliVersion)
+			if err != nil {
+				return err
+			}
+
+			// Call API
+			req := buildRequest(ctx, model, apiClient)
+			resp, err := req.Execute()
+			if err != nil {
+				return fmt.Errorf("get SKE cluster: %w", err)
+			}
+
+			schedules := hibernationSchedules{
+				Schedules: []ske.HibernationSchedule{},
+			}
+			if resp.Hibernation != nil {
+				schedules.Schedules = resp.Hibernation.Schedules
+			}
+			schedules.NextWindows, err = skeUtils.NextHibernationWindows(schedules.Schedules, time.Now(), int(model.Preview))
+			if err != nil {
+				return fmt.Errorf("get next hibernation windows: %w", err)
+			}
+
+			return outputResult(params.Printer, model.OutputFormat, model.ClusterName, schedules)
+		},
+	}
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().Int64(previewFlag, defaultPreview, "Number of upcoming hibernation windows to show")
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inputModel, error) {
+	clusterName := inputArgs[0]
+
+	globalFlags := globalflags.Parse(p, cmd)
+	if globalFlags.ProjectId == "" {
+		return nil, &errors.ProjectIdError{}
+	}
+
+	preview := flags.FlagWithDefaultToInt64Value(p, cmd, previewFlag)
+	if preview < 0 {
+		return nil, &errors.FlagValidationError{
+			Flag:    previewFlag,
+			Details: "must not be negative",
+		}
+	}
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		ClusterName:     clusterName,
+		Preview:         preview,
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+func buildRequest(ctx context.Context, model *inputModel, apiClient *ske.APIClient) ske.ApiGetClusterRequest {
+	req := apiClient.DefaultAPI.GetCluster(ctx, model.ProjectId, model.Region, model.ClusterName)
+	return req
+}
+
+func outputResult(p *print.Printer, outputFormat, clusterName string, schedules hibernationSchedules) error {
+	return p.OutputResult(outputFormat, schedules, func() error {
+		if len(schedules.Schedules) == 0 {
+			p.Outputf("No hibernation schedules found for cluster %q\n", clusterName)
+			return nil
+		}
+
+		table := tables.NewTable()
+		table.SetTitle("Hibernation schedules")
+		table.SetHeader("INDEX", "START", "END", "TIMEZONE")
+		for i := range schedules.Schedules {
+			schedule := schedules.Schedules[i]
+			timezone := skeUtils.DefaultHibernationTimezone
+			if schedule.Timezone != nil && *schedule.Timezone != "" {
+				timezone = *schedule.Timezone
+			}
+			table.AddRow(i+1, schedule.Start, schedule.End, timezone)
+		}
+		err := table.Display(p)
+		if err != nil {
+			return fmt.Errorf("render table: %w", err)
+		}
+
+		if len(schedules.NextWindows) == 0 {
+			return nil
+		}
+		windowsTable := tables.NewTable()
+		windowsTable.SetTitle("Next hibernation windows")
+		windowsTable.SetHeader("START", "END", "DURATION")
+		for i := range schedules.NextWindows {
+			window := schedules.NextWindows[i]
+			windowsTable.AddRow(
+				window.Start.Format(skeUtils.HibernationWindowTimeFormat),
+				window.End.Format(skeUtils.HibernationWindowTimeFormat),
+				window.End.Sub(window.Start).String(),
+			)
+		}
+		err = windowsTable.Display(p)
+		if err != nil {
+			return fmt.Errorf("render table: %w", err)
+		}
+
+		return nil
+	})
+}
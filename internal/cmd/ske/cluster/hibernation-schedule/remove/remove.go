@@ -0,0 +1,184 @@
+package remove
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+
+	"github.com/spf13/cobra"
+	ske "github.com/stackitcloud/stackit-sdk-go/services/ske/v2api"
+	wait "github.com/stackitcloud/stackit-sdk-go/services/ske/v2api/wait"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/client"
+	skeUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/utils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/spinner"
+)
+
+const (
+	clusterNameArg = "CLUSTER_NAME"
+
+	indexFlag = "index"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+	ClusterName string
+	Index       *int64
+}
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("remove %s", clusterNameArg),
+		Short: "Removes hibernation schedules of a SKE cluster",
+		Long: fmt.Sprintf("%s\n%s",
+			"Removes the hibernation schedules of a STACKIT Kubernetes Engine (SKE) cluster, so that it isn't hibernated automatically anymore.",
+			`If an index is set, only the schedule with this index in the output of "stackit ske cluster hibernation-schedule list" is removed.`,
+		),
+		Args: args.SingleArg(clusterNameArg, nil),
+		Example: examples.Build(
+			examples.NewExample(
+				`Remove all hibernation schedules of the SKE cluster "my-cluster"`,
+				"$ stackit ske cluster hibernation-schedule remove my-cluster"),
+			examples.NewExample(
+				`Remove the second hibernation schedule of the SKE cluster "my-cluster"`,
+				"$ stackit ske cluster hibernation-schedule remove my-cluster --index 2"),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			// Configure API client
+			apiClient, err := client.ConfigureClient(params.Printer, params.CliVersion)
+			if err != nil {
+				return err
+			}
+
+			cluster, err := apiClient.DefaultAPI.GetCluster(ctx, model.ProjectId, model.Region, model.ClusterName).Execute()
+			if err != nil {
+				return fmt.Errorf("get SKE cluster: %w", err)
+			}
+			payload := skeUtils.PayloadFromCluster(cluster)
+			err = removeSchedule(payload, model.Index)
+			if err != nil {
+				return err
+			}
+
+			prompt := fmt.Sprintf("Are you sure you want to remove the hibernation schedules of cluster %q?", model.ClusterName)
+			if model.Index != nil {
+				prompt = fmt.Sprintf("Are you sure you want to remove hibernation schedule %d of cluster %q?", *model.Index, model.ClusterName)
+			}
+			err = params.Printer.PromptForConfirmation(prompt)
+			if err != nil {
+				return err
+			}
+
+			// Call API
+			req := buildRequest(ctx, model, apiClient, payload)
+			resp, err := req.Execute()
+			if err != nil {
+				return fmt.Errorf("update SKE cluster: %w", err)
+			}
+
+			// Wait for async operation, if async mode not enabled
+			if !model.Async {
+				err := spinner.Run(params.Printer, "Removing hibernation schedule", func() error {
+					_, err = wait.UpdateClusterWaitHandler(ctx, apiClient.DefaultAPI, model.ProjectId, model.Region, model.ClusterName).WaitWithContext(ctx)
+					return err
+				})
+				if err != nil {
+					return fmt.Errorf("wait for SKE cluster update: %w", err)
+				}
+			}
+
+			return outputResult(params.Printer, model.OutputFormat, model.Async, model.ClusterName, resp)
+		},
+	}
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().Int64(indexFlag, 0, `Index of the schedule to remove, as shown by "stackit ske cluster hibernation-schedule list". If unset, all schedules are removed`)
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inputModel, error) {
+	clusterName := inputArgs[0]
+
+	globalFlags := globalflags.Parse(p, cmd)
+	if globalFlags.ProjectId == "" {
+		return nil, &errors.ProjectIdError{}
+	}
+
+	index := flags.FlagToInt64Pointer(p, cmd, indexFlag)
+	if index != nil && *index < 1 {
+		return nil, &errors.FlagValidationError{
+			Flag:    indexFlag,
+			Details: "must be greater than 0",
+		}
+	}
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		ClusterName:     clusterName,
+		Index:           index,
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+// removeSchedule removes the schedule with the given index, starting at 1, from the payload, or all schedules if the index is nil
+func removeSchedule(payload *ske.CreateOrUpdateClusterPayload, index *int64) error {
+	if payload.Hibernation == nil || len(payload.Hibernation.Schedules) == 0 {
+		return fmt.Errorf("the cluster has no hibernation schedules")
+	}
+	if index == nil {
+		payload.Hibernation = nil
+		return nil
+	}
+
+	schedules := payload.Hibernation.Schedules
+	if *index > int64(len(schedules)) {
+		return fmt.Errorf("the cluster has no hibernation schedule with index %d, it has %d schedule(s)", *index, len(schedules))
+	}
+	schedules = slices.Delete(slices.Clone(schedules), int(*index-1), int(*index))
+	if len(schedules) == 0 {
+		payload.Hibernation = nil
+		return nil
+	}
+	payload.Hibernation.Schedules = schedules
+	return nil
+}
+
+func buildRequest(ctx context.Context, model *inputModel, apiClient *ske.APIClient, payload *ske.CreateOrUpdateClusterPayload) ske.ApiCreateOrUpdateClusterRequest {
+	req := apiClient.DefaultAPI.CreateOrUpdateCluster(ctx, model.ProjectId, model.Region, model.ClusterName)
+
+	req = req.CreateOrUpdateClusterPayload(*payload)
+	return req
+}
+
+func outputResult(p *print.Printer, outputFormat string, async bool, clusterName string, cluster *ske.Cluster) error {
+	if cluster == nil {
+		return fmt.Errorf("cluster is nil")
+	}
+
+	return p.OutputResult(outputFormat, cluster, func() error {
+		operationState := "Removed"
+		if async {
+			operationState = "Triggered removal of"
+		}
+		p.Info("%s hibernation schedule of cluster %q\n", operationState, clusterName)
+		return nil
+	})
+}
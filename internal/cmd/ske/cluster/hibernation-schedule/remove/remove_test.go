@@ -0,0 +1,297 @@
+package remove
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testparams"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testutils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	ske "github.com/stackitcloud/stackit-sdk-go/services/ske/v2api"
+)
+
+var projectIdFlag = globalflags.ProjectIdFlag
+
+type testCtxKey struct{}
+
+var testCtx = context.WithValue(context.Background(), testCtxKey{}, "foo")
+var testClient = &ske.APIClient{DefaultAPI: &ske.DefaultAPIService{}}
+var testProjectId = uuid.NewString()
+var testClusterName = "cluster"
+
+const testRegion = "eu01"
+
+var testPayload = ske.CreateOrUpdateClusterPayload{
+	Kubernetes: ske.Kubernetes{
+		Version: "1.33.5",
+	},
+	Hibernation: &ske.Hibernation{
+		Schedules: []ske.HibernationSchedule{
+			{Start: "0 18 * * 1-5", End: "0 8 * * 1-5", Timezone: utils.Ptr("Europe/Berlin")},
+		},
+	},
+}
+
+func fixtureArgValues(mods ...func(argValues []string)) []string {
+	argValues := []string{
+		testClusterName,
+	}
+	for _, mod := range mods {
+		mod(argValues)
+	}
+	return argValues
+}
+
+func fixtureFlagValues(mods ...func(flagValues map[string]string)) map[string]string {
+	flagValues := map[string]string{
+		globalflags.ProjectIdFlag: testProjectId,
+		globalflags.RegionFlag:    testRegion,
+		indexFlag:                 "1",
+	}
+	for _, mod := range mods {
+		mod(flagValues)
+	}
+	return flagValues
+}
+
+func fixtureInputModel(mods ...func(model *inputModel)) *inputModel {
+	model := &inputModel{
+		GlobalFlagModel: &globalflags.GlobalFlagModel{
+			ProjectId: testProjectId,
+			Region:    testRegion,
+			Verbosity: globalflags.VerbosityDefault,
+		},
+		ClusterName: testClusterName,
+		Index:       utils.Ptr(int64(1)),
+	}
+	for _, mod := range mods {
+		mod(model)
+	}
+	return model
+}
+
+func fixtureRequest(mods ...func(request *ske.ApiCreateOrUpdateClusterRequest)) ske.ApiCreateOrUpdateClusterRequest {
+	request := testClient.DefaultAPI.CreateOrUpdateCluster(testCtx, testProjectId, testRegion, testClusterName)
+	request = request.CreateOrUpdateClusterPayload(testPayload)
+	for _, mod := range mods {
+		mod(&request)
+	}
+	return request
+}
+
+func TestParseInput(t *testing.T) {
+	tests := []struct {
+		description   string
+		argValues     []string
+		flagValues    map[string]string
+		isValid       bool
+		expectedModel *inputModel
+	}{
+		{
+			description:   "base",
+			argValues:     fixtureArgValues(),
+			flagValues:    fixtureFlagValues(),
+			isValid:       true,
+			expectedModel: fixtureInputModel(),
+		},
+		{
+			description: "no index",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, indexFlag)
+			}),
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.Index = nil
+			}),
+		},
+		{
+			description: "no values",
+			argValues:   []string{},
+			flagValues:  map[string]string{},
+			isValid:     false,
+		},
+		{
+			description: "no arg values",
+			argValues:   []string{},
+			flagValues:  fixtureFlagValues(),
+			isValid:     false,
+		},
+		{
+			description: "project id missing",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, projectIdFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "project id invalid",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[projectIdFlag] = "invalid-uuid"
+			}),
+			isValid: false,
+		},
+		{
+			description: "index invalid",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[indexFlag] = "0"
+			}),
+			isValid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			testutils.TestParseInput(t, NewCmd, parseInput, tt.expectedModel, tt.argValues, tt.flagValues, tt.isValid)
+		})
+	}
+}
+
+func TestRemoveSchedule(t *testing.T) {
+	nights := ske.HibernationSchedule{Start: "0 18 * * 1-5", End: "0 8 * * 1-5"}
+	weekend := ske.HibernationSchedule{Start: "0 0 * * 6", End: "0 0 * * 0"}
+
+	tests := []struct {
+		description         string
+		hibernation         *ske.Hibernation
+		index               *int64
+		isValid             bool
+		expectedHibernation *ske.Hibernation
+	}{
+		{
+			description:         "remove all",
+			hibernation:         &ske.Hibernation{Schedules: []ske.HibernationSchedule{nights, weekend}},
+			isValid:             true,
+			expectedHibernation: nil,
+		},
+		{
+			description:         "remove by index",
+			hibernation:         &ske.Hibernation{Schedules: []ske.HibernationSchedule{nights, weekend}},
+			index:               utils.Ptr(int64(1)),
+			isValid:             true,
+			expectedHibernation: &ske.Hibernation{Schedules: []ske.HibernationSchedule{weekend}},
+		},
+		{
+			description:         "remove last schedule",
+			hibernation:         &ske.Hibernation{Schedules: []ske.HibernationSchedule{nights}},
+			index:               utils.Ptr(int64(1)),
+			isValid:             true,
+			expectedHibernation: nil,
+		},
+		{
+			description: "index out of range",
+			hibernation: &ske.Hibernation{Schedules: []ske.HibernationSchedule{nights, weekend}},
+			index:       utils.Ptr(int64(3)),
+		},
+		{
+			description: "no hibernation",
+		},
+		{
+			description: "no schedules",
+			hibernation: &ske.Hibernation{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			payload := &ske.CreateOrUpdateClusterPayload{Hibernation: tt.hibernation}
+
+			err := removeSchedule(payload, tt.index)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("did not fail on invalid input")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			diff := cmp.Diff(payload.Hibernation, tt.expectedHibernation)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildRequest(t *testing.T) {
+	tests := []struct {
+		description     string
+		model           *inputModel
+		payload         *ske.CreateOrUpdateClusterPayload
+		expectedRequest ske.ApiCreateOrUpdateClusterRequest
+	}{
+		{
+			description:     "base",
+			model:           fixtureInputModel(),
+			payload:         &testPayload,
+			expectedRequest: fixtureRequest(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			request := buildRequest(testCtx, tt.model, testClient, tt.payload)
+
+			diff := cmp.Diff(request, tt.expectedRequest,
+				cmp.AllowUnexported(tt.expectedRequest),
+				cmpopts.EquateComparable(testCtx),
+				cmpopts.EquateComparable(testClient.DefaultAPI),
+			)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestOutputResult(t *testing.T) {
+	type args struct {
+		outputFormat string
+		async        bool
+		cluster      *ske.Cluster
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name:    "empty",
+			args:    args{},
+			wantErr: true,
+		},
+		{
+			name: "empty cluster",
+			args: args{
+				cluster: &ske.Cluster{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "async",
+			args: args{
+				async:   true,
+				cluster: &ske.Cluster{},
+			},
+			wantErr: false,
+		},
+	}
+	params := testparams.NewTestParams()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := outputResult(params.Printer, tt.args.outputFormat, tt.args.async, testClusterName, tt.args.cluster); (err != nil) != tt.wantErr {
+				t.Errorf("outputResult() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
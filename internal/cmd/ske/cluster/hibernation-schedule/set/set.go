@@ -0,0 +1,236 @@
+package set
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+
+	"github.com/spf13/cobra"
+	ske "github.com/stackitcloud/stackit-sdk-go/services/ske/v2api"
+	wait "github.com/stackitcloud/stackit-sdk-go/services/ske/v2api/wait"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/client"
+	skeUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/utils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/spinner"
+)
+
+const (
+	clusterNameArg = "CLUSTER_NAME"
+
+	startFlag    = "start"
+	endFlag      = "end"
+	timezoneFlag = "timezone"
+	addFlag      = "add"
+	previewFlag  = "preview"
+
+	defaultPreview = 5
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+	ClusterName string
+	Schedule    ske.HibernationSchedule
+	Add         bool
+	Preview     int64
+}
+
+type hibernationScheduleResult struct {
+	Cluster     *ske.Cluster                 `json:"cluster"`
+	NextWindows []skeUtils.HibernationWindow `json:"nextWindows"`
+}
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("set %s", clusterNameArg),
+		Short: "Sets the hibernation schedule of a SKE cluster",
+		Long: fmt.Sprintf("%s\n%s\n%s",
+			"Sets the hibernation schedule of a STACKIT Kubernetes Engine (SKE) cluster, replacing its current schedules.",
+			"The start and end of the hibernation are cron expressions with the five fields minute, hour, day of month, month and day of week, which are evaluated in the given time zone.",
+			"The next hibernation windows are shown before the schedule is set and are part of the JSON and YAML output.",
+		),
+		Args: args.SingleArg(clusterNameArg, nil),
+		Example: examples.Build(
+			examples.NewExample(
+				`Hibernate the SKE cluster "my-cluster" every night and weekend, from 18:00 to 08:00 on weekdays in Berlin time`,
+				`$ stackit ske cluster hibernation-schedule set my-cluster --start "0 18 * * 1-5" --end "0 8 * * 1-5" --timezone Europe/Berlin`),
+			examples.NewExample(
+				`Add a schedule which hibernates the SKE cluster "my-cluster" on Saturdays, keeping its current schedules`,
+				`$ stackit ske cluster hibernation-schedule set my-cluster --start "0 0 * * 6" --end "0 0 * * 0" --add`),
+			examples.NewExample(
+				`Set the hibernation schedule of the SKE cluster "my-cluster" and show the next 10 hibernation windows`,
+				`$ stackit ske cluster hibernation-schedule set my-cluster --start "0 20 * * *" --end "0 6 * * *" --preview 10`),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			// Configure API client
+			apiClient, err := client.ConfigureClient(params.Printer, params.CliVersion)
+			if err != nil {
+				return err
+			}
+
+			cluster, err := apiClient.DefaultAPI.GetCluster(ctx, model.ProjectId, model.Region, model.ClusterName).Execute()
+			if err != nil {
+				return fmt.Errorf("get SKE cluster: %w", err)
+			}
+			payload := skeUtils.PayloadFromCluster(cluster)
+			applySchedule(payload, model)
+
+			windows, err := skeUtils.NextHibernationWindows(payload.Hibernation.Schedules, time.Now(), int(model.Preview))
+			if err != nil {
+				return fmt.Errorf("get next hibernation windows: %w", err)
+			}
+			// Show the preview on every run, also if the confirmation is skipped
+			if len(windows) > 0 {
+				params.Printer.Info("The next hibernation windows of cluster %q will be:\n%s\n", model.ClusterName, formatWindows(windows))
+			}
+			prompt := fmt.Sprintf("Are you sure you want to set the hibernation schedule of cluster %q?", model.ClusterName)
+			err = params.Printer.PromptForConfirmation(prompt)
+			if err != nil {
+				return err
+			}
+
+			// Call API
+			req := buildRequest(ctx, model, apiClient, payload)
+			resp, err := req.Execute()
+			if err != nil {
+				return fmt.Errorf("update SKE cluster: %w", err)
+			}
+
+			// Wait for async operation, if async mode not enabled
+			if !model.Async {
+				err := spinner.Run(params.Printer, "Setting hibernation schedule", func() error {
+					_, err = wait.UpdateClusterWaitHandler(ctx, apiClient.DefaultAPI, model.ProjectId, model.Region, model.ClusterName).WaitWithContext(ctx)
+					return err
+				})
+				if err != nil {
+					return fmt.Errorf("wait for SKE cluster update: %w", err)
+				}
+			}
+
+			return outputResult(params.Printer, model.OutputFormat, model.Async, model.ClusterName, resp, windows)
+		},
+	}
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().String(startFlag, "", `Cron expression of the start of the hibernation (example: "0 18 * * 1-5")`)
+	cmd.Flags().String(endFlag, "", `Cron expression of the end of the hibernation (example: "0 8 * * 1-5")`)
+	cmd.Flags().String(timezoneFlag, "", fmt.Sprintf(`Time zone of the cron expressions (example: "Europe/Berlin"). If unset, %s is used`, skeUtils.DefaultHibernationTimezone))
+	cmd.Flags().Bool(addFlag, false, "If set, the schedule is added to the current schedules of the cluster instead of replacing them")
+	cmd.Flags().Int64(previewFlag, defaultPreview, "Number of upcoming hibernation windows to show")
+
+	err := flags.MarkFlagsRequired(cmd, startFlag, endFlag)
+	cobra.CheckErr(err)
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inputModel, error) {
+	clusterName := inputArgs[0]
+
+	globalFlags := globalflags.Parse(p, cmd)
+	if globalFlags.ProjectId == "" {
+		return nil, &errors.ProjectIdError{}
+	}
+
+	schedule := ske.HibernationSchedule{
+		Start:    flags.FlagToStringValue(p, cmd, startFlag),
+		End:      flags.FlagToStringValue(p, cmd, endFlag),
+		Timezone: flags.FlagToStringPointer(p, cmd, timezoneFlag),
+	}
+	for flag, expression := range map[string]string{
+		startFlag: schedule.Start,
+		endFlag:   schedule.End,
+	} {
+		if _, err := skeUtils.ParseCron(expression); err != nil {
+			return nil, &errors.FlagValidationError{
+				Flag:    flag,
+				Details: err.Error(),
+			}
+		}
+	}
+	if _, err := skeUtils.LoadHibernationTimezone(schedule.Timezone); err != nil {
+		return nil, &errors.FlagValidationError{
+			Flag:    timezoneFlag,
+			Details: err.Error(),
+		}
+	}
+
+	preview := flags.FlagWithDefaultToInt64Value(p, cmd, previewFlag)
+	if preview < 0 {
+		return nil, &errors.FlagValidationError{
+			Flag:    previewFlag,
+			Details: "must not be negative",
+		}
+	}
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		ClusterName:     clusterName,
+		Schedule:        schedule,
+		Add:             flags.FlagToBoolValue(p, cmd, addFlag),
+		Preview:         preview,
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+// applySchedule sets the schedule of the model as the hibernation schedule of the payload, or adds it to the current schedules
+func applySchedule(payload *ske.CreateOrUpdateClusterPayload, model *inputModel) {
+	if model.Add && payload.Hibernation != nil {
+		payload.Hibernation.Schedules = append(payload.Hibernation.Schedules, model.Schedule)
+		return
+	}
+	payload.Hibernation = &ske.Hibernation{
+		Schedules: []ske.HibernationSchedule{model.Schedule},
+	}
+}
+
+func formatWindows(windows []skeUtils.HibernationWindow) string {
+	lines := []string{}
+	for i := range windows {
+		lines = append(lines, fmt.Sprintf("  - %s", windows[i]))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func buildRequest(ctx context.Context, model *inputModel, apiClient *ske.APIClient, payload *ske.CreateOrUpdateClusterPayload) ske.ApiCreateOrUpdateClusterRequest {
+	req := apiClient.DefaultAPI.CreateOrUpdateCluster(ctx, model.ProjectId, model.Region, model.ClusterName)
+
+	req = req.CreateOrUpdateClusterPayload(*payload)
+	return req
+}
+
+func outputResult(p *print.Printer, outputFormat string, async bool, clusterName string, cluster *ske.Cluster, windows []skeUtils.HibernationWindow) error {
+	if cluster == nil {
+		return fmt.Errorf("cluster is nil")
+	}
+
+	result := hibernationScheduleResult{
+		Cluster:     cluster,
+		NextWindows: windows,
+	}
+	return p.OutputResult(outputFormat, result, func() error {
+		operationState := "Set"
+		if async {
+			operationState = "Triggered setting of"
+		}
+		p.Info("%s hibernation schedule of cluster %q\n", operationState, clusterName)
+		return nil
+	})
+}
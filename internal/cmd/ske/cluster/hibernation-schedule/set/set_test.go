@@ -0,0 +1,346 @@
+package set
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	skeUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/utils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testparams"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testutils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	ske "github.com/stackitcloud/stackit-sdk-go/services/ske/v2api"
+)
+
+var projectIdFlag = globalflags.ProjectIdFlag
+
+type testCtxKey struct{}
+
+var testCtx = context.WithValue(context.Background(), testCtxKey{}, "foo")
+var testClient = &ske.APIClient{DefaultAPI: &ske.DefaultAPIService{}}
+var testProjectId = uuid.NewString()
+var testClusterName = "cluster"
+
+const testRegion = "eu01"
+
+var testPayload = ske.CreateOrUpdateClusterPayload{
+	Kubernetes: ske.Kubernetes{
+		Version: "1.33.5",
+	},
+	Hibernation: &ske.Hibernation{
+		Schedules: []ske.HibernationSchedule{
+			{Start: "0 18 * * 1-5", End: "0 8 * * 1-5", Timezone: utils.Ptr("Europe/Berlin")},
+		},
+	},
+}
+
+func fixtureArgValues(mods ...func(argValues []string)) []string {
+	argValues := []string{
+		testClusterName,
+	}
+	for _, mod := range mods {
+		mod(argValues)
+	}
+	return argValues
+}
+
+func fixtureFlagValues(mods ...func(flagValues map[string]string)) map[string]string {
+	flagValues := map[string]string{
+		globalflags.ProjectIdFlag: testProjectId,
+		globalflags.RegionFlag:    testRegion,
+		startFlag:                 "0 18 * * 1-5",
+		endFlag:                   "0 8 * * 1-5",
+		timezoneFlag:              "Europe/Berlin",
+	}
+	for _, mod := range mods {
+		mod(flagValues)
+	}
+	return flagValues
+}
+
+func fixtureInputModel(mods ...func(model *inputModel)) *inputModel {
+	model := &inputModel{
+		GlobalFlagModel: &globalflags.GlobalFlagModel{
+			ProjectId: testProjectId,
+			Region:    testRegion,
+			Verbosity: globalflags.VerbosityDefault,
+		},
+		ClusterName: testClusterName,
+		Schedule: ske.HibernationSchedule{
+			Start:    "0 18 * * 1-5",
+			End:      "0 8 * * 1-5",
+			Timezone: utils.Ptr("Europe/Berlin"),
+		},
+		Preview: defaultPreview,
+	}
+	for _, mod := range mods {
+		mod(model)
+	}
+	return model
+}
+
+func fixtureRequest(mods ...func(request *ske.ApiCreateOrUpdateClusterRequest)) ske.ApiCreateOrUpdateClusterRequest {
+	request := testClient.DefaultAPI.CreateOrUpdateCluster(testCtx, testProjectId, testRegion, testClusterName)
+	request = request.CreateOrUpdateClusterPayload(testPayload)
+	for _, mod := range mods {
+		mod(&request)
+	}
+	return request
+}
+
+func TestParseInput(t *testing.T) {
+	tests := []struct {
+		description   string
+		argValues     []string
+		flagValues    map[string]string
+		isValid       bool
+		expectedModel *inputModel
+	}{
+		{
+			description:   "base",
+			argValues:     fixtureArgValues(),
+			flagValues:    fixtureFlagValues(),
+			isValid:       true,
+			expectedModel: fixtureInputModel(),
+		},
+		{
+			description: "add and preview",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[addFlag] = "true"
+				flagValues[previewFlag] = "10"
+			}),
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.Add = true
+				model.Preview = 10
+			}),
+		},
+		{
+			description: "no timezone",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, timezoneFlag)
+			}),
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.Schedule.Timezone = nil
+			}),
+		},
+		{
+			description: "no values",
+			argValues:   []string{},
+			flagValues:  map[string]string{},
+			isValid:     false,
+		},
+		{
+			description: "no arg values",
+			argValues:   []string{},
+			flagValues:  fixtureFlagValues(),
+			isValid:     false,
+		},
+		{
+			description: "project id missing",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, projectIdFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "project id invalid",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[projectIdFlag] = "invalid-uuid"
+			}),
+			isValid: false,
+		},
+		{
+			description: "end missing",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, endFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "start invalid",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[startFlag] = "0 18 * * MON-XYZ"
+			}),
+			isValid: false,
+		},
+		{
+			description: "end with seconds",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[endFlag] = "0 0 8 * * 1-5"
+			}),
+			isValid: false,
+		},
+		{
+			description: "timezone invalid",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[timezoneFlag] = "Europe/Nowhere"
+			}),
+			isValid: false,
+		},
+		{
+			description: "preview negative",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[previewFlag] = "-1"
+			}),
+			isValid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			testutils.TestParseInput(t, NewCmd, parseInput, tt.expectedModel, tt.argValues, tt.flagValues, tt.isValid)
+		})
+	}
+}
+
+func TestApplySchedule(t *testing.T) {
+	weekend := ske.HibernationSchedule{Start: "0 0 * * 6", End: "0 0 * * 0"}
+
+	tests := []struct {
+		description       string
+		hibernation       *ske.Hibernation
+		add               bool
+		expectedSchedules []ske.HibernationSchedule
+	}{
+		{
+			description:       "no hibernation",
+			expectedSchedules: []ske.HibernationSchedule{weekend},
+		},
+		{
+			description:       "replace",
+			hibernation:       &ske.Hibernation{Schedules: testPayload.Hibernation.Schedules},
+			expectedSchedules: []ske.HibernationSchedule{weekend},
+		},
+		{
+			description:       "add",
+			hibernation:       &ske.Hibernation{Schedules: testPayload.Hibernation.Schedules},
+			add:               true,
+			expectedSchedules: []ske.HibernationSchedule{testPayload.Hibernation.Schedules[0], weekend},
+		},
+		{
+			description:       "add without hibernation",
+			add:               true,
+			expectedSchedules: []ske.HibernationSchedule{weekend},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			payload := &ske.CreateOrUpdateClusterPayload{Hibernation: tt.hibernation}
+			model := fixtureInputModel(func(model *inputModel) {
+				model.Schedule = weekend
+				model.Add = tt.add
+			})
+
+			applySchedule(payload, model)
+
+			diff := cmp.Diff(payload.Hibernation.Schedules, tt.expectedSchedules)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildRequest(t *testing.T) {
+	tests := []struct {
+		description     string
+		model           *inputModel
+		payload         *ske.CreateOrUpdateClusterPayload
+		expectedRequest ske.ApiCreateOrUpdateClusterRequest
+	}{
+		{
+			description:     "base",
+			model:           fixtureInputModel(),
+			payload:         &testPayload,
+			expectedRequest: fixtureRequest(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			request := buildRequest(testCtx, tt.model, testClient, tt.payload)
+
+			diff := cmp.Diff(request, tt.expectedRequest,
+				cmp.AllowUnexported(tt.expectedRequest),
+				cmpopts.EquateComparable(testCtx),
+				cmpopts.EquateComparable(testClient.DefaultAPI),
+			)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestOutputResult(t *testing.T) {
+	type args struct {
+		outputFormat string
+		async        bool
+		cluster      *ske.Cluster
+		windows      []skeUtils.HibernationWindow
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name:    "empty",
+			args:    args{},
+			wantErr: true,
+		},
+		{
+			name: "empty cluster",
+			args: args{
+				cluster: &ske.Cluster{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "async",
+			args: args{
+				async:   true,
+				cluster: &ske.Cluster{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "json output with windows",
+			args: args{
+				outputFormat: print.JSONOutputFormat,
+				cluster:      &ske.Cluster{},
+				windows: []skeUtils.HibernationWindow{
+					{Start: time.Date(2026, 1, 5, 18, 0, 0, 0, time.UTC), End: time.Date(2026, 1, 6, 8, 0, 0, 0, time.UTC)},
+				},
+			},
+			wantErr: false,
+		},
+	}
+	params := testparams.NewTestParams()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := outputResult(params.Printer, tt.args.outputFormat, tt.args.async, testClusterName, tt.args.cluster, tt.args.windows); (err != nil) != tt.wantErr {
+				t.Errorf("outputResult() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
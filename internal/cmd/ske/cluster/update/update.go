@@ -31,20 +31,34 @@ const (
 type inputModel struct {
 	*globalflags.GlobalFlagModel
 	ClusterName string
-	Payload     ske.CreateOrUpdateClusterPayload
+	Payload     *ske.CreateOrUpdateClusterPayload
+	Spec        *skeUtils.ClusterSpecOptions
 }
 
 func NewCmd(params *types.CmdParams) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   fmt.Sprintf("update %s", clusterNameArg),
 		Short: "Updates a SKE cluster",
-		Long: fmt.Sprintf("%s\n%s\n%s",
+		Long: fmt.Sprintf("%s\n%s\n%s\n%s",
 			"Updates a STACKIT Kubernetes Engine (SKE) cluster.",
 			"The payload can be provided as a JSON string or a file path prefixed with \"@\".",
 			"See https://docs.api.stackit.cloud/documentation/ske/version/v1#tag/Cluster/operation/SkeService_CreateOrUpdateCluster for information regarding the payload structure.",
+			"Common settings, like the Kubernetes version, nodepools, maintenance window, hibernation schedule and extensions, can also be changed with flags instead. They are merged into the payload or, if no payload is set, into the current configuration of the cluster.",
 		),
 		Args: args.SingleArg(clusterNameArg, nil),
 		Example: examples.Build(
+			examples.NewExample(
+				`Update the Kubernetes version of a SKE cluster to 1.33.5`,
+				"$ stackit ske cluster update my-cluster --kubernetes-version 1.33.5"),
+			examples.NewExample(
+				`Increase the maximum size of the nodepool "pool-default" of a SKE cluster to 10 nodes`,
+				"$ stackit ske cluster update my-cluster --nodepool name=pool-default,max=10"),
+			examples.NewExample(
+				`Add a nodepool to a SKE cluster and remove the nodepool "old-pool"`,
+				"$ stackit ske cluster update my-cluster --nodepool name=workers,machine-type=c2i.4,min=1,max=3,zones=eu01-1 --remove-nodepool old-pool"),
+			examples.NewExample(
+				`Hibernate a SKE cluster on weekday evenings and enable the observability extension`,
+				`$ stackit ske cluster update my-cluster --hibernation-start "0 18 * * 1-5" --hibernation-end "0 8 * * 1-5" --hibernation-timezone Europe/Berlin --observability-instance-id xxx`),
 			examples.NewExample(
 				`Update a SKE cluster using an API payload sourced from the file "./payload.json"`,
 				"$ stackit ske cluster update my-cluster --payload @./payload.json"),
@@ -85,6 +99,26 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 				return fmt.Errorf("cluster with name %s does not exist", model.ClusterName)
 			}
 
+			// Merge the flags into the payload, or into the current spec of the cluster
+			if model.Payload == nil {
+				cluster, err := apiClient.DefaultAPI.GetCluster(ctx, model.ProjectId, model.Region, model.ClusterName).Execute()
+				if err != nil {
+					return fmt.Errorf("get SKE cluster: %w", err)
+				}
+				model.Payload = skeUtils.PayloadFromCluster(cluster)
+			}
+			var defaultNodepool *ske.Nodepool
+			if skeUtils.AddsNodepool(model.Payload, model.Spec) {
+				defaultNodepool, err = skeUtils.GetDefaultNodepool(ctx, apiClient.DefaultAPI, model.Region)
+				if err != nil {
+					return fmt.Errorf("get default nodepool: %w", err)
+				}
+			}
+			err = skeUtils.ApplyClusterSpec(model.Payload, model.Spec, defaultNodepool)
+			if err != nil {
+				return err
+			}
+
 			// Call API
 			req := buildRequest(ctx, model, apiClient)
 			resp, err := req.Execute()
@@ -112,10 +146,8 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 }
 
 func configureFlags(cmd *cobra.Command) {
-	cmd.Flags().Var(flags.ReadFromFileFlag(), payloadFlag, `Request payload (JSON). Can be a string or a file path, if prefixed with "@". Example: @./payload.json`)
-
-	err := flags.MarkFlagsRequired(cmd, payloadFlag)
-	cobra.CheckErr(err)
+	cmd.Flags().Var(flags.ReadFromFileFlag(), payloadFlag, `Request payload (JSON). Can be a string or a file path, if prefixed with "@". Example: @./payload.json. If unset, the flags are merged into the current configuration of the cluster`)
+	skeUtils.ConfigureClusterSpecFlags(cmd)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inputModel, error) {
@@ -126,17 +158,29 @@ func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inpu
 		return nil, &errors.ProjectIdError{}
 	}
 
-	payloadString := flags.FlagToStringValue(p, cmd, payloadFlag)
-	var payload ske.CreateOrUpdateClusterPayload
-	err := json.Unmarshal([]byte(payloadString), &payload)
+	payloadValue := flags.FlagToStringPointer(p, cmd, payloadFlag)
+	var payload *ske.CreateOrUpdateClusterPayload
+	if payloadValue != nil {
+		payload = &ske.CreateOrUpdateClusterPayload{}
+		err := json.Unmarshal([]byte(*payloadValue), payload)
+		if err != nil {
+			return nil, fmt.Errorf("encode payload: %w", err)
+		}
+	}
+
+	spec, err := skeUtils.ParseClusterSpecFlags(p, cmd)
 	if err != nil {
-		return nil, fmt.Errorf("encode payload: %w", err)
+		return nil, err
+	}
+	if payload == nil && spec.IsEmpty() {
+		return nil, &errors.EmptyUpdateError{}
 	}
 
 	model := inputModel{
 		GlobalFlagModel: globalFlags,
 		ClusterName:     clusterName,
 		Payload:         payload,
+		Spec:            spec,
 	}
 
 	p.DebugInputModel(model)
@@ -146,7 +190,7 @@ func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inpu
 func buildRequest(ctx context.Context, model *inputModel, apiClient *ske.APIClient) ske.ApiCreateOrUpdateClusterRequest {
 	req := apiClient.DefaultAPI.CreateOrUpdateCluster(ctx, model.ProjectId, model.Region, model.ClusterName)
 
-	req = req.CreateOrUpdateClusterPayload(model.Payload)
+	req = req.CreateOrUpdateClusterPayload(*model.Payload)
 	return req
 }
 
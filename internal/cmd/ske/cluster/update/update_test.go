@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	skeUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/utils"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/testparams"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/testutils"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
@@ -147,7 +148,8 @@ func fixtureInputModel(mods ...func(model *inputModel)) *inputModel {
 			Verbosity: globalflags.VerbosityDefault,
 		},
 		ClusterName: testClusterName,
-		Payload:     testPayload,
+		Payload:     &testPayload,
+		Spec:        &skeUtils.ClusterSpecOptions{},
 	}
 	for _, mod := range mods {
 		mod(model)
@@ -221,6 +223,61 @@ func TestParseInput(t *testing.T) {
 			}),
 			isValid: false,
 		},
+		{
+			description: "flags without payload",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, payloadFlag)
+				flagValues[skeUtils.NodepoolFlag] = "name=np-name,max=10"
+				flagValues[skeUtils.DisableACLFlag] = "true"
+			}),
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.Payload = nil
+				model.Spec = &skeUtils.ClusterSpecOptions{
+					Nodepools: []skeUtils.NodepoolOptions{
+						{Name: "np-name", Maximum: utils.Ptr(int32(10))},
+					},
+					DisableACL: true,
+				}
+			}),
+		},
+		{
+			description: "no payload and no flags",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, payloadFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "acl and disable acl",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[skeUtils.ACLFlag] = "198.51.100.0/24"
+				flagValues[skeUtils.DisableACLFlag] = "true"
+			}),
+			isValid: false,
+		},
+		{
+			description: "hibernation start invalid",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[skeUtils.HibernationStartFlag] = "0 18 * *"
+				flagValues[skeUtils.HibernationEndFlag] = "0 8 * * 1-5"
+			}),
+			isValid: false,
+		},
+		{
+			description: "hibernation timezone invalid",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[skeUtils.HibernationStartFlag] = "0 18 * * 1-5"
+				flagValues[skeUtils.HibernationEndFlag] = "0 8 * * 1-5"
+				flagValues[skeUtils.HibernationTimezoneFlag] = "Europe/Nowhere"
+			}),
+			isValid: false,
+		},
 		{
 			description: "invalid json",
 			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
@@ -0,0 +1,267 @@
+package upgrade
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+
+	"github.com/spf13/cobra"
+	ske "github.com/stackitcloud/stackit-sdk-go/services/ske/v2api"
+	wait "github.com/stackitcloud/stackit-sdk-go/services/ske/v2api/wait"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/client"
+	skeUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/utils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/spinner"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/tables"
+)
+
+const (
+	clusterNameArg = "CLUSTER_NAME"
+
+	toVersionFlag = "to-version"
+	dryRunFlag    = "dry-run"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+	ClusterName string
+	ToVersion   string
+	DryRun      bool
+}
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("upgrade %s", clusterNameArg),
+		Short: "Upgrades a SKE cluster to a newer Kubernetes version",
+		Long: fmt.Sprintf("%s\n%s\n%s\n%s",
+			"Upgrades a STACKIT Kubernetes Engine (SKE) cluster to a newer Kubernetes version and the machine images of its nodepools to their latest supported version.",
+			fmt.Sprintf("If the --%s flag is unset, the cluster is upgraded to the latest supported Kubernetes version.", toVersionFlag),
+			"Kubernetes can only be upgraded by one minor version at a time, so the cluster is updated step by step, using the latest available patch version of every minor version in between. The machine images are upgraded in a final step.",
+			"Every step but the last is waited for, also in async mode. If a step fails, the upgrade can be resumed by running the command again.",
+		),
+		Args: args.SingleArg(clusterNameArg, nil),
+		Example: examples.Build(
+			examples.NewExample(
+				`Upgrade a SKE cluster with name "my-cluster" to the latest supported Kubernetes version`,
+				"$ stackit ske cluster upgrade my-cluster"),
+			examples.NewExample(
+				`Upgrade a SKE cluster with name "my-cluster" to Kubernetes version 1.33.5`,
+				"$ stackit ske cluster upgrade my-cluster --to-version 1.33.5"),
+			examples.NewExample(
+				`Show the upgrade plan of a SKE cluster with name "my-cluster" without applying it`,
+				"$ stackit ske cluster upgrade my-cluster --dry-run"),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			// Configure API client
+			apiClient, err := client.ConfigureClient(params.Printer, params.CliVersion)
+			if err != nil {
+				return err
+			}
+
+			cluster, err := apiClient.DefaultAPI.GetCluster(ctx, model.ProjectId, model.Region, model.ClusterName).Execute()
+			if err != nil {
+				return fmt.Errorf("get SKE cluster: %w", err)
+			}
+			options, err := apiClient.DefaultAPI.ListProviderOptions(ctx, model.Region).Execute()
+			if err != nil {
+				return fmt.Errorf("get SKE provider options: %w", err)
+			}
+			plan, err := skeUtils.PlanUpgrade(cluster, options, model.ToVersion, time.Now())
+			if err != nil {
+				return fmt.Errorf("plan SKE cluster upgrade: %w", err)
+			}
+
+			if model.DryRun || len(plan.Steps) == 0 {
+				return outputResult(params.Printer, model.OutputFormat, model.DryRun, model.Async, plan)
+			}
+
+			// Show the plan on every run, also if the confirmation is skipped
+			params.Printer.Info("%s", renderPlan(plan))
+			prompt := fmt.Sprintf("Are you sure you want to upgrade cluster %q in %d step(s)? (This may cause downtime)\n%s", model.ClusterName, len(plan.Steps), formatSteps(plan.Steps))
+			err = params.Printer.PromptForConfirmation(prompt)
+			if err != nil {
+				return err
+			}
+
+			for i := range plan.Steps {
+				step := &plan.Steps[i]
+				stepLabel := fmt.Sprintf("step %d of %d", i+1, len(plan.Steps))
+
+				req := buildRequest(ctx, model, apiClient, cluster, step)
+				_, err = req.Execute()
+				if err != nil {
+					return fmt.Errorf("upgrade SKE cluster (%s, %s): %w", stepLabel, step, err)
+				}
+
+				// The next step can only be applied once the cluster is updated
+				isLastStep := i == len(plan.Steps)-1
+				if isLastStep && model.Async {
+					break
+				}
+				err = spinner.Run(params.Printer, fmt.Sprintf("Upgrading cluster (%s)", stepLabel), func() error {
+					cluster, err = wait.UpdateClusterWaitHandler(ctx, apiClient.DefaultAPI, model.ProjectId, model.Region, model.ClusterName).WaitWithContext(ctx)
+					return err
+				})
+				if err != nil {
+					return fmt.Errorf("wait for SKE cluster upgrade (%s, %s): %w", stepLabel, step, err)
+				}
+			}
+
+			return outputResult(params.Printer, model.OutputFormat, model.DryRun, model.Async, plan)
+		},
+	}
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().String(toVersionFlag, "", "Kubernetes version to upgrade to. Defaults to the latest supported version")
+	cmd.Flags().Bool(dryRunFlag, false, "If set, the upgrade plan is shown without applying it")
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inputModel, error) {
+	clusterName := inputArgs[0]
+
+	globalFlags := globalflags.Parse(p, cmd)
+	if globalFlags.ProjectId == "" {
+		return nil, &errors.ProjectIdError{}
+	}
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		ClusterName:     clusterName,
+		ToVersion:       flags.FlagToStringValue(p, cmd, toVersionFlag),
+		DryRun:          flags.FlagToBoolValue(p, cmd, dryRunFlag),
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+// buildRequest applies the step to the current configuration of the cluster
+func buildRequest(ctx context.Context, model *inputModel, apiClient *ske.APIClient, cluster *ske.Cluster, step *skeUtils.UpgradeStep) ske.ApiCreateOrUpdateClusterRequest {
+	req := apiClient.DefaultAPI.CreateOrUpdateCluster(ctx, model.ProjectId, model.Region, model.ClusterName)
+
+	payload := skeUtils.PayloadFromCluster(cluster)
+	skeUtils.ApplyUpgradeStep(payload, step)
+	req = req.CreateOrUpdateClusterPayload(*payload)
+	return req
+}
+
+func formatSteps(steps []skeUtils.UpgradeStep) string {
+	lines := []string{}
+	for i := range steps {
+		lines = append(lines, fmt.Sprintf("  %d. %s", i+1, &steps[i]))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func valueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
+func formatExpirationDate(status *skeUtils.VersionStatus) string {
+	if status.ExpirationDate == nil {
+		return "-"
+	}
+	return status.ExpirationDate.Format(time.RFC3339)
+}
+
+func buildKubernetesTable(plan *skeUtils.UpgradePlan) tables.Table {
+	table := tables.NewTable()
+	table.SetTitle("Kubernetes")
+	table.AddRow("VERSION", plan.Kubernetes.Version)
+	table.AddSeparator()
+	table.AddRow("STATE", valueOrDash(plan.Kubernetes.State))
+	table.AddSeparator()
+	table.AddRow("EXPIRATION DATE", formatExpirationDate(&plan.Kubernetes))
+	table.AddSeparator()
+	table.AddRow("TARGET VERSION", plan.TargetKubernetesVersion())
+	return table
+}
+
+func buildNodepoolsTable(plan *skeUtils.UpgradePlan) tables.Table {
+	table := tables.NewTable()
+	table.SetTitle("Nodepools")
+	table.SetHeader("NAME", "IMAGE", "VERSION", "STATE", "EXPIRATION DATE", "TARGET VERSION")
+	for i := range plan.Nodepools {
+		nodepool := &plan.Nodepools[i]
+		targetVersion := nodepool.TargetVersion
+		if targetVersion == "" {
+			targetVersion = nodepool.Current.Version
+		}
+		table.AddRow(nodepool.Name, nodepool.Image, nodepool.Current.Version, valueOrDash(nodepool.Current.State), formatExpirationDate(&nodepool.Current), targetVersion)
+	}
+	return table
+}
+
+func buildStepsTable(plan *skeUtils.UpgradePlan) tables.Table {
+	table := tables.NewTable()
+	table.SetTitle("Steps")
+	table.SetHeader("STEP", "KUBERNETES VERSION", "MACHINE IMAGES")
+	for i := range plan.Steps {
+		step := &plan.Steps[i]
+		nodepools := []string{}
+		for name, version := range step.MachineImageVersions {
+			nodepools = append(nodepools, fmt.Sprintf("%s: %s", name, version))
+		}
+		slices.Sort(nodepools)
+		table.AddRow(i+1, valueOrDash(step.KubernetesVersion), valueOrDash(strings.Join(nodepools, "\n")))
+		table.AddSeparator()
+	}
+	return table
+}
+
+// renderPlan renders the current and target versions of Kubernetes and of the machine images of the nodepools
+func renderPlan(plan *skeUtils.UpgradePlan) string {
+	kubernetesTable := buildKubernetesTable(plan)
+	nodepoolsTable := buildNodepoolsTable(plan)
+	return kubernetesTable.Render() + nodepoolsTable.Render()
+}
+
+func outputResult(p *print.Printer, outputFormat string, dryRun, async bool, plan *skeUtils.UpgradePlan) error {
+	if plan == nil {
+		return fmt.Errorf("upgrade plan is nil")
+	}
+
+	return p.OutputResult(outputFormat, plan, func() error {
+		if len(plan.Steps) == 0 {
+			p.Outputf("Cluster %q is up to date\n", plan.ClusterName)
+			return nil
+		}
+
+		if !dryRun {
+			operationState := "Upgraded"
+			if async {
+				operationState = "Triggered upgrade of"
+			}
+			p.Outputf("%s cluster %q to Kubernetes version %s\n", operationState, plan.ClusterName, plan.TargetKubernetesVersion())
+			return nil
+		}
+
+		err := tables.DisplayTables(p, []tables.Table{buildKubernetesTable(plan), buildNodepoolsTable(plan), buildStepsTable(plan)})
+		if err != nil {
+			return fmt.Errorf("render tables: %w", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,309 @@
+package upgrade
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	skeUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/utils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testparams"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testutils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	ske "github.com/stackitcloud/stackit-sdk-go/services/ske/v2api"
+)
+
+var projectIdFlag = globalflags.ProjectIdFlag
+
+type testCtxKey struct{}
+
+var testCtx = context.WithValue(context.Background(), testCtxKey{}, "foo")
+var testClient = &ske.APIClient{DefaultAPI: &ske.DefaultAPIService{}}
+var testProjectId = uuid.NewString()
+var testClusterName = "cluster"
+
+const testRegion = "eu01"
+
+var testCluster = &ske.Cluster{
+	Name: utils.Ptr(testClusterName),
+	Kubernetes: ske.Kubernetes{
+		Version: "1.32.8",
+	},
+	Nodepools: []ske.Nodepool{
+		{
+			Name: "np-name",
+			Machine: ske.Machine{
+				Image: ske.Image{
+					Name:    "flatcar",
+					Version: "4152.2.3",
+				},
+				Type: "b1.2",
+			},
+			Minimum: int32(1),
+			Maximum: int32(2),
+		},
+	},
+}
+
+func fixtureArgValues(mods ...func(argValues []string)) []string {
+	argValues := []string{
+		testClusterName,
+	}
+	for _, mod := range mods {
+		mod(argValues)
+	}
+	return argValues
+}
+
+func fixtureFlagValues(mods ...func(flagValues map[string]string)) map[string]string {
+	flagValues := map[string]string{
+		globalflags.ProjectIdFlag: testProjectId,
+		globalflags.RegionFlag:    testRegion,
+	}
+	for _, mod := range mods {
+		mod(flagValues)
+	}
+	return flagValues
+}
+
+func fixtureInputModel(mods ...func(model *inputModel)) *inputModel {
+	model := &inputModel{
+		GlobalFlagModel: &globalflags.GlobalFlagModel{
+			ProjectId: testProjectId,
+			Region:    testRegion,
+			Verbosity: globalflags.VerbosityDefault,
+		},
+		ClusterName: testClusterName,
+	}
+	for _, mod := range mods {
+		mod(model)
+	}
+	return model
+}
+
+func fixturePlan() *skeUtils.UpgradePlan {
+	return &skeUtils.UpgradePlan{
+		ClusterName: testClusterName,
+		Kubernetes: skeUtils.VersionStatus{
+			Version:        "1.32.8",
+			State:          "deprecated",
+			ExpirationDate: utils.Ptr(time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		Nodepools: []skeUtils.NodepoolUpgrade{
+			{
+				Name:          "np-name",
+				Image:         "flatcar",
+				Current:       skeUtils.VersionStatus{Version: "4152.2.3", State: "supported"},
+				TargetVersion: "4230.2.3",
+			},
+		},
+		Steps: []skeUtils.UpgradeStep{
+			{KubernetesVersion: "1.33.5"},
+			{MachineImageVersions: map[string]string{"np-name": "4230.2.3"}},
+		},
+	}
+}
+
+func TestParseInput(t *testing.T) {
+	tests := []struct {
+		description   string
+		argValues     []string
+		flagValues    map[string]string
+		isValid       bool
+		expectedModel *inputModel
+	}{
+		{
+			description:   "base",
+			argValues:     fixtureArgValues(),
+			flagValues:    fixtureFlagValues(),
+			isValid:       true,
+			expectedModel: fixtureInputModel(),
+		},
+		{
+			description: "target version and dry run",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[toVersionFlag] = "1.33.5"
+				flagValues[dryRunFlag] = "true"
+			}),
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.ToVersion = "1.33.5"
+				model.DryRun = true
+			}),
+		},
+		{
+			description: "no values",
+			argValues:   []string{},
+			flagValues:  map[string]string{},
+			isValid:     false,
+		},
+		{
+			description: "no arg values",
+			argValues:   []string{},
+			flagValues:  fixtureFlagValues(),
+			isValid:     false,
+		},
+		{
+			description: "project id missing",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, projectIdFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "project id invalid",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[projectIdFlag] = "invalid-uuid"
+			}),
+			isValid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			testutils.TestParseInput(t, NewCmd, parseInput, tt.expectedModel, tt.argValues, tt.flagValues, tt.isValid)
+		})
+	}
+}
+
+func TestBuildRequest(t *testing.T) {
+	tests := []struct {
+		description     string
+		step            *skeUtils.UpgradeStep
+		expectedPayload ske.CreateOrUpdateClusterPayload
+	}{
+		{
+			description: "kubernetes version",
+			step:        &skeUtils.UpgradeStep{KubernetesVersion: "1.33.5"},
+			expectedPayload: ske.CreateOrUpdateClusterPayload{
+				Kubernetes: ske.Kubernetes{Version: "1.33.5"},
+				Nodepools:  testCluster.Nodepools,
+			},
+		},
+		{
+			description: "machine image versions",
+			step:        &skeUtils.UpgradeStep{MachineImageVersions: map[string]string{"np-name": "4230.2.3"}},
+			expectedPayload: ske.CreateOrUpdateClusterPayload{
+				Kubernetes: testCluster.Kubernetes,
+				Nodepools: []ske.Nodepool{
+					{
+						Name: "np-name",
+						Machine: ske.Machine{
+							Image: ske.Image{
+								Name:    "flatcar",
+								Version: "4230.2.3",
+							},
+							Type: "b1.2",
+						},
+						Minimum: int32(1),
+						Maximum: int32(2),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			request := buildRequest(testCtx, fixtureInputModel(), testClient, testCluster, tt.step)
+
+			expectedRequest := testClient.DefaultAPI.CreateOrUpdateCluster(testCtx, testProjectId, testRegion, testClusterName).
+				CreateOrUpdateClusterPayload(tt.expectedPayload)
+			diff := cmp.Diff(request, expectedRequest,
+				cmp.AllowUnexported(expectedRequest),
+				cmpopts.EquateComparable(testCtx),
+				cmpopts.EquateComparable(testClient.DefaultAPI),
+			)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFormatSteps(t *testing.T) {
+	expected := "  1. Kubernetes version 1.33.5\n  2. machine images of 1 nodepool(s)"
+
+	got := formatSteps(fixturePlan().Steps)
+	if got != expected {
+		t.Fatalf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestOutputResult(t *testing.T) {
+	type args struct {
+		outputFormat string
+		dryRun       bool
+		async        bool
+		plan         *skeUtils.UpgradePlan
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name:    "empty",
+			args:    args{},
+			wantErr: true,
+		},
+		{
+			name: "empty plan",
+			args: args{
+				plan: &skeUtils.UpgradePlan{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "dry run",
+			args: args{
+				dryRun: true,
+				plan:   fixturePlan(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "async",
+			args: args{
+				async: true,
+				plan:  fixturePlan(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "json output",
+			args: args{
+				outputFormat: print.JSONOutputFormat,
+				plan:         fixturePlan(),
+			},
+			wantErr: false,
+		},
+	}
+	params := testparams.NewTestParams()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := outputResult(params.Printer, tt.args.outputFormat, tt.args.dryRun, tt.args.async, tt.args.plan); (err != nil) != tt.wantErr {
+				t.Errorf("outputResult() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRenderPlan(t *testing.T) {
+	got := renderPlan(fixturePlan())
+	for _, expected := range []string{"1.32.8", "1.33.5", "np-name", "4152.2.3", "4230.2.3"} {
+		if !strings.Contains(got, expected) {
+			t.Fatalf("expected the plan to contain %q, got %q", expected, got)
+		}
+	}
+}
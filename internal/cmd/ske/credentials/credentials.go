@@ -2,6 +2,7 @@ package credentials
 
 import (
 	completerotation "github.com/stackitcloud/stackit-cli/internal/cmd/ske/credentials/complete-rotation"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/ske/credentials/rotate"
 	startrotation "github.com/stackitcloud/stackit-cli/internal/cmd/ske/credentials/start-rotation"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
@@ -25,4 +26,5 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 func addSubcommands(cmd *cobra.Command, params *types.CmdParams) {
 	cmd.AddCommand(startrotation.NewCmd(params))
 	cmd.AddCommand(completerotation.NewCmd(params))
+	cmd.AddCommand(rotate.NewCmd(params))
 }
@@ -0,0 +1,370 @@
+package rotate
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"time"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+
+	"github.com/spf13/cobra"
+	ske "github.com/stackitcloud/stackit-sdk-go/services/ske/v2api"
+	wait "github.com/stackitcloud/stackit-sdk-go/services/ske/v2api/wait"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/cache"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/client"
+	skeUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/utils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/spinner"
+)
+
+const (
+	clusterNameArg = "CLUSTER_NAME"
+
+	filepathFlag      = "filepath"
+	verifyCommandFlag = "verify-command"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+	ClusterName   string
+	Filepath      *string
+	VerifyCommand *string
+}
+
+// rotationResult is the outcome of the rotation, used for the JSON and YAML output
+type rotationResult struct {
+	ClusterName     string   `json:"clusterName"`
+	Kubeconfig      string   `json:"kubeconfig"`
+	UpdatedContexts []string `json:"updatedContexts"`
+	Phase           string   `json:"phase"`
+}
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("rotate %s", clusterNameArg),
+		Short: "Rotates the credentials associated to a SKE cluster",
+		Long: fmt.Sprintf("%s\n\n%s\n%s\n%s\n%s\n%s\n\n%s\n%s\n%s",
+			"Rotates the credentials associated to a STACKIT Kubernetes Engine (SKE) cluster in a single command.",
+			"This runs both steps of the credentials rotation and updates your kubeconfig in between:",
+			"  - The rotation is started and the command waits until the new credentials were added to the cluster.",
+			"  - The contexts of the cluster in the kubeconfig file are updated with the new credentials, keeping their names. Admin kubeconfigs keep their expiration.",
+			"  - If set, the command of the --verify-command flag is run with the KUBECONFIG env variable pointing to the updated kubeconfig file. The rotation is only completed if it succeeds.",
+			"  - The rotation is completed, which removes the old credentials from the cluster. The credentials of the cluster cached by \"stackit ske kubeconfig login\" are removed as well.",
+			"If the command is interrupted or the verification fails, run it again to resume the rotation. The current phase of the rotation is shown by:",
+			"  $ stackit ske cluster describe my-cluster",
+			"With the --async flag, the command doesn't wait for the completion of the rotation.",
+		),
+		Args: args.SingleArg(clusterNameArg, nil),
+		Example: examples.Build(
+			examples.NewExample(
+				`Rotate the credentials of the SKE cluster with name "my-cluster" and update the default kubeconfig file`,
+				"$ stackit ske credentials rotate my-cluster"),
+			examples.NewExample(
+				`Rotate the credentials of the SKE cluster with name "my-cluster" and only complete the rotation if the cluster is reachable with the new credentials`,
+				`$ stackit ske credentials rotate my-cluster --verify-command "kubectl get nodes"`),
+			examples.NewExample(
+				`Rotate the credentials of the SKE cluster with name "my-cluster" and update a custom kubeconfig file`,
+				"$ stackit ske credentials rotate my-cluster --filepath /path/to/config"),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			// Configure API client
+			apiClient, err := client.ConfigureClient(params.Printer, params.CliVersion)
+			if err != nil {
+				return err
+			}
+
+			kubeconfigPath, err := skeUtils.GetKubeconfigPath(model.Filepath)
+			if err != nil {
+				return err
+			}
+
+			cluster, err := apiClient.DefaultAPI.GetCluster(ctx, model.ProjectId, model.Region, model.ClusterName).Execute()
+			if err != nil {
+				return fmt.Errorf("get SKE cluster: %w", err)
+			}
+			plan, err := skeUtils.PlanCredentialsRotation(skeUtils.CredentialsRotationPhase(cluster))
+			if err != nil {
+				return err
+			}
+
+			prompt := fmt.Sprintf("Are you sure you want to rotate the credentials for SKE cluster %q? This will update the kubeconfig file %q.", model.ClusterName, kubeconfigPath)
+			if !plan.Start {
+				prompt = fmt.Sprintf("The credentials rotation of SKE cluster %q is in phase %s. Are you sure you want to resume it? This will update the kubeconfig file %q.", model.ClusterName, plan.Phase, kubeconfigPath)
+			}
+			err = params.Printer.PromptForConfirmation(prompt)
+			if err != nil {
+				return err
+			}
+
+			// Call API
+			if plan.Start {
+				_, err = apiClient.DefaultAPI.StartCredentialsRotation(ctx, model.ProjectId, model.Region, model.ClusterName).Execute()
+				if err != nil {
+					return fmt.Errorf("start rotation of SKE credentials: %w", err)
+				}
+			}
+
+			// The kubeconfig can only be updated once the new credentials were added, so this is also awaited in async mode
+			if plan.WaitForPrepared {
+				err := spinner.Run(params.Printer, "Starting credentials rotation", func() error {
+					_, err = wait.StartCredentialsRotationWaitHandler(ctx, apiClient.DefaultAPI, model.ProjectId, model.Region, model.ClusterName).WaitWithContext(ctx)
+					return err
+				})
+				if err != nil {
+					return fmt.Errorf("wait for start SKE credentials rotation: %w", err)
+				}
+			}
+
+			updatedContexts := []string{}
+			if plan.UpdateKubeconfigs {
+				updatedContexts, err = updateKubeconfigs(ctx, params.Printer, model, apiClient, kubeconfigPath)
+				if err != nil {
+					params.Printer.Warn("The rotation wasn't completed, resume it by running:\n  $ stackit ske credentials rotate %s\n", model.ClusterName)
+					return err
+				}
+
+				if model.VerifyCommand != nil {
+					params.Printer.Info("Verifying the new credentials\n")
+					err = runVerifyCommand(params.Printer, *model.VerifyCommand, kubeconfigPath)
+					if err != nil {
+						params.Printer.Warn("The rotation wasn't completed, the old credentials are still valid. Fix the problem and resume the rotation by running:\n  $ stackit ske credentials rotate %s\n", model.ClusterName)
+						return fmt.Errorf("verify new SKE credentials: %w", err)
+					}
+				}
+			}
+
+			if plan.Complete {
+				_, err = apiClient.DefaultAPI.CompleteCredentialsRotation(ctx, model.ProjectId, model.Region, model.ClusterName).Execute()
+				if err != nil {
+					return fmt.Errorf("complete rotation of SKE credentials: %w", err)
+				}
+			}
+
+			// The credentials cached by "stackit ske kubeconfig login" are revoked by the rotation
+			clearLoginCache(params.Printer, model)
+
+			// Wait for async operation, if async mode not enabled
+			phase := skeUtils.CredentialsRotationPhaseCompleting
+			if !model.Async {
+				err := spinner.Run(params.Printer, "Completing credentials rotation", func() error {
+					_, err = wait.CompleteCredentialsRotationWaitHandler(ctx, apiClient.DefaultAPI, model.ProjectId, model.Region, model.ClusterName).WaitWithContext(ctx)
+					return err
+				})
+				if err != nil {
+					return fmt.Errorf("wait for completing SKE credentials rotation: %w", err)
+				}
+				phase = skeUtils.CredentialsRotationPhaseCompleted
+			}
+
+			return outputResult(params.Printer, model.OutputFormat, model.Async, &rotationResult{
+				ClusterName:     model.ClusterName,
+				Kubeconfig:      kubeconfigPath,
+				UpdatedContexts: updatedContexts,
+				Phase:           phase,
+			})
+		},
+	}
+	configureFlags(cmd)
+	return cmd
+}
+
+func clearLoginCache(p *print.Printer, model *inputModel) {
+	err := cache.Init()
+	if err == nil {
+		var deleted []string
+		deleted, err = cache.Prune(skeUtils.LoginCachePrefix, func(_ string, data []byte) bool {
+			return skeUtils.LoginCacheObjectOfCluster(data, model.ProjectId, model.Region, model.ClusterName)
+		})
+		p.Debug(print.DebugLevel, "removed %d cached login credentials of the cluster", len(deleted))
+	}
+	if err != nil {
+		p.Warn("Couldn't remove the cached login credentials of the cluster, remove them by running:\n  $ stackit ske kubeconfig cache clear\n")
+		p.Debug(print.ErrorLevel, "clear login cache: %v", err)
+	}
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().String(filepathFlag, "", "Path of the kubeconfig file to update. Will fall back to KUBECONFIG env variable if not set. In case both aren't set, the file named 'config' in the .kube folder in the user's home directory is used.")
+	cmd.Flags().String(verifyCommandFlag, "", "Shell command to verify the new credentials before the rotation is completed, e.g. \"kubectl get nodes\". It is run with the KUBECONFIG env variable set to the updated kubeconfig file.")
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inputModel, error) {
+	clusterName := inputArgs[0]
+
+	globalFlags := globalflags.Parse(p, cmd)
+	if globalFlags.ProjectId == "" {
+		return nil, &errors.ProjectIdError{}
+	}
+
+	verifyCommand := flags.FlagToStringPointer(p, cmd, verifyCommandFlag)
+	if verifyCommand != nil && *verifyCommand == "" {
+		return nil, &errors.FlagValidationError{
+			Flag:    verifyCommandFlag,
+			Details: "can't be empty",
+		}
+	}
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		ClusterName:     clusterName,
+		Filepath:        flags.FlagToStringPointer(p, cmd, filepathFlag),
+		VerifyCommand:   verifyCommand,
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+// updateKubeconfigs replaces the credentials of the contexts of the cluster in the kubeconfig file with new ones and returns the updated contexts
+func updateKubeconfigs(ctx context.Context, p *print.Printer, model *inputModel, apiClient *ske.APIClient, kubeconfigPath string) ([]string, error) {
+	_, err := os.Stat(kubeconfigPath)
+	if os.IsNotExist(err) {
+		p.Info("Kubeconfig %q doesn't exist, no contexts to update\n", kubeconfigPath)
+		return []string{}, nil
+	}
+	config, err := clientcmd.LoadFromFile(kubeconfigPath)
+	if err != nil {
+		return nil, fmt.Errorf("load kubeconfig %q: %w", kubeconfigPath, err)
+	}
+
+	// The login kubeconfig is used to identify admin kubeconfigs of the cluster by their API server
+	loginKubeconfig, err := getKubeconfig(ctx, model, apiClient, skeUtils.KubeconfigTypeLogin, nil)
+	if err != nil {
+		return nil, err
+	}
+	server, err := skeUtils.KubeconfigServer(loginKubeconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := skeUtils.FilterKubeconfigEntriesByCluster(config, skeUtils.ListKubeconfigEntries(config), model.ProjectId, model.ClusterName, server)
+	if len(entries) == 0 {
+		p.Info("No contexts of cluster %q found in kubeconfig %q, create a new kubeconfig with:\n  $ stackit ske kubeconfig create %s\n", model.ClusterName, kubeconfigPath, model.ClusterName)
+		return []string{}, nil
+	}
+
+	// Login and IDP kubeconfigs are the same for all contexts, admin kubeconfigs differ by their expiration
+	kubeconfigs := map[string]string{skeUtils.KubeconfigTypeLogin: loginKubeconfig}
+	updatedContexts := []string{}
+	for i := range entries {
+		entry := &entries[i]
+		content, ok := kubeconfigs[entry.Type]
+		if !ok {
+			content, err = getKubeconfig(ctx, model, apiClient, entry.Type, expirationSeconds(entry, time.Now()))
+			if err != nil {
+				return nil, err
+			}
+			if entry.Type != skeUtils.KubeconfigTypeAdmin {
+				kubeconfigs[entry.Type] = content
+			}
+		}
+		err = skeUtils.ReplaceKubeconfigCredentials(config, entry.Context, content)
+		if err != nil {
+			return nil, fmt.Errorf("update context %q: %w", entry.Context, err)
+		}
+		updatedContexts = append(updatedContexts, entry.Context)
+	}
+
+	err = clientcmd.WriteToFile(*config, kubeconfigPath)
+	if err != nil {
+		return nil, fmt.Errorf("write kubeconfig %q: %w", kubeconfigPath, err)
+	}
+	return updatedContexts, nil
+}
+
+// expirationSeconds returns the remaining lifetime of the client certificate of an admin kubeconfig,
+// so the new kubeconfig expires at the same time. It is nil if unknown, which uses the default expiration
+func expirationSeconds(entry *skeUtils.KubeconfigEntry, now time.Time) *string {
+	if entry.ExpiresAt == nil || !entry.ExpiresAt.After(now) {
+		return nil
+	}
+	seconds := strconv.FormatInt(int64(entry.ExpiresAt.Sub(now).Seconds()), 10)
+	return &seconds
+}
+
+// getKubeconfig fetches a new kubeconfig of the given type for the cluster
+func getKubeconfig(ctx context.Context, model *inputModel, apiClient *ske.APIClient, kubeconfigType string, expiration *string) (string, error) {
+	var kubeconfig *string
+	switch kubeconfigType {
+	case skeUtils.KubeconfigTypeLogin:
+		resp, err := apiClient.DefaultAPI.GetLoginKubeconfig(ctx, model.ProjectId, model.Region, model.ClusterName).Execute()
+		if err != nil {
+			return "", fmt.Errorf("create login kubeconfig for SKE cluster: %w", err)
+		}
+		kubeconfig = resp.Kubeconfig
+	case skeUtils.KubeconfigTypeIDP:
+		resp, err := apiClient.DefaultAPI.GetIDPKubeconfig(ctx, model.ProjectId, model.Region, model.ClusterName).Execute()
+		if err != nil {
+			return "", fmt.Errorf("create idp kubeconfig for SKE cluster: %w", err)
+		}
+		kubeconfig = resp.Kubeconfig
+	default:
+		payload := ske.CreateKubeconfigPayload{ExpirationSeconds: expiration}
+		resp, err := apiClient.DefaultAPI.CreateKubeconfig(ctx, model.ProjectId, model.Region, model.ClusterName).CreateKubeconfigPayload(payload).Execute()
+		if err != nil {
+			return "", fmt.Errorf("create kubeconfig for SKE cluster: %w", err)
+		}
+		kubeconfig = resp.Kubeconfig
+	}
+	if kubeconfig == nil {
+		return "", fmt.Errorf("no %s kubeconfig returned from the API", kubeconfigType)
+	}
+	return *kubeconfig, nil
+}
+
+// runVerifyCommand runs the verification command in a shell, with the KUBECONFIG env variable set to the kubeconfig file
+func runVerifyCommand(p *print.Printer, command, kubeconfigPath string) error {
+	shell, shellArgs := "sh", []string{"-c", command}
+	if runtime.GOOS == "windows" {
+		shell, shellArgs = "cmd", []string{"/C", command}
+	}
+
+	cmd := exec.Command(shell, shellArgs...) //nolint:gosec // the command is chosen by the user
+	cmd.Env = append(os.Environ(), "KUBECONFIG="+kubeconfigPath)
+	cmd.Stdin = p.StdIn
+	cmd.Stdout = p.StdOut
+	cmd.Stderr = p.StdErr
+
+	p.Debug(print.DebugLevel, "running verification command: %s", command)
+	err := cmd.Run()
+	if err != nil {
+		return fmt.Errorf("run %q: %w", command, err)
+	}
+	return nil
+}
+
+func outputResult(p *print.Printer, outputFormat string, async bool, result *rotationResult) error {
+	if result == nil {
+		return fmt.Errorf("result is nil")
+	}
+
+	return p.OutputResult(outputFormat, result, func() error {
+		if len(result.UpdatedContexts) > 0 {
+			p.Outputf("Updated %d context(s) of cluster %q in kubeconfig %q\n", len(result.UpdatedContexts), result.ClusterName, result.Kubeconfig)
+		}
+
+		operationState := "Rotation of credentials is completed"
+		if async {
+			operationState = "Triggered completion of credentials rotation"
+		}
+		p.Info("%s for cluster %q\n", operationState, result.ClusterName)
+		return nil
+	})
+}
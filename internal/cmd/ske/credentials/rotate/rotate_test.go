@@ -0,0 +1,275 @@
+package rotate
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	skeUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/utils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testparams"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testutils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+)
+
+var projectIdFlag = globalflags.ProjectIdFlag
+
+var testProjectId = uuid.NewString()
+var testClusterName = "cluster"
+
+const (
+	testRegion = "eu01"
+)
+
+func fixtureArgValues(mods ...func(argValues []string)) []string {
+	argValues := []string{
+		testClusterName,
+	}
+	for _, mod := range mods {
+		mod(argValues)
+	}
+	return argValues
+}
+
+func fixtureFlagValues(mods ...func(flagValues map[string]string)) map[string]string {
+	flagValues := map[string]string{
+		globalflags.ProjectIdFlag: testProjectId,
+		globalflags.RegionFlag:    testRegion,
+	}
+	for _, mod := range mods {
+		mod(flagValues)
+	}
+	return flagValues
+}
+
+func fixtureInputModel(mods ...func(model *inputModel)) *inputModel {
+	model := &inputModel{
+		GlobalFlagModel: &globalflags.GlobalFlagModel{
+			ProjectId: testProjectId,
+			Region:    testRegion,
+			Verbosity: globalflags.VerbosityDefault,
+		},
+		ClusterName: testClusterName,
+	}
+	for _, mod := range mods {
+		mod(model)
+	}
+	return model
+}
+
+func TestParseInput(t *testing.T) {
+	tests := []struct {
+		description   string
+		argValues     []string
+		flagValues    map[string]string
+		isValid       bool
+		expectedModel *inputModel
+	}{
+		{
+			description:   "base",
+			argValues:     fixtureArgValues(),
+			flagValues:    fixtureFlagValues(),
+			isValid:       true,
+			expectedModel: fixtureInputModel(),
+		},
+		{
+			description: "all values",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[filepathFlag] = "/path/to/config"
+				flagValues[verifyCommandFlag] = "kubectl get nodes"
+			}),
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.Filepath = utils.Ptr("/path/to/config")
+				model.VerifyCommand = utils.Ptr("kubectl get nodes")
+			}),
+		},
+		{
+			description: "no values",
+			argValues:   []string{},
+			flagValues:  map[string]string{},
+			isValid:     false,
+		},
+		{
+			description: "no arg values",
+			argValues:   []string{},
+			flagValues:  fixtureFlagValues(),
+			isValid:     false,
+		},
+		{
+			description: "project id missing",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, projectIdFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "project id invalid",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[projectIdFlag] = "invalid-uuid"
+			}),
+			isValid: false,
+		},
+		{
+			description: "verify command empty",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[verifyCommandFlag] = ""
+			}),
+			isValid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			testutils.TestParseInput(t, NewCmd, parseInput, tt.expectedModel, tt.argValues, tt.flagValues, tt.isValid)
+		})
+	}
+}
+
+func TestExpirationSeconds(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		description string
+		entry       *skeUtils.KubeconfigEntry
+		expected    *string
+	}{
+		{
+			description: "valid certificate",
+			entry:       &skeUtils.KubeconfigEntry{ExpiresAt: utils.Ptr(now.Add(30 * 24 * time.Hour))},
+			expected:    utils.Ptr("2592000"),
+		},
+		{
+			description: "expired certificate",
+			entry:       &skeUtils.KubeconfigEntry{ExpiresAt: utils.Ptr(now.Add(-time.Hour))},
+		},
+		{
+			description: "unknown expiration",
+			entry:       &skeUtils.KubeconfigEntry{Expired: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			seconds := expirationSeconds(tt.entry, now)
+			diff := cmp.Diff(seconds, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRunVerifyCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the tests use a POSIX shell")
+	}
+	kubeconfigPath := filepath.Join(t.TempDir(), "config")
+	output := filepath.Join(t.TempDir(), "output")
+
+	tests := []struct {
+		description string
+		command     string
+		isValid     bool
+	}{
+		{
+			description: "success",
+			command:     `printf "%s" "$KUBECONFIG" > ` + output,
+			isValid:     true,
+		},
+		{
+			description: "failure",
+			command:     "exit 1",
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			params := testparams.NewTestParams()
+			err := runVerifyCommand(params.Printer, tt.command, kubeconfigPath)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("did not fail on invalid input")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			content, err := os.ReadFile(output)
+			if err != nil {
+				t.Fatalf("read output: %v", err)
+			}
+			if string(content) != kubeconfigPath {
+				t.Fatalf("expected KUBECONFIG %q, got %q", kubeconfigPath, string(content))
+			}
+		})
+	}
+}
+
+func TestOutputResult(t *testing.T) {
+	type args struct {
+		outputFormat string
+		async        bool
+		result       *rotationResult
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name:    "empty",
+			args:    args{},
+			wantErr: true,
+		},
+		{
+			name: "empty result",
+			args: args{
+				result: &rotationResult{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "updated contexts",
+			args: args{
+				result: &rotationResult{
+					ClusterName:     testClusterName,
+					Kubeconfig:      "/path/to/config",
+					UpdatedContexts: []string{"admin", "login"},
+					Phase:           skeUtils.CredentialsRotationPhaseCompleted,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "async",
+			args: args{
+				async: true,
+				result: &rotationResult{
+					ClusterName: testClusterName,
+					Phase:       skeUtils.CredentialsRotationPhaseCompleting,
+				},
+			},
+			wantErr: false,
+		},
+	}
+	params := testparams.NewTestParams()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := outputResult(params.Printer, tt.args.outputFormat, tt.args.async, tt.args.result); (err != nil) != tt.wantErr {
+				t.Errorf("outputResult() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
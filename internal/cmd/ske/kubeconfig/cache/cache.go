@@ -0,0 +1,28 @@
+package cache
+
+import (
+	"github.com/stackitcloud/stackit-cli/internal/cmd/ske/kubeconfig/cache/clear"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/ske/kubeconfig/cache/list"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cache",
+		Short: "Provides functionality for the credentials cached by the SKE kubeconfig login",
+		Long:  `Provides functionality for the credentials of STACKIT Kubernetes Engine (SKE) clusters which are cached by "stackit ske kubeconfig login".`,
+		Args:  args.NoArgs,
+		Run:   utils.CmdHelp,
+	}
+	addSubcommands(cmd, params)
+	return cmd
+}
+
+func addSubcommands(cmd *cobra.Command, params *types.CmdParams) {
+	cmd.AddCommand(list.NewCmd(params))
+	cmd.AddCommand(clear.NewCmd(params))
+}
@@ -0,0 +1,120 @@
+package clear
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+
+	"github.com/spf13/cobra"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/cache"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	skeUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/utils"
+)
+
+const (
+	expiredFlag = "expired"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+	Expired bool
+}
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clear",
+		Short: "Removes the credentials cached by the SKE kubeconfig login",
+		Long: fmt.Sprintf("%s\n%s",
+			`Removes the credentials of STACKIT Kubernetes Engine (SKE) clusters which are cached by "stackit ske kubeconfig login".`,
+			"New credentials are requested the next time a cluster is accessed, so this requires to be online.",
+		),
+		Args: args.NoArgs,
+		Example: examples.Build(
+			examples.NewExample(
+				`Remove all cached credentials`,
+				"$ stackit ske kubeconfig cache clear"),
+			examples.NewExample(
+				`Remove only the cached credentials which are expired or can't be read anymore`,
+				"$ stackit ske kubeconfig cache clear --expired"),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			if err := cache.Init(); err != nil {
+				return fmt.Errorf("cache init failed: %w", err)
+			}
+
+			if model.Expired {
+				removed, err := cache.Prune(skeUtils.LoginCachePrefix, func(_ string, data []byte) bool {
+					return skeUtils.LoginCacheObjectExpired(data, time.Now())
+				})
+				if err != nil {
+					return fmt.Errorf("prune cache: %w", err)
+				}
+				return outputResult(params.Printer, model.OutputFormat, removed)
+			}
+
+			keys, err := cache.ListObjects(skeUtils.LoginCachePrefix)
+			if err != nil {
+				return fmt.Errorf("list cache: %w", err)
+			}
+			if len(keys) == 0 {
+				return outputResult(params.Printer, model.OutputFormat, keys)
+			}
+
+			prompt := fmt.Sprintf("Are you sure you want to remove %d cached SKE credential(s)?", len(keys))
+			err = params.Printer.PromptForConfirmation(prompt)
+			if err != nil {
+				return err
+			}
+
+			removed := []string{}
+			for _, key := range keys {
+				if err := cache.DeleteObject(key); err != nil {
+					return fmt.Errorf("delete cache entry %q: %w", key, err)
+				}
+				removed = append(removed, key)
+			}
+			return outputResult(params.Printer, model.OutputFormat, removed)
+		},
+	}
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(expiredFlag, false, "Only remove the cached credentials which are expired or can't be read anymore")
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
+	globalFlags := globalflags.Parse(p, cmd)
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		Expired:         flags.FlagToBoolValue(p, cmd, expiredFlag),
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+func outputResult(p *print.Printer, outputFormat string, removedKeys []string) error {
+	return p.OutputResult(outputFormat, removedKeys, func() error {
+		if len(removedKeys) == 0 {
+			p.Outputf("No cached SKE credentials to remove\n")
+			return nil
+		}
+
+		p.Outputf("Removed %d cached SKE credential(s)\n", len(removedKeys))
+		return nil
+	})
+}
@@ -0,0 +1,130 @@
+package list
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+
+	"github.com/spf13/cobra"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/cache"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	skeUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/utils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/tables"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+}
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Lists the credentials cached by the SKE kubeconfig login",
+		Long: fmt.Sprintf("%s\n%s",
+			`Lists the credentials of STACKIT Kubernetes Engine (SKE) clusters which are cached by "stackit ske kubeconfig login", together with their expiration.`,
+			"The cluster is unknown for credentials which were cached by older versions of the STACKIT CLI.",
+		),
+		Args: args.NoArgs,
+		Example: examples.Build(
+			examples.NewExample(
+				`List the cached credentials`,
+				"$ stackit ske kubeconfig cache list"),
+			examples.NewExample(
+				`List the cached credentials in JSON format`,
+				"$ stackit ske kubeconfig cache list --output-format json"),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			if err := cache.Init(); err != nil {
+				return fmt.Errorf("cache init failed: %w", err)
+			}
+			entries, err := getCacheEntries(params.Printer, time.Now())
+			if err != nil {
+				return err
+			}
+
+			return outputResult(params.Printer, model.OutputFormat, entries)
+		},
+	}
+	return cmd
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
+	globalFlags := globalflags.Parse(p, cmd)
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+// getCacheEntries returns the cached credentials, sorted by project and cluster.
+// Entries which can't be read, e.g. because the encryption key of the cache changed, are skipped
+func getCacheEntries(p *print.Printer, now time.Time) ([]skeUtils.LoginCacheEntry, error) {
+	keys, err := cache.ListObjects(skeUtils.LoginCachePrefix)
+	if err != nil {
+		return nil, fmt.Errorf("list cache: %w", err)
+	}
+
+	entries := []skeUtils.LoginCacheEntry{}
+	for _, key := range keys {
+		data, err := cache.GetObject(key)
+		if err != nil {
+			p.Debug(print.ErrorLevel, "read cache entry %q: %v", key, err)
+			continue
+		}
+		entries = append(entries, skeUtils.NewLoginCacheEntry(key, skeUtils.ParseLoginCacheObject(data), now))
+	}
+	slices.SortStableFunc(entries, func(a, b skeUtils.LoginCacheEntry) int {
+		return strings.Compare(a.ProjectId+"/"+a.ClusterName, b.ProjectId+"/"+b.ClusterName)
+	})
+	return entries, nil
+}
+
+func outputResult(p *print.Printer, outputFormat string, entries []skeUtils.LoginCacheEntry) error {
+	return p.OutputResult(outputFormat, entries, func() error {
+		if len(entries) == 0 {
+			p.Outputf("No cached SKE credentials found\n")
+			return nil
+		}
+
+		table := tables.NewTable()
+		table.SetHeader("CLUSTER", "PROJECT ID", "REGION", "TYPE", "EXPIRES AT")
+		for i := range entries {
+			entry := &entries[i]
+			expiresAt := "-"
+			if entry.Expired {
+				expiresAt = "expired"
+			} else if entry.ExpiresAt != nil {
+				expiresAt = entry.ExpiresAt.Format(time.DateTime)
+			}
+			table.AddRow(valueOrDash(entry.ClusterName), valueOrDash(entry.ProjectId), valueOrDash(entry.Region), entry.Type, expiresAt)
+		}
+		err := table.Display(p)
+		if err != nil {
+			return fmt.Errorf("render table: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func valueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
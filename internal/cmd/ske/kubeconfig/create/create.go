@@ -4,10 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 
 	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
 
 	"github.com/goccy/go-yaml"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
@@ -15,47 +18,59 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	rmClient "github.com/stackitcloud/stackit-cli/internal/pkg/services/resourcemanager/client"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/client"
 	skeUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/utils"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
 
 	"github.com/spf13/cobra"
+	resourcemanager "github.com/stackitcloud/stackit-sdk-go/services/resourcemanager/v0api"
 	ske "github.com/stackitcloud/stackit-sdk-go/services/ske/v2api"
 )
 
 const (
 	clusterNameArg = "CLUSTER_NAME"
 
+	allFlag            = "all"
 	disableWritingFlag = "disable-writing"
 	expirationFlag     = "expiration"
 	filepathFlag       = "filepath"
 	loginFlag          = "login"
 	idpFlag            = "idp"
+	organizationIdFlag = "organization-id"
 	overwriteFlag      = "overwrite"
+	switchContextFlag  = "switch-context"
+
+	projectsPageSize = 50
+	foldersPageSize  = 50
 )
 
 type inputModel struct {
 	*globalflags.GlobalFlagModel
 	ClusterName    string
+	All            bool
+	OrganizationId *string
 	DisableWriting bool
 	ExpirationTime *string
 	Filepath       *string
 	Login          bool
 	IDP            bool
 	Overwrite      bool
+	SwitchContext  bool
 }
 
 func NewCmd(params *types.CmdParams) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   fmt.Sprintf("create %s", clusterNameArg),
 		Short: "Creates or update a kubeconfig for a SKE cluster",
-		Long: fmt.Sprintf("%s\n\n%s\n%s\n%s\n%s",
+		Long: fmt.Sprintf("%s\n\n%s\n%s\n%s\n%s\n\n%s",
 			"Creates a kubeconfig for a STACKIT Kubernetes Engine (SKE) cluster. By default an admin kubeconfig is created. Use the `--idp` option to create an IDP kubeconfig that authenticates via the STACKIT IDP.",
 			"If the config exists in the kubeconfig file the information will be updated. By default, the kubeconfig information of the SKE cluster is merged into the default kubeconfig file of the current user. If the kubeconfig file doesn't exist, a new one will be created.",
 			"You can override this behavior by specifying a custom filepath using the --filepath flag or by setting the KUBECONFIG env variable (fallback).\n",
 			"An expiration time can be set for the kubeconfig. The expiration time is set in seconds(s), minutes(m), hours(h), days(d) or months(M). Default is 1h.\n",
-			"Note that the format is <value><unit>, e.g. 30d for 30 days and you can't combine units."),
-		Args: args.SingleArg(clusterNameArg, nil),
+			"Note that the format is <value><unit>, e.g. 30d for 30 days and you can't combine units.",
+			"Use the `--all` option together with `--login` or `--idp` to create kubeconfigs for all clusters of the project, or of all projects of an organization and its folders with `--organization-id`, instead of a single cluster. Their contexts are named <PROJECT_ID>/<CLUSTER_NAME>."),
+		Args: args.SingleOptionalArg(clusterNameArg, nil),
 		Example: examples.Build(
 			examples.NewExample(
 				`Get a short-lived admin kubeconfig for the SKE cluster with name "my-cluster". `+
@@ -80,9 +95,18 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 			examples.NewExample(
 				`Get an admin kubeconfig for the SKE cluster with name "my-cluster" without writing it to a file and format the output as json`,
 				"$ stackit ske kubeconfig create my-cluster --disable-writing --output-format json"),
+			examples.NewExample(
+				`Create or update a short-lived admin kubeconfig for the SKE cluster with name "my-cluster" and switch the current context to it`,
+				"$ stackit ske kubeconfig create my-cluster --login --switch-context"),
 			examples.NewExample(
 				`Create an admin kubeconfig for the SKE cluster with name "my-cluster". It will OVERWRITE your current kubeconfig file.`,
 				"$ stackit ske kubeconfig create my-cluster --overwrite true"),
+			examples.NewExample(
+				`Create or update short-lived admin kubeconfigs for all SKE clusters of the project`,
+				"$ stackit ske kubeconfig create --all --login"),
+			examples.NewExample(
+				`Create or update IDP kubeconfigs for all SKE clusters of all projects of the organization with ID "xxx"`,
+				"$ stackit ske kubeconfig create --all --idp --organization-id xxx"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.Background()
@@ -97,6 +121,10 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 				return err
 			}
 
+			if model.All {
+				return createAll(ctx, params, model, apiClient)
+			}
+
 			if !model.DisableWriting {
 				var prompt string
 				if model.Overwrite {
@@ -160,26 +188,25 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 			}
 
 			// Create the config file
-			var kubeconfigPath string
-			if model.Filepath == nil {
-				kubeconfigPath, err = skeUtils.GetDefaultKubeconfigPath()
-				if err != nil {
-					return fmt.Errorf("get default kubeconfig path: %w", err)
-				}
-			} else {
-				kubeconfigPath = *model.Filepath
+			kubeconfigPath, err := skeUtils.GetKubeconfigPath(model.Filepath)
+			if err != nil {
+				return err
 			}
 
 			if !model.DisableWriting {
 				if model.Overwrite {
 					err = skeUtils.WriteConfigFile(kubeconfigPath, kubeconfig)
 				} else {
-					err = skeUtils.MergeKubeConfig(kubeconfigPath, kubeconfig)
+					err = skeUtils.MergeKubeConfig(kubeconfigPath, kubeconfig, model.SwitchContext)
 				}
 				if err != nil {
 					return fmt.Errorf("write kubeconfig file: %w", err)
 				}
-				params.Printer.Outputf("\nSet kubectl context to %s with: kubectl config use-context %s\n", model.ClusterName, model.ClusterName)
+				if model.SwitchContext {
+					params.Printer.Outputf("\nSwitched kubectl context to %s\n", model.ClusterName)
+				} else {
+					params.Printer.Outputf("\nSet kubectl context to %s with: kubectl config use-context %s\n", model.ClusterName, model.ClusterName)
+				}
 			}
 
 			return outputResult(params.Printer, model.OutputFormat, model.ClusterName, kubeconfigPath, respKubeconfig, respLogin, respIDP)
@@ -190,20 +217,59 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 }
 
 func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(allFlag, false, "Create kubeconfigs for all clusters of the project, or of all projects of the organization if --organization-id is set. Requires --login or --idp.")
+	cmd.Flags().Var(flags.UUIDFlag(), organizationIdFlag, "Organization ID. Used together with --all to create kubeconfigs for the clusters of all its projects, including the projects in its folders.")
 	cmd.Flags().Bool(disableWritingFlag, false, fmt.Sprintf("Disable the writing of kubeconfig. Set the output format to json or yaml using the --%s flag to display the kubeconfig.", globalflags.OutputFormatFlag))
 	cmd.Flags().BoolP(loginFlag, "l", false, "Create a short-lived admin kubeconfig that obtains valid credentials via the STACKIT CLI. This flag is mutually exclusive with the expiration flag.")
 	cmd.Flags().Bool(idpFlag, false, "Create a non-admin kubeconfig that uses the STACKIT IDP to obtain credentials.")
 	cmd.Flags().String(filepathFlag, "", "Path to create the kubeconfig file. Will fall back to KUBECONFIG env variable if not set. In case both aren't set, the kubeconfig is created as file named 'config' in the .kube folder in the user's home directory.")
 	cmd.Flags().StringP(expirationFlag, "e", "", "Expiration time for the kubeconfig in seconds(s), minutes(m), hours(h), days(d) or months(M). Example: 30d. By default, expiration time is 1h")
 	cmd.Flags().Bool(overwriteFlag, false, "Overwrite the kubeconfig file.")
+	cmd.Flags().Bool(switchContextFlag, false, "Switch the current context of the kubeconfig file to the context of the cluster.")
 	cmd.MarkFlagsMutuallyExclusive(loginFlag, expirationFlag, idpFlag)
+	cmd.MarkFlagsMutuallyExclusive(disableWritingFlag, switchContextFlag)
+	cmd.MarkFlagsMutuallyExclusive(allFlag, disableWritingFlag)
+	cmd.MarkFlagsMutuallyExclusive(allFlag, switchContextFlag)
 }
 
 func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inputModel, error) {
-	clusterName := inputArgs[0]
+	var clusterName string
+	if len(inputArgs) > 0 {
+		clusterName = inputArgs[0]
+	}
+
+	all := flags.FlagToBoolValue(p, cmd, allFlag)
+	organizationId := flags.FlagToStringPointer(p, cmd, organizationIdFlag)
+	login := flags.FlagToBoolValue(p, cmd, loginFlag)
+	idp := flags.FlagToBoolValue(p, cmd, idpFlag)
+	if all {
+		if clusterName != "" {
+			return nil, fmt.Errorf("the argument %s can't be provided when setting the flag --%s", clusterNameArg, allFlag)
+		}
+		if !login && !idp {
+			return nil, &errors.FlagValidationError{
+				Flag:    allFlag,
+				Details: fmt.Sprintf("can only be used together with --%s or --%s", loginFlag, idpFlag),
+			}
+		}
+	} else {
+		if clusterName == "" {
+			return nil, &errors.SingleArgExpectedError{
+				Cmd:      cmd,
+				Expected: clusterNameArg,
+				Count:    len(inputArgs),
+			}
+		}
+		if organizationId != nil {
+			return nil, &errors.FlagValidationError{
+				Flag:    organizationIdFlag,
+				Details: fmt.Sprintf("can only be used together with --%s", allFlag),
+			}
+		}
+	}
 
 	globalFlags := globalflags.Parse(p, cmd)
-	if globalFlags.ProjectId == "" {
+	if globalFlags.ProjectId == "" && organizationId == nil {
 		return nil, &errors.ProjectIdError{}
 	}
 
@@ -230,13 +296,16 @@ func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inpu
 
 	model := inputModel{
 		ClusterName:     clusterName,
+		All:             all,
+		OrganizationId:  organizationId,
 		DisableWriting:  disableWriting,
 		ExpirationTime:  expTime,
 		Filepath:        flags.FlagToStringPointer(p, cmd, filepathFlag),
 		GlobalFlagModel: globalFlags,
-		Login:           flags.FlagToBoolValue(p, cmd, loginFlag),
-		IDP:             flags.FlagToBoolValue(p, cmd, idpFlag),
+		Login:           login,
+		IDP:             idp,
 		Overwrite:       flags.FlagToBoolValue(p, cmd, overwriteFlag),
+		SwitchContext:   flags.FlagToBoolValue(p, cmd, switchContextFlag),
 	}
 
 	p.DebugInputModel(model)
@@ -263,6 +332,244 @@ func buildRequestLogin(ctx context.Context, model *inputModel, apiClient *ske.AP
 	return apiClient.DefaultAPI.GetLoginKubeconfig(ctx, model.ProjectId, model.Region, model.ClusterName), nil
 }
 
+// clusterKubeconfig is a kubeconfig created by the --all option
+type clusterKubeconfig struct {
+	ProjectId   string `json:"projectId"`
+	ClusterName string `json:"clusterName"`
+	Context     string `json:"context"`
+}
+
+// createAll creates login or IDP kubeconfigs for all clusters of the project or organization and merges them into the kubeconfig file
+func createAll(ctx context.Context, params *types.CmdParams, model *inputModel, apiClient *ske.APIClient) error {
+	projectIds := []string{model.ProjectId}
+	if model.OrganizationId != nil {
+		rmApiClient, err := rmClient.ConfigureClient(params.Printer, params.CliVersion)
+		if err != nil {
+			return err
+		}
+		projectIds, err = fetchProjectIds(ctx, rmApiClient.DefaultAPI, *model.OrganizationId)
+		if err != nil {
+			return err
+		}
+	}
+
+	clusters, err := listClusters(ctx, params.Printer, model, apiClient, projectIds)
+	if err != nil {
+		return err
+	}
+	if len(clusters) == 0 {
+		params.Printer.Info("No SKE clusters found\n")
+		return nil
+	}
+
+	var prompt string
+	if model.Overwrite {
+		prompt = fmt.Sprintf("Are you sure you want to create kubeconfigs for %d SKE clusters? This will OVERWRITE your current kubeconfig file, if it exists.", len(clusters))
+	} else {
+		prompt = fmt.Sprintf("Are you sure you want to update your kubeconfig for %d SKE clusters? This will update your kubeconfig file. \nIf the kubeconfig file does not exist, it will create a new one.", len(clusters))
+	}
+	err = params.Printer.PromptForConfirmation(prompt)
+	if err != nil {
+		return err
+	}
+
+	// Call API
+	config := clientcmdapi.NewConfig()
+	created := []clusterKubeconfig{}
+	failed := 0
+	for i := range clusters {
+		cluster := &clusters[i]
+		kubeconfig, err := getClusterKubeconfig(ctx, model, apiClient, cluster.ProjectId, cluster.ClusterName)
+		if err == nil {
+			var clusterConfig *clientcmdapi.Config
+			clusterConfig, err = skeUtils.RenameKubeconfig(kubeconfig, cluster.Context)
+			if err == nil {
+				maps.Copy(config.Clusters, clusterConfig.Clusters)
+				maps.Copy(config.AuthInfos, clusterConfig.AuthInfos)
+				maps.Copy(config.Contexts, clusterConfig.Contexts)
+			}
+		}
+		if err != nil {
+			params.Printer.Warn("Failed to create kubeconfig for SKE cluster %q of project %q: %v\n", cluster.ClusterName, cluster.ProjectId, err)
+			failed++
+			continue
+		}
+		created = append(created, *cluster)
+	}
+
+	if len(created) > 0 {
+		kubeconfigPath, err := skeUtils.GetKubeconfigPath(model.Filepath)
+		if err != nil {
+			return err
+		}
+		content, err := clientcmd.Write(*config)
+		if err != nil {
+			return fmt.Errorf("marshal kubeconfig: %w", err)
+		}
+		if model.Overwrite {
+			err = skeUtils.WriteConfigFile(kubeconfigPath, string(content))
+		} else {
+			err = skeUtils.MergeKubeConfig(kubeconfigPath, string(content), false)
+		}
+		if err != nil {
+			return fmt.Errorf("write kubeconfig file: %w", err)
+		}
+
+		err = outputResultAll(params.Printer, model.OutputFormat, kubeconfigPath, created)
+		if err != nil {
+			return err
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to create kubeconfigs for %d of %d SKE clusters", failed, len(clusters))
+	}
+	return nil
+}
+
+type resourceManagerClient interface {
+	ListProjects(ctx context.Context) resourcemanager.ApiListProjectsRequest
+	ListFolders(ctx context.Context) resourcemanager.ApiListFoldersRequest
+}
+
+// fetchProjectIds returns the IDs of the active projects of the organization, including the projects in its folders.
+// Projects and folders are only listed for their direct parent, so the folders are walked recursively
+func fetchProjectIds(ctx context.Context, apiClient resourceManagerClient, organizationId string) ([]string, error) {
+	projectIds := []string{}
+	containerIds := []string{organizationId}
+	for len(containerIds) > 0 {
+		containerId := containerIds[0]
+		containerIds = containerIds[1:]
+
+		containerProjectIds, err := fetchContainerProjectIds(ctx, apiClient, containerId)
+		if err != nil {
+			return nil, err
+		}
+		projectIds = append(projectIds, containerProjectIds...)
+
+		folderIds, err := fetchContainerFolderIds(ctx, apiClient, containerId)
+		if err != nil {
+			return nil, err
+		}
+		containerIds = append(containerIds, folderIds...)
+	}
+	return projectIds, nil
+}
+
+// fetchContainerProjectIds returns the IDs of the active projects directly in the organization or folder
+func fetchContainerProjectIds(ctx context.Context, apiClient resourceManagerClient, containerId string) ([]string, error) {
+	projectIds := []string{}
+	offset := 0
+	for {
+		req := apiClient.ListProjects(ctx).
+			ContainerParentId(containerId).
+			Limit(float32(projectsPageSize)).
+			Offset(float32(offset))
+		resp, err := req.Execute()
+		if err != nil {
+			return nil, fmt.Errorf("get projects of container %q: %w", containerId, err)
+		}
+		respProjects := resp.GetItems()
+		for i := range respProjects {
+			if respProjects[i].LifecycleState == resourcemanager.LIFECYCLESTATE_ACTIVE {
+				projectIds = append(projectIds, respProjects[i].ProjectId)
+			}
+		}
+		// Stop if no more pages
+		if len(respProjects) < projectsPageSize {
+			break
+		}
+		offset += projectsPageSize
+	}
+	return projectIds, nil
+}
+
+// fetchContainerFolderIds returns the IDs of the folders directly in the organization or folder
+func fetchContainerFolderIds(ctx context.Context, apiClient resourceManagerClient, containerId string) ([]string, error) {
+	folderIds := []string{}
+	offset := 0
+	for {
+		req := apiClient.ListFolders(ctx).
+			ContainerParentId(containerId).
+			Limit(float32(foldersPageSize)).
+			Offset(float32(offset))
+		resp, err := req.Execute()
+		if err != nil {
+			return nil, fmt.Errorf("get folders of container %q: %w", containerId, err)
+		}
+		respFolders := resp.GetItems()
+		for i := range respFolders {
+			folderIds = append(folderIds, respFolders[i].FolderId)
+		}
+		// Stop if no more pages
+		if len(respFolders) < foldersPageSize {
+			break
+		}
+		offset += foldersPageSize
+	}
+	return folderIds, nil
+}
+
+// listClusters returns the clusters of the projects. If the clusters of the organization are listed,
+// projects whose clusters can't be listed, e.g. because SKE isn't enabled, are skipped
+func listClusters(ctx context.Context, p *print.Printer, model *inputModel, apiClient *ske.APIClient, projectIds []string) ([]clusterKubeconfig, error) {
+	clusters := []clusterKubeconfig{}
+	for _, projectId := range projectIds {
+		resp, err := apiClient.DefaultAPI.ListClusters(ctx, projectId, model.Region).Execute()
+		if err != nil {
+			if model.OrganizationId == nil {
+				return nil, fmt.Errorf("get SKE clusters: %w", err)
+			}
+			p.Debug(print.ErrorLevel, "get SKE clusters of project %q: %v", projectId, err)
+			continue
+		}
+		for i := range resp.Items {
+			if resp.Items[i].Name == nil {
+				continue
+			}
+			clusters = append(clusters, clusterKubeconfig{
+				ProjectId:   projectId,
+				ClusterName: *resp.Items[i].Name,
+				Context:     skeUtils.KubeconfigContextName(projectId, *resp.Items[i].Name),
+			})
+		}
+	}
+	return clusters, nil
+}
+
+func getClusterKubeconfig(ctx context.Context, model *inputModel, apiClient *ske.APIClient, projectId, clusterName string) (string, error) {
+	if model.IDP {
+		resp, err := apiClient.DefaultAPI.GetIDPKubeconfig(ctx, projectId, model.Region, clusterName).Execute()
+		if err != nil {
+			return "", fmt.Errorf("create idp kubeconfig for SKE cluster: %w", err)
+		}
+		if resp.Kubeconfig == nil {
+			return "", fmt.Errorf("no idp kubeconfig returned from the API")
+		}
+		return *resp.Kubeconfig, nil
+	}
+
+	resp, err := apiClient.DefaultAPI.GetLoginKubeconfig(ctx, projectId, model.Region, clusterName).Execute()
+	if err != nil {
+		return "", fmt.Errorf("create login kubeconfig for SKE cluster: %w", err)
+	}
+	if resp.Kubeconfig == nil {
+		return "", fmt.Errorf("no login kubeconfig returned from the API")
+	}
+	return *resp.Kubeconfig, nil
+}
+
+func outputResultAll(p *print.Printer, outputFormat, kubeconfigPath string, created []clusterKubeconfig) error {
+	return p.OutputResult(outputFormat, created, func() error {
+		p.Outputf("Updated kubeconfig file %q for %d SKE cluster(s)\n", kubeconfigPath, len(created))
+		for i := range created {
+			p.Outputf("  %s\n", created[i].Context)
+		}
+		p.Outputf("\nSet kubectl context with: kubectl config use-context <CONTEXT>\n")
+		return nil
+	})
+}
+
 func outputResult(p *print.Printer, outputFormat, clusterName, kubeconfigPath string, respKubeconfig *ske.Kubeconfig, respLogin *ske.LoginKubeconfig, respIDP *ske.IDPKubeconfig) error {
 	switch outputFormat {
 	case print.JSONOutputFormat:
@@ -2,11 +2,18 @@ package create
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/google/uuid"
+	sdkConfig "github.com/stackitcloud/stackit-sdk-go/core/config"
+	resourcemanager "github.com/stackitcloud/stackit-sdk-go/services/resourcemanager/v0api"
 	ske "github.com/stackitcloud/stackit-sdk-go/services/ske/v2api"
 
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
@@ -24,6 +31,7 @@ var testCtx = context.WithValue(context.Background(), testCtxKey{}, "foo")
 var testClient = &ske.APIClient{DefaultAPI: &ske.DefaultAPIService{}}
 var testProjectId = uuid.NewString()
 var testClusterName = "cluster"
+var testOrganizationId = uuid.NewString()
 
 const testRegion = "eu01"
 
@@ -224,6 +232,110 @@ func TestParseInput(t *testing.T) {
 			}),
 			isValid: true,
 		},
+		{
+			description: "switch context",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[switchContextFlag] = "true"
+			}),
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.SwitchContext = true
+			}),
+			isValid: true,
+		},
+		{
+			description: "switch context and disable writing",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[switchContextFlag] = "true"
+				flagValues[disableWritingFlag] = "true"
+				flagValues[globalflags.OutputFormatFlag.Name()] = print.JSONOutputFormat
+			}),
+			isValid: false,
+		},
+		{
+			description: "no cluster name",
+			argValues:   []string{},
+			flagValues:  fixtureFlagValues(),
+			isValid:     false,
+		},
+		{
+			description: "all",
+			argValues:   []string{},
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[allFlag] = "true"
+				flagValues[loginFlag] = "true"
+			}),
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.ClusterName = ""
+				model.All = true
+				model.Login = true
+			}),
+		},
+		{
+			description: "all in organization",
+			argValues:   []string{},
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, projectIdFlag)
+				flagValues[allFlag] = "true"
+				flagValues[idpFlag] = "true"
+				flagValues[organizationIdFlag] = testOrganizationId
+			}),
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.ProjectId = ""
+				model.ClusterName = ""
+				model.All = true
+				model.IDP = true
+				model.OrganizationId = utils.Ptr(testOrganizationId)
+			}),
+		},
+		{
+			description: "all with cluster name",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[allFlag] = "true"
+				flagValues[loginFlag] = "true"
+			}),
+			isValid: false,
+		},
+		{
+			description: "all without login or idp",
+			argValues:   []string{},
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[allFlag] = "true"
+			}),
+			isValid: false,
+		},
+		{
+			description: "all and switch context",
+			argValues:   []string{},
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[allFlag] = "true"
+				flagValues[loginFlag] = "true"
+				flagValues[switchContextFlag] = "true"
+			}),
+			isValid: false,
+		},
+		{
+			description: "organization id without all",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[organizationIdFlag] = testOrganizationId
+			}),
+			isValid: false,
+		},
+		{
+			description: "organization id invalid",
+			argValues:   []string{},
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[allFlag] = "true"
+				flagValues[loginFlag] = "true"
+				flagValues[organizationIdFlag] = "invalid-uuid"
+			}),
+			isValid: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -288,6 +400,196 @@ func TestBuildRequestIDP(t *testing.T) {
 	assertNoDiff(t, request, expectedRequest)
 }
 
+func TestFetchProjectIds(t *testing.T) {
+	tests := []struct {
+		description string
+		// totalItems is the number of projects in every container
+		totalItems int
+		// folders are the IDs of the folders in every container
+		folders                 map[string][]string
+		apiCallFails            bool
+		expectedNumProjectCalls int
+		expectedNumItems        int
+	}{
+		{
+			description:             "single page",
+			totalItems:              10,
+			expectedNumProjectCalls: 1,
+			expectedNumItems:        5,
+		},
+		{
+			description:             "multiple pages",
+			totalItems:              120,
+			expectedNumProjectCalls: 3,
+			expectedNumItems:        60,
+		},
+		{
+			description:             "full last page",
+			totalItems:              100,
+			expectedNumProjectCalls: 3, // Last call will return no items
+			expectedNumItems:        50,
+		},
+		{
+			description: "nested folders",
+			totalItems:  10,
+			folders: map[string][]string{
+				testOrganizationId: {"folder-a", "folder-b"},
+				"folder-a":         {"folder-c"},
+			},
+			expectedNumProjectCalls: 4,
+			expectedNumItems:        20,
+		},
+		{
+			description:  "request fails",
+			totalItems:   10,
+			apiCallFails: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			numProjectCalls := 0
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if tt.apiCallFails {
+					w.WriteHeader(http.StatusInternalServerError)
+					_, err := w.Write([]byte("{\"message\": \"Something bad happened\""))
+					if err != nil {
+						t.Errorf("Failed to write bad response: %v", err)
+					}
+					return
+				}
+
+				query := r.URL.Query()
+				parentId := query.Get("containerParentId")
+				if parentId != testOrganizationId && !strings.HasPrefix(parentId, "folder-") {
+					t.Errorf("Expected query param containerParentId to be the organization or a folder, got %q", parentId)
+				}
+				offset, err := strconv.Atoi(query.Get("offset"))
+				if err != nil {
+					t.Errorf("Failed to parse query param offset: %v", err)
+				}
+				limit, err := strconv.Atoi(query.Get("limit"))
+				if err != nil {
+					t.Errorf("Failed to parse query param limit: %v", err)
+				}
+
+				var resp any
+				if strings.HasSuffix(r.URL.Path, "/folders") {
+					folderIds := tt.folders[parentId]
+					folders := []resourcemanager.ListFoldersResponseItemsInner{}
+					for i := offset; i < min(offset+limit, len(folderIds)); i++ {
+						folders = append(folders, resourcemanager.ListFoldersResponseItemsInner{
+							ContainerId: folderIds[i],
+							FolderId:    folderIds[i],
+							Name:        folderIds[i],
+						})
+					}
+					resp = resourcemanager.ListFoldersResponse{Items: folders}
+				} else {
+					numProjectCalls++
+					projects := []resourcemanager.Project{}
+					for i := offset; i < min(offset+limit, tt.totalItems); i++ {
+						// Every second project is inactive
+						lifecycleState := resourcemanager.LIFECYCLESTATE_ACTIVE
+						if i%2 == 1 {
+							lifecycleState = resourcemanager.LIFECYCLESTATE_DELETING
+						}
+						projects = append(projects, resourcemanager.Project{
+							ProjectId:      uuid.NewString(),
+							LifecycleState: lifecycleState,
+						})
+					}
+					resp = resourcemanager.ListProjectsResponse{Items: projects}
+				}
+				mockedRespBytes, err := json.Marshal(resp)
+				if err != nil {
+					t.Fatalf("Failed to marshal mocked response: %v", err)
+				}
+
+				_, err = w.Write(mockedRespBytes)
+				if err != nil {
+					t.Errorf("Failed to write response: %v", err)
+				}
+			})
+			mockedServer := httptest.NewServer(handler)
+			defer mockedServer.Close()
+			client, err := resourcemanager.NewAPIClient(
+				sdkConfig.WithEndpoint(mockedServer.URL),
+				sdkConfig.WithoutAuthentication(),
+			)
+			if err != nil {
+				t.Fatalf("Failed to initialize client: %v", err)
+			}
+
+			projectIds, err := fetchProjectIds(testCtx, client.DefaultAPI, testOrganizationId)
+			if err != nil {
+				if !tt.apiCallFails {
+					t.Fatalf("failed on valid input: %v", err)
+				}
+				return
+			}
+			if tt.apiCallFails {
+				t.Fatalf("did not fail on invalid input")
+			}
+			if numProjectCalls != tt.expectedNumProjectCalls {
+				t.Fatalf("Expected %d project API calls, got %d", tt.expectedNumProjectCalls, numProjectCalls)
+			}
+			if len(projectIds) != tt.expectedNumItems {
+				t.Fatalf("Expected %d project IDs, got %d", tt.expectedNumItems, len(projectIds))
+			}
+		})
+	}
+}
+
+func TestOutputResultAll(t *testing.T) {
+	type args struct {
+		outputFormat   string
+		kubeconfigPath string
+		created        []clusterKubeconfig
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name:    "empty",
+			args:    args{},
+			wantErr: false,
+		},
+		{
+			name: "created",
+			args: args{
+				kubeconfigPath: "/path/to/config",
+				created: []clusterKubeconfig{
+					{ProjectId: testProjectId, ClusterName: testClusterName, Context: testProjectId + "/" + testClusterName},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "created in json format",
+			args: args{
+				outputFormat: print.JSONOutputFormat,
+				created: []clusterKubeconfig{
+					{ProjectId: testProjectId, ClusterName: testClusterName, Context: testProjectId + "/" + testClusterName},
+				},
+			},
+			wantErr: false,
+		},
+	}
+	params := testparams.NewTestParams()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := outputResultAll(params.Printer, tt.args.outputFormat, tt.args.kubeconfigPath, tt.args.created); (err != nil) != tt.wantErr {
+				t.Errorf("outputResultAll() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func Test_outputResult(t *testing.T) {
 	type args struct {
 		outputFormat   string
@@ -1,8 +1,11 @@
 package kubeconfig
 
 import (
+	"github.com/stackitcloud/stackit-cli/internal/cmd/ske/kubeconfig/cache"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/ske/kubeconfig/create"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/ske/kubeconfig/list"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/ske/kubeconfig/login"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/ske/kubeconfig/prune"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
@@ -25,4 +28,7 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 func addSubcommands(cmd *cobra.Command, params *types.CmdParams) {
 	cmd.AddCommand(create.NewCmd(params))
 	cmd.AddCommand(login.NewCmd(params))
+	cmd.AddCommand(list.NewCmd(params))
+	cmd.AddCommand(prune.NewCmd(params))
+	cmd.AddCommand(cache.NewCmd(params))
 }
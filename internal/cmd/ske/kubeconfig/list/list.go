@@ -0,0 +1,143 @@
+package list
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/client"
+	skeUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/utils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/tables"
+)
+
+const (
+	filepathFlag = "filepath"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+	Filepath *string
+}
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Lists the SKE clusters in a kubeconfig",
+		Long: fmt.Sprintf("%s\n%s\n%s",
+			"Lists the contexts of a kubeconfig file which point to STACKIT Kubernetes Engine (SKE) clusters.",
+			"For admin kubeconfigs, the expiration of the client certificate is shown. For login and IDP kubeconfigs, it is checked whether the cluster still exists. Admin kubeconfigs don't contain the project of the cluster, so their cluster is looked up in the project set with the --project-id flag or configured, and is reported as unknown if it isn't found there.",
+			`Stale contexts, whose certificate expired or whose cluster was deleted, can be removed with "stackit ske kubeconfig prune".`,
+		),
+		Args: args.NoArgs,
+		Example: examples.Build(
+			examples.NewExample(
+				`List the SKE clusters in the default kubeconfig file`,
+				"$ stackit ske kubeconfig list"),
+			examples.NewExample(
+				`List the SKE clusters in a custom kubeconfig file in JSON format`,
+				"$ stackit ske kubeconfig list --filepath /path/to/config --output-format json"),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			// Configure API client
+			apiClient, err := client.ConfigureClient(params.Printer, params.CliVersion)
+			if err != nil {
+				return err
+			}
+
+			kubeconfigPath, err := skeUtils.GetKubeconfigPath(model.Filepath)
+			if err != nil {
+				return err
+			}
+			config, err := clientcmd.LoadFromFile(kubeconfigPath)
+			if err != nil {
+				return fmt.Errorf("load kubeconfig %q: %w", kubeconfigPath, err)
+			}
+
+			entries := skeUtils.ListKubeconfigEntries(config)
+			skeUtils.CheckKubeconfigClusters(ctx, apiClient.DefaultAPI, entries, model.ProjectId, model.Region)
+
+			return outputResult(params.Printer, model.OutputFormat, kubeconfigPath, entries)
+		},
+	}
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().String(filepathFlag, "", "Path of the kubeconfig file. Will fall back to KUBECONFIG env variable if not set. In case both aren't set, the file named 'config' in the .kube folder in the user's home directory is used.")
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
+	globalFlags := globalflags.Parse(p, cmd)
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		Filepath:        flags.FlagToStringPointer(p, cmd, filepathFlag),
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+func outputResult(p *print.Printer, outputFormat, kubeconfigPath string, entries []skeUtils.KubeconfigEntry) error {
+	return p.OutputResult(outputFormat, entries, func() error {
+		if len(entries) == 0 {
+			p.Outputf("No SKE clusters found in kubeconfig %q\n", kubeconfigPath)
+			return nil
+		}
+
+		table := tables.NewTable()
+		table.SetHeader("CONTEXT", "CLUSTER", "PROJECT ID", "REGION", "TYPE", "EXPIRES AT", "CLUSTER EXISTS", "STALE")
+		for i := range entries {
+			entry := &entries[i]
+			contextName := entry.Context
+			if entry.Current {
+				contextName = fmt.Sprintf("* %s", contextName)
+			}
+			expiresAt := "-"
+			if entry.Expired {
+				expiresAt = "expired"
+			} else if entry.ExpiresAt != nil {
+				expiresAt = entry.ExpiresAt.Format(time.DateTime)
+			}
+			clusterExists := "unknown"
+			if entry.ClusterExists != nil {
+				clusterExists = fmt.Sprintf("%t", *entry.ClusterExists)
+			}
+			stale := "-"
+			if entry.IsStale() {
+				stale = entry.StaleReason()
+			}
+			table.AddRow(contextName, entry.ClusterName, valueOrDash(entry.ProjectId), valueOrDash(entry.Region), entry.Type, expiresAt, clusterExists, stale)
+		}
+		err := table.Display(p)
+		if err != nil {
+			return fmt.Errorf("render table: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func valueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/client"
+	skeUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/utils"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
 )
 
@@ -40,17 +41,20 @@ const (
 	refreshBeforeDuration      = 15 * time.Minute // 15 min
 	refreshTokenBeforeDuration = 5 * time.Minute  // 5 min
 
-	idpFlag = "idp"
+	idpFlag           = "idp"
+	refreshBeforeFlag = "refresh-before"
 )
 
 func NewCmd(params *types.CmdParams) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "login",
 		Short: "Login plugin for kubernetes clients",
-		Long: fmt.Sprintf("%s\n%s\n%s",
+		Long: fmt.Sprintf("%s\n%s\n%s\n%s\n%s",
 			"Login plugin for kubernetes clients, that creates short-lived credentials to authenticate against a STACKIT Kubernetes Engine (SKE) cluster.",
 			"First you need to obtain a kubeconfig for use with the login command (first or second example).",
 			"Secondly you use the kubeconfig with your chosen Kubernetes client (third example), the client will automatically retrieve the credentials via the STACKIT CLI.",
+			"The credentials are cached and new ones are requested shortly before they expire. If that fails, e.g. while offline, the cached credentials are used until they expire.",
+			`The cached credentials can be shown with "stackit ske kubeconfig cache list" and removed with "stackit ske kubeconfig cache clear", which only removes the expired ones with --expired.`,
 		),
 		Args: args.NoArgs,
 		Example: examples.Build(
@@ -66,6 +70,9 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 				"Use the previously saved kubeconfig to authenticate to the SKE cluster, in this case with kubectl.",
 				"$ kubectl cluster-info",
 				"$ kubectl get pods"),
+			examples.NewExample(
+				"Request new credentials when the cached ones expire within 10 minutes. The flag needs to be added to the arguments of the `stackit ske kubeconfig login` command in the kubeconfig.",
+				"$ stackit ske kubeconfig login --refresh-before 10m"),
 		),
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			ctx := context.Background()
@@ -86,6 +93,10 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 			if err != nil {
 				return fmt.Errorf("parseClusterConfig: %w", err)
 			}
+			clusterConfig.refreshBefore, err = parseRefreshBefore(params.Printer, cmd, idpMode)
+			if err != nil {
+				return err
+			}
 
 			if idpMode {
 				accessToken, err := getAccessToken(params)
@@ -97,7 +108,7 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 				if err != nil {
 					return err
 				}
-				return outputTokenKubeconfig(params.Printer, clusterConfig.cacheKey, token)
+				return outputTokenKubeconfig(params.Printer, clusterConfig, token)
 			}
 
 			// Configure API client
@@ -109,7 +120,7 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			return outputLoginKubeconfig(params.Printer, clusterConfig.cacheKey, kubeconfig)
+			return outputLoginKubeconfig(params.Printer, clusterConfig, kubeconfig)
 		},
 	}
 	configureFlags(cmd)
@@ -118,6 +129,30 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 
 func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().Bool(idpFlag, false, "Use the STACKIT IdP for authentication to the cluster.")
+	cmd.Flags().Duration(refreshBeforeFlag, 0, fmt.Sprintf("Time before the cached credentials expire in which new credentials are requested, e.g. 10m. Defaults to %s, or %s with --%s", refreshBeforeDuration, refreshTokenBeforeDuration, idpFlag))
+}
+
+// parseRefreshBefore returns the time before the expiration of the credentials in which they are refreshed.
+// Login kubeconfigs are valid for 30 minutes, so it must be shorter for them
+func parseRefreshBefore(p *print.Printer, cmd *cobra.Command, idpMode bool) (time.Duration, error) {
+	refreshBefore := flags.FlagWithDefaultToDurationValue(p, cmd, refreshBeforeFlag)
+	switch {
+	case refreshBefore < 0:
+		return 0, &cliErr.FlagValidationError{
+			Flag:    refreshBeforeFlag,
+			Details: "must not be negative",
+		}
+	case refreshBefore == 0 && idpMode:
+		return refreshTokenBeforeDuration, nil
+	case refreshBefore == 0:
+		return refreshBeforeDuration, nil
+	case !idpMode && refreshBefore >= expirationSeconds*time.Second:
+		return 0, &cliErr.FlagValidationError{
+			Flag:    refreshBeforeFlag,
+			Details: fmt.Sprintf("must be shorter than the validity of the credentials (%s)", expirationSeconds*time.Second),
+		}
+	}
+	return refreshBefore, nil
 }
 
 type clusterConfig struct {
@@ -126,7 +161,8 @@ type clusterConfig struct {
 	Region           string `json:"region"`
 	OrganizationID   string `json:"organizationID"`
 
-	cacheKey string
+	cacheKey      string
+	refreshBefore time.Duration
 }
 
 func parseClusterConfig(p *print.Printer, cmd *cobra.Command, idpMode bool) (*clusterConfig, error) {
@@ -165,7 +201,7 @@ func parseClusterConfig(p *print.Printer, cmd *cobra.Command, idpMode bool) (*cl
 	if idpMode {
 		idpSuffix = "\x00idp"
 	}
-	clusterConfig.cacheKey = fmt.Sprintf("ske-login-%x", sha256.Sum256([]byte(execCredential.Spec.Cluster.Server+"\x00"+authEmail+idpSuffix)))
+	clusterConfig.cacheKey = fmt.Sprintf("%s%x", skeUtils.LoginCachePrefix, sha256.Sum256([]byte(execCredential.Spec.Cluster.Server+"\x00"+authEmail+idpSuffix)))
 
 	// NOTE: Fallback if region is not set in the kubeconfig (this was the case in the past)
 	if clusterConfig.Region == "" {
@@ -181,13 +217,13 @@ func retrieveLoginKubeconfig(ctx context.Context, apiClient *ske.APIClient, clus
 		return requestNewLoginKubeconfig(ctx, apiClient, clusterConfig)
 	}
 
-	isValid, notAfter := checkKubeconfigExpiry(cachedKubeconfig.CertData)
+	isValid, notAfter := skeUtils.CheckKubeconfigExpiry(cachedKubeconfig.CertData)
 	if !isValid {
 		// cert is expired or invalid, request new
 		_ = cache.DeleteObject(clusterConfig.cacheKey)
 		return requestNewLoginKubeconfig(ctx, apiClient, clusterConfig)
-	} else if time.Now().Add(refreshBeforeDuration).After(notAfter.UTC()) {
-		// cert expires within the next 15min -> refresh
+	} else if time.Now().Add(clusterConfig.refreshBefore).After(notAfter.UTC()) {
+		// cert expires soon -> refresh
 		kubeconfig, err := requestNewLoginKubeconfig(ctx, apiClient, clusterConfig)
 		// try to get a new one but use cache on failure
 		if err != nil {
@@ -195,17 +231,18 @@ func retrieveLoginKubeconfig(ctx context.Context, apiClient *ske.APIClient, clus
 		}
 		return kubeconfig, nil
 	}
-	// cert not expired, nor will it expire in the next 15min; therefore, use the cached kubeconfig
+	// cert not expired, nor will it expire soon; therefore, use the cached kubeconfig
 	return cachedKubeconfig, nil
 }
 
 func getCachedKubeConfig(key string) *rest.Config {
-	cachedKubeconfig, err := cache.GetObject(key)
+	data, err := cache.GetObject(key)
 	if err != nil {
 		return nil
 	}
 
-	restConfig, err := clientcmd.RESTConfigFromKubeConfig(cachedKubeconfig)
+	cachedObject := skeUtils.ParseLoginCacheObject(data)
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig([]byte(cachedObject.Credentials))
 	if err != nil {
 		return nil
 	}
@@ -213,24 +250,6 @@ func getCachedKubeConfig(key string) *rest.Config {
 	return restConfig
 }
 
-func checkKubeconfigExpiry(certData []byte) (bool, time.Time) {
-	certPem, _ := pem.Decode(certData)
-	if certPem == nil {
-		return false, time.Time{}
-	}
-
-	certificate, err := x509.ParseCertificate(certPem.Bytes)
-	if err != nil {
-		return false, time.Time{}
-	}
-
-	// cert is expired
-	if time.Now().After(certificate.NotAfter.UTC()) {
-		return false, time.Time{}
-	}
-	return true, certificate.NotAfter.UTC()
-}
-
 func requestNewLoginKubeconfig(ctx context.Context, apiClient *ske.APIClient, clusterConfig *clusterConfig) (*rest.Config, error) {
 	req := buildLoginKubeconfigRequest(ctx, apiClient, clusterConfig)
 	kubeconfigResponse, err := req.Execute()
@@ -241,13 +260,28 @@ func requestNewLoginKubeconfig(ctx context.Context, apiClient *ske.APIClient, cl
 	if err != nil {
 		return nil, fmt.Errorf("parse kubeconfig: %w", err)
 	}
-	if err = cache.PutObject(clusterConfig.cacheKey, []byte(*kubeconfigResponse.Kubeconfig)); err != nil {
+	if err = putCacheObject(clusterConfig, skeUtils.KubeconfigTypeLogin, *kubeconfigResponse.Kubeconfig); err != nil {
 		return nil, fmt.Errorf("cache kubeconfig: %w", err)
 	}
 
 	return kubeconfig, nil
 }
 
+// putCacheObject caches the credentials together with the cluster they belong to
+func putCacheObject(clusterConfig *clusterConfig, kubeconfigType, credentials string) error {
+	data, err := json.Marshal(&skeUtils.LoginCacheObject{
+		ProjectId:   clusterConfig.STACKITProjectID,
+		Region:      clusterConfig.Region,
+		ClusterName: clusterConfig.ClusterName,
+		Type:        kubeconfigType,
+		Credentials: credentials,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal: %w", err)
+	}
+	return cache.PutObject(clusterConfig.cacheKey, data)
+}
+
 func buildLoginKubeconfigRequest(ctx context.Context, apiClient *ske.APIClient, clusterConfig *clusterConfig) ske.ApiCreateKubeconfigRequest {
 	req := apiClient.DefaultAPI.CreateKubeconfig(ctx, clusterConfig.STACKITProjectID, clusterConfig.Region, clusterConfig.ClusterName)
 	expirationSeconds := strconv.Itoa(expirationSeconds)
@@ -255,10 +289,10 @@ func buildLoginKubeconfigRequest(ctx context.Context, apiClient *ske.APIClient,
 	return req.CreateKubeconfigPayload(ske.CreateKubeconfigPayload{ExpirationSeconds: &expirationSeconds})
 }
 
-func outputLoginKubeconfig(p *print.Printer, cacheKey string, kubeconfig *rest.Config) error {
-	output, err := parseLoginKubeConfigToExecCredential(kubeconfig)
+func outputLoginKubeconfig(p *print.Printer, clusterConfig *clusterConfig, kubeconfig *rest.Config) error {
+	output, err := parseLoginKubeConfigToExecCredential(kubeconfig, clusterConfig.refreshBefore)
 	if err != nil {
-		_ = cache.DeleteObject(cacheKey)
+		_ = cache.DeleteObject(clusterConfig.cacheKey)
 		return fmt.Errorf("convert to ExecCredential: %w", err)
 	}
 
@@ -266,7 +300,7 @@ func outputLoginKubeconfig(p *print.Printer, cacheKey string, kubeconfig *rest.C
 	return nil
 }
 
-func parseLoginKubeConfigToExecCredential(kubeconfig *rest.Config) ([]byte, error) {
+func parseLoginKubeConfigToExecCredential(kubeconfig *rest.Config, refreshBefore time.Duration) ([]byte, error) {
 	if kubeconfig == nil {
 		return nil, errors.New("kubeconfig is nil")
 	}
@@ -287,7 +321,7 @@ func parseLoginKubeConfigToExecCredential(kubeconfig *rest.Config) ([]byte, erro
 			Kind:       "ExecCredential",
 		},
 		Status: &clientauthenticationv1.ExecCredentialStatus{
-			ExpirationTimestamp:   &v1.Time{Time: certificate.NotAfter.Add(-refreshBeforeDuration)},
+			ExpirationTimestamp:   &v1.Time{Time: certificate.NotAfter.Add(-refreshBefore)},
 			ClientCertificateData: string(kubeconfig.CertData),
 			ClientKeyData:         string(kubeconfig.KeyData),
 		},
@@ -324,21 +358,19 @@ func getAccessToken(params *types.CmdParams) (string, error) {
 }
 
 func retrieveTokenFromIDP(ctx context.Context, idpClient *http.Client, accessToken string, clusterConfig *clusterConfig) (string, error) {
-	resource := resourceForCluster(clusterConfig)
-
 	cachedToken := getCachedToken(clusterConfig.cacheKey)
 	if cachedToken == "" {
-		return exchangeAndCacheToken(ctx, idpClient, accessToken, resource, clusterConfig.cacheKey)
+		return exchangeAndCacheToken(ctx, idpClient, accessToken, clusterConfig)
 	}
 
 	expiry, err := auth.TokenExpirationTime(cachedToken)
 	if err != nil {
 		// token is expired or invalid, request new
 		_ = cache.DeleteObject(clusterConfig.cacheKey)
-		return exchangeAndCacheToken(ctx, idpClient, accessToken, resource, clusterConfig.cacheKey)
-	} else if time.Now().Add(refreshTokenBeforeDuration).After(expiry) {
+		return exchangeAndCacheToken(ctx, idpClient, accessToken, clusterConfig)
+	} else if time.Now().Add(clusterConfig.refreshBefore).After(expiry) {
 		// token expires soon -> refresh
-		token, err := exchangeAndCacheToken(ctx, idpClient, accessToken, resource, clusterConfig.cacheKey)
+		token, err := exchangeAndCacheToken(ctx, idpClient, accessToken, clusterConfig)
 		// try to get a new one but use cache on failure
 		if err != nil {
 			return cachedToken, nil
@@ -360,28 +392,28 @@ func resourceForCluster(config *clusterConfig) string {
 }
 
 func getCachedToken(key string) string {
-	token, err := cache.GetObject(key)
+	data, err := cache.GetObject(key)
 	if err != nil {
 		return ""
 	}
-	return string(token)
+	return skeUtils.ParseLoginCacheObject(data).Credentials
 }
 
-func exchangeAndCacheToken(ctx context.Context, idpClient *http.Client, accessToken, resource, cacheKey string) (string, error) {
-	clusterToken, err := auth.ExchangeToken(ctx, idpClient, accessToken, resource)
+func exchangeAndCacheToken(ctx context.Context, idpClient *http.Client, accessToken string, clusterConfig *clusterConfig) (string, error) {
+	clusterToken, err := auth.ExchangeToken(ctx, idpClient, accessToken, resourceForCluster(clusterConfig))
 	if err != nil {
 		return "", err
 	}
-	if err = cache.PutObject(cacheKey, []byte(clusterToken)); err != nil {
+	if err = putCacheObject(clusterConfig, skeUtils.KubeconfigTypeIDP, clusterToken); err != nil {
 		return "", fmt.Errorf("cache token: %w", err)
 	}
 	return clusterToken, err
 }
 
-func outputTokenKubeconfig(p *print.Printer, cacheKey, token string) error {
-	output, err := parseTokenToExecCredential(token)
+func outputTokenKubeconfig(p *print.Printer, clusterConfig *clusterConfig, token string) error {
+	output, err := parseTokenToExecCredential(token, clusterConfig.refreshBefore)
 	if err != nil {
-		_ = cache.DeleteObject(cacheKey)
+		_ = cache.DeleteObject(clusterConfig.cacheKey)
 		return fmt.Errorf("convert to ExecCredential: %w", err)
 	}
 
@@ -389,7 +421,7 @@ func outputTokenKubeconfig(p *print.Printer, cacheKey, token string) error {
 	return nil
 }
 
-func parseTokenToExecCredential(clusterToken string) ([]byte, error) {
+func parseTokenToExecCredential(clusterToken string, refreshBefore time.Duration) ([]byte, error) {
 	expiry, err := auth.TokenExpirationTime(clusterToken)
 	if err != nil {
 		return nil, fmt.Errorf("parse auth token for cluster: %w", err)
@@ -401,7 +433,7 @@ func parseTokenToExecCredential(clusterToken string) ([]byte, error) {
 			Kind:       "ExecCredential",
 		},
 		Status: &clientauthenticationv1.ExecCredentialStatus{
-			ExpirationTimestamp: &v1.Time{Time: expiry.Add(-refreshTokenBeforeDuration)},
+			ExpirationTimestamp: &v1.Time{Time: expiry.Add(-refreshBefore)},
 			Token:               clusterToken,
 		},
 	}
@@ -15,6 +15,8 @@ import (
 	clientauthenticationv1 "k8s.io/client-go/pkg/apis/clientauthentication/v1"
 	"k8s.io/client-go/rest"
 
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testparams"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
 )
 
@@ -133,7 +135,7 @@ zbRjZmli7cnenEnfnNoFIGbgkbjGXRUCIC5zFtWXFK7kA+B2vDxD0DlLcQodNwi4
 
 	for _, tt := range tests {
 		t.Run(tt.description, func(t *testing.T) {
-			execCredential, err := parseLoginKubeConfigToExecCredential(tt.kubeconfig)
+			execCredential, err := parseLoginKubeConfigToExecCredential(tt.kubeconfig, refreshBeforeDuration)
 			if err != nil {
 				t.Fatalf("func returned error: %s", err)
 			}
@@ -183,7 +185,7 @@ func TestParseTokenToExecCredential(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.description, func(t *testing.T) {
-			execCredential, err := parseTokenToExecCredential(tt.token)
+			execCredential, err := parseTokenToExecCredential(tt.token, refreshTokenBeforeDuration)
 			if err != nil {
 				t.Fatalf("func returned error: %s", err)
 			}
@@ -199,6 +201,88 @@ func TestParseTokenToExecCredential(t *testing.T) {
 	}
 }
 
+func TestParseRefreshBefore(t *testing.T) {
+	tests := []struct {
+		description   string
+		flagValues    map[string]string
+		isValid       bool
+		expectedValue time.Duration
+	}{
+		{
+			description:   "default",
+			flagValues:    map[string]string{},
+			isValid:       true,
+			expectedValue: refreshBeforeDuration,
+		},
+		{
+			description: "default idp",
+			flagValues: map[string]string{
+				idpFlag: "true",
+			},
+			isValid:       true,
+			expectedValue: refreshTokenBeforeDuration,
+		},
+		{
+			description: "custom",
+			flagValues: map[string]string{
+				refreshBeforeFlag: "10m",
+			},
+			isValid:       true,
+			expectedValue: 10 * time.Minute,
+		},
+		{
+			description: "custom idp",
+			flagValues: map[string]string{
+				idpFlag:           "true",
+				refreshBeforeFlag: "45m",
+			},
+			isValid:       true,
+			expectedValue: 45 * time.Minute,
+		},
+		{
+			description: "negative",
+			flagValues: map[string]string{
+				refreshBeforeFlag: "-1m",
+			},
+			isValid: false,
+		},
+		{
+			description: "longer than the validity",
+			flagValues: map[string]string{
+				refreshBeforeFlag: "30m",
+			},
+			isValid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			params := testparams.NewTestParams()
+			cmd := NewCmd(params.CmdParams)
+			for flag, value := range tt.flagValues {
+				if err := cmd.Flags().Set(flag, value); err != nil {
+					t.Fatalf("setting flag --%s=%s: %v", flag, value, err)
+				}
+			}
+			idpMode := flags.FlagToBoolValue(params.Printer, cmd, idpFlag)
+
+			refreshBefore, err := parseRefreshBefore(params.Printer, cmd, idpMode)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("did not fail on invalid input")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			if refreshBefore != tt.expectedValue {
+				t.Fatalf("expected %s, got %s", tt.expectedValue, refreshBefore)
+			}
+		})
+	}
+}
+
 func TestResourceForCluster(t *testing.T) {
 	cc := fixtureClusterConfig()
 	resource := resourceForCluster(cc)
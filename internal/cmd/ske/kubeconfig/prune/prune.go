@@ -0,0 +1,150 @@
+package prune
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/client"
+	skeUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/utils"
+)
+
+const (
+	filepathFlag = "filepath"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+	Filepath *string
+}
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Removes stale SKE clusters from a kubeconfig",
+		Long: fmt.Sprintf("%s\n%s\n%s",
+			"Removes the contexts of a kubeconfig file which point to STACKIT Kubernetes Engine (SKE) clusters and are stale, as well as their clusters and users, if they aren't used by other contexts.",
+			"A context is stale if the client certificate of an admin kubeconfig expired, or if the cluster of a login or IDP kubeconfig doesn't exist anymore.",
+			`Use "stackit ske kubeconfig list" to show which contexts are stale.`,
+		),
+		Args: args.NoArgs,
+		Example: examples.Build(
+			examples.NewExample(
+				`Remove the stale SKE clusters from the default kubeconfig file`,
+				"$ stackit ske kubeconfig prune"),
+			examples.NewExample(
+				`Remove the stale SKE clusters from a custom kubeconfig file`,
+				"$ stackit ske kubeconfig prune --filepath /path/to/config"),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			// Configure API client
+			apiClient, err := client.ConfigureClient(params.Printer, params.CliVersion)
+			if err != nil {
+				return err
+			}
+
+			kubeconfigPath, err := skeUtils.GetKubeconfigPath(model.Filepath)
+			if err != nil {
+				return err
+			}
+			config, err := clientcmd.LoadFromFile(kubeconfigPath)
+			if err != nil {
+				return fmt.Errorf("load kubeconfig %q: %w", kubeconfigPath, err)
+			}
+
+			entries := skeUtils.ListKubeconfigEntries(config)
+			skeUtils.CheckKubeconfigClusters(ctx, apiClient.DefaultAPI, entries, model.ProjectId, model.Region)
+			staleEntries := getStaleEntries(entries)
+			if len(staleEntries) == 0 {
+				return outputResult(params.Printer, model.OutputFormat, kubeconfigPath, staleEntries)
+			}
+
+			prompt := fmt.Sprintf("Are you sure you want to remove the following contexts from kubeconfig %q?\n%s", kubeconfigPath, formatEntries(staleEntries))
+			err = params.Printer.PromptForConfirmation(prompt)
+			if err != nil {
+				return err
+			}
+
+			contexts := []string{}
+			for i := range staleEntries {
+				contexts = append(contexts, staleEntries[i].Context)
+			}
+			skeUtils.RemoveKubeconfigContexts(config, contexts)
+			err = clientcmd.WriteToFile(*config, kubeconfigPath)
+			if err != nil {
+				return fmt.Errorf("write kubeconfig %q: %w", kubeconfigPath, err)
+			}
+
+			return outputResult(params.Printer, model.OutputFormat, kubeconfigPath, staleEntries)
+		},
+	}
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().String(filepathFlag, "", "Path of the kubeconfig file. Will fall back to KUBECONFIG env variable if not set. In case both aren't set, the file named 'config' in the .kube folder in the user's home directory is used.")
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
+	globalFlags := globalflags.Parse(p, cmd)
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		Filepath:        flags.FlagToStringPointer(p, cmd, filepathFlag),
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+func getStaleEntries(entries []skeUtils.KubeconfigEntry) []skeUtils.KubeconfigEntry {
+	staleEntries := []skeUtils.KubeconfigEntry{}
+	for i := range entries {
+		if entries[i].IsStale() {
+			staleEntries = append(staleEntries, entries[i])
+		}
+	}
+	return staleEntries
+}
+
+func formatEntries(entries []skeUtils.KubeconfigEntry) string {
+	lines := []string{}
+	for i := range entries {
+		lines = append(lines, fmt.Sprintf("  - %s (%s)", entries[i].Context, entries[i].StaleReason()))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func outputResult(p *print.Printer, outputFormat, kubeconfigPath string, removedEntries []skeUtils.KubeconfigEntry) error {
+	return p.OutputResult(outputFormat, removedEntries, func() error {
+		if len(removedEntries) == 0 {
+			p.Outputf("No stale SKE clusters found in kubeconfig %q\n", kubeconfigPath)
+			return nil
+		}
+
+		p.Outputf("Removed %d stale context(s) from kubeconfig %q\n", len(removedEntries), kubeconfigPath)
+		for i := range removedEntries {
+			if removedEntries[i].Current {
+				p.Warn("The current context %q was removed, set a new one with: kubectl config use-context <CONTEXT>\n", removedEntries[i].Context)
+			}
+		}
+		return nil
+	})
+}
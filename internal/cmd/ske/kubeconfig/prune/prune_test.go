@@ -0,0 +1,161 @@
+package prune
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	skeUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/utils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testparams"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testutils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+)
+
+const testRegion = "eu01"
+
+func fixtureFlagValues(mods ...func(flagValues map[string]string)) map[string]string {
+	flagValues := map[string]string{
+		globalflags.RegionFlag: testRegion,
+	}
+	for _, mod := range mods {
+		mod(flagValues)
+	}
+	return flagValues
+}
+
+func fixtureInputModel(mods ...func(model *inputModel)) *inputModel {
+	model := &inputModel{
+		GlobalFlagModel: &globalflags.GlobalFlagModel{
+			Region:    testRegion,
+			Verbosity: globalflags.VerbosityDefault,
+		},
+	}
+	for _, mod := range mods {
+		mod(model)
+	}
+	return model
+}
+
+func TestParseInput(t *testing.T) {
+	tests := []struct {
+		description   string
+		argValues     []string
+		flagValues    map[string]string
+		isValid       bool
+		expectedModel *inputModel
+	}{
+		{
+			description:   "base",
+			flagValues:    fixtureFlagValues(),
+			isValid:       true,
+			expectedModel: fixtureInputModel(),
+		},
+		{
+			description: "no values",
+			flagValues:  map[string]string{},
+			isValid:     true,
+			expectedModel: &inputModel{
+				GlobalFlagModel: &globalflags.GlobalFlagModel{
+					Verbosity: globalflags.VerbosityDefault,
+				},
+			},
+		},
+		{
+			description: "filepath",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[filepathFlag] = "/path/to/config"
+			}),
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.Filepath = utils.Ptr("/path/to/config")
+			}),
+		},
+		{
+			description: "output format",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[globalflags.OutputFormatFlag.Name()] = print.JSONOutputFormat
+			}),
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.OutputFormat = print.JSONOutputFormat
+			}),
+		},
+		{
+			description: "args",
+			argValues:   []string{"arg"},
+			flagValues:  fixtureFlagValues(),
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			testutils.TestParseInput(t, NewCmd, parseInput, tt.expectedModel, tt.argValues, tt.flagValues, tt.isValid)
+		})
+	}
+}
+
+func TestGetStaleEntries(t *testing.T) {
+	entries := []skeUtils.KubeconfigEntry{
+		{Context: "valid", ExpiresAt: utils.Ptr(time.Now())},
+		{Context: "expired", Expired: true},
+		{Context: "unknown"},
+		{Context: "existing", ClusterExists: utils.Ptr(true)},
+		{Context: "deleted", ClusterExists: utils.Ptr(false)},
+	}
+
+	staleEntries := getStaleEntries(entries)
+	if len(staleEntries) != 2 || staleEntries[0].Context != "expired" || staleEntries[1].Context != "deleted" {
+		t.Fatalf("unexpected stale entries: %v", staleEntries)
+	}
+}
+
+func TestOutputResult(t *testing.T) {
+	type args struct {
+		outputFormat   string
+		kubeconfigPath string
+		removedEntries []skeUtils.KubeconfigEntry
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name:    "empty",
+			args:    args{},
+			wantErr: false,
+		},
+		{
+			name: "removed entries",
+			args: args{
+				kubeconfigPath: "/path/to/config",
+				removedEntries: []skeUtils.KubeconfigEntry{
+					{Context: "expired", Type: skeUtils.KubeconfigTypeAdmin, Current: true, Expired: true},
+					{Context: "login", Type: skeUtils.KubeconfigTypeLogin, ClusterExists: utils.Ptr(false)},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "removed entries in json format",
+			args: args{
+				outputFormat: print.JSONOutputFormat,
+				removedEntries: []skeUtils.KubeconfigEntry{
+					{Context: "login", Type: skeUtils.KubeconfigTypeLogin, ClusterExists: utils.Ptr(false)},
+				},
+			},
+			wantErr: false,
+		},
+	}
+	params := testparams.NewTestParams()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := outputResult(params.Printer, tt.args.outputFormat, tt.args.kubeconfigPath, tt.args.removedEntries); (err != nil) != tt.wantErr {
+				t.Errorf("outputResult() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package add
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+
+	"github.com/spf13/cobra"
+	ske "github.com/stackitcloud/stackit-sdk-go/services/ske/v2api"
+	wait "github.com/stackitcloud/stackit-sdk-go/services/ske/v2api/wait"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/client"
+	skeUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/utils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/spinner"
+)
+
+const (
+	nodepoolNameArg = "NODEPOOL_NAME"
+
+	clusterNameFlag = "cluster-name"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+	ClusterName string
+	Nodepool    *skeUtils.NodepoolOptions
+}
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("add %s", nodepoolNameArg),
+		Short: "Adds a nodepool to a SKE cluster",
+		Long: fmt.Sprintf("%s\n%s",
+			"Adds a nodepool to a STACKIT Kubernetes Engine (SKE) cluster.",
+			"The nodepool is based on the default nodepool of the SKE provider options, only the machine type is required. Unset values are taken from the default nodepool.",
+		),
+		Args: args.SingleArg(nodepoolNameArg, nil),
+		Example: examples.Build(
+			examples.NewExample(
+				`Add the nodepool "my-pool" with machine type "c2i.4" to the SKE cluster "my-cluster"`,
+				"$ stackit ske nodepool add my-pool --cluster-name my-cluster --machine-type c2i.4"),
+			examples.NewExample(
+				`Add the nodepool "my-pool" with 1 to 3 nodes in availability zone "eu01-1" to the SKE cluster "my-cluster"`,
+				"$ stackit ske nodepool add my-pool --cluster-name my-cluster --machine-type c2i.4 --min 1 --max 3 --availability-zones eu01-1"),
+			examples.NewExample(
+				`Add the nodepool "my-pool" with a label and a taint to the SKE cluster "my-cluster"`,
+				`$ stackit ske nodepool add my-pool --cluster-name my-cluster --machine-type c2i.4 --labels team=data --taints "dedicated=data:NoSchedule"`),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			// Configure API client
+			apiClient, err := client.ConfigureClient(params.Printer, params.CliVersion)
+			if err != nil {
+				return err
+			}
+
+			prompt := fmt.Sprintf("Are you sure you want to add nodepool %q to cluster %q?", model.Nodepool.Name, model.ClusterName)
+			err = params.Printer.PromptForConfirmation(prompt)
+			if err != nil {
+				return err
+			}
+
+			cluster, err := apiClient.DefaultAPI.GetCluster(ctx, model.ProjectId, model.Region, model.ClusterName).Execute()
+			if err != nil {
+				return fmt.Errorf("get SKE cluster: %w", err)
+			}
+			if _, err := skeUtils.GetNodepool(cluster, model.Nodepool.Name); err == nil {
+				return fmt.Errorf("nodepool %q already exists in cluster %q", model.Nodepool.Name, model.ClusterName)
+			}
+			defaultNodepool, err := skeUtils.GetDefaultNodepool(ctx, apiClient.DefaultAPI, model.Region)
+			if err != nil {
+				return fmt.Errorf("get default nodepool: %w", err)
+			}
+			payload := skeUtils.PayloadFromCluster(cluster)
+			err = skeUtils.ApplyClusterSpec(payload, &skeUtils.ClusterSpecOptions{Nodepools: []skeUtils.NodepoolOptions{*model.Nodepool}}, defaultNodepool)
+			if err != nil {
+				return err
+			}
+
+			// Call API
+			req := buildRequest(ctx, model, apiClient, payload)
+			resp, err := req.Execute()
+			if err != nil {
+				return fmt.Errorf("update SKE cluster: %w", err)
+			}
+
+			// Wait for async operation, if async mode not enabled
+			if !model.Async {
+				err := spinner.Run(params.Printer, "Adding nodepool", func() error {
+					_, err = wait.UpdateClusterWaitHandler(ctx, apiClient.DefaultAPI, model.ProjectId, model.Region, model.ClusterName).WaitWithContext(ctx)
+					return err
+				})
+				if err != nil {
+					return fmt.Errorf("wait for SKE cluster update: %w", err)
+				}
+			}
+
+			return outputResult(params.Printer, model.OutputFormat, model.Async, model.ClusterName, model.Nodepool.Name, resp)
+		},
+	}
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().String(clusterNameFlag, "", "Name of the cluster")
+	skeUtils.ConfigureNodepoolFlags(cmd)
+
+	err := flags.MarkFlagsRequired(cmd, clusterNameFlag, skeUtils.NodepoolMachineTypeFlag)
+	cobra.CheckErr(err)
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inputModel, error) {
+	nodepoolName := inputArgs[0]
+
+	globalFlags := globalflags.Parse(p, cmd)
+	if globalFlags.ProjectId == "" {
+		return nil, &errors.ProjectIdError{}
+	}
+
+	nodepool, err := skeUtils.ParseNodepoolFlags(p, cmd, nodepoolName)
+	if err != nil {
+		return nil, err
+	}
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		ClusterName:     flags.FlagToStringValue(p, cmd, clusterNameFlag),
+		Nodepool:        nodepool,
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+func buildRequest(ctx context.Context, model *inputModel, apiClient *ske.APIClient, payload *ske.CreateOrUpdateClusterPayload) ske.ApiCreateOrUpdateClusterRequest {
+	req := apiClient.DefaultAPI.CreateOrUpdateCluster(ctx, model.ProjectId, model.Region, model.ClusterName)
+
+	req = req.CreateOrUpdateClusterPayload(*payload)
+	return req
+}
+
+func outputResult(p *print.Printer, outputFormat string, async bool, clusterName, nodepoolName string, cluster *ske.Cluster) error {
+	if cluster == nil {
+		return fmt.Errorf("cluster is nil")
+	}
+
+	return p.OutputResult(outputFormat, cluster, func() error {
+		operationState := "Added"
+		if async {
+			operationState = "Triggered addition of"
+		}
+		p.Info("%s nodepool %q to cluster %q\n", operationState, nodepoolName, clusterName)
+		return nil
+	})
+}
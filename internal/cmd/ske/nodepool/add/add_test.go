@@ -0,0 +1,277 @@
+package add
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	skeUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/utils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testparams"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testutils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	ske "github.com/stackitcloud/stackit-sdk-go/services/ske/v2api"
+)
+
+var projectIdFlag = globalflags.ProjectIdFlag
+
+type testCtxKey struct{}
+
+var testCtx = context.WithValue(context.Background(), testCtxKey{}, "foo")
+var testClient = &ske.APIClient{DefaultAPI: &ske.DefaultAPIService{}}
+var testProjectId = uuid.NewString()
+var testClusterName = "cluster"
+var testNodepoolName = "pool"
+
+const testRegion = "eu01"
+
+var testPayload = ske.CreateOrUpdateClusterPayload{
+	Kubernetes: ske.Kubernetes{
+		Version: "1.33.5",
+	},
+	Nodepools: []ske.Nodepool{
+		{
+			Name: testNodepoolName,
+			Machine: ske.Machine{
+				Type: "c2i.4",
+			},
+			Minimum: int32(1),
+			Maximum: int32(3),
+		},
+	},
+}
+
+func fixtureArgValues(mods ...func(argValues []string)) []string {
+	argValues := []string{
+		testNodepoolName,
+	}
+	for _, mod := range mods {
+		mod(argValues)
+	}
+	return argValues
+}
+
+func fixtureFlagValues(mods ...func(flagValues map[string]string)) map[string]string {
+	flagValues := map[string]string{
+		globalflags.ProjectIdFlag:        testProjectId,
+		globalflags.RegionFlag:           testRegion,
+		clusterNameFlag:                  testClusterName,
+		skeUtils.NodepoolMachineTypeFlag: "c2i.4",
+	}
+	for _, mod := range mods {
+		mod(flagValues)
+	}
+	return flagValues
+}
+
+func fixtureInputModel(mods ...func(model *inputModel)) *inputModel {
+	model := &inputModel{
+		GlobalFlagModel: &globalflags.GlobalFlagModel{
+			ProjectId: testProjectId,
+			Region:    testRegion,
+			Verbosity: globalflags.VerbosityDefault,
+		},
+		ClusterName: testClusterName,
+		Nodepool: &skeUtils.NodepoolOptions{
+			Name:        testNodepoolName,
+			MachineType: utils.Ptr("c2i.4"),
+		},
+	}
+	for _, mod := range mods {
+		mod(model)
+	}
+	return model
+}
+
+func fixtureRequest(mods ...func(request *ske.ApiCreateOrUpdateClusterRequest)) ske.ApiCreateOrUpdateClusterRequest {
+	request := testClient.DefaultAPI.CreateOrUpdateCluster(testCtx, testProjectId, testRegion, testClusterName)
+	request = request.CreateOrUpdateClusterPayload(testPayload)
+	for _, mod := range mods {
+		mod(&request)
+	}
+	return request
+}
+
+func TestParseInput(t *testing.T) {
+	tests := []struct {
+		description   string
+		argValues     []string
+		flagValues    map[string]string
+		isValid       bool
+		expectedModel *inputModel
+	}{
+		{
+			description:   "base",
+			argValues:     fixtureArgValues(),
+			flagValues:    fixtureFlagValues(),
+			isValid:       true,
+			expectedModel: fixtureInputModel(),
+		},
+		{
+			description: "all values",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[skeUtils.NodepoolMinimumFlag] = "1"
+				flagValues[skeUtils.NodepoolMaximumFlag] = "3"
+				flagValues[skeUtils.NodepoolAvailabilityZonesFlag] = "eu01-1,eu01-2"
+				flagValues[skeUtils.NodepoolCRIFlag] = "containerd"
+				flagValues[skeUtils.NodepoolLabelsFlag] = "team=data"
+				flagValues[skeUtils.NodepoolTaintsFlag] = "dedicated=data:NoSchedule"
+			}),
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.Nodepool.Minimum = utils.Ptr(int32(1))
+				model.Nodepool.Maximum = utils.Ptr(int32(3))
+				model.Nodepool.AvailabilityZones = []string{"eu01-1", "eu01-2"}
+				model.Nodepool.CRI = ske.NAMEOFTHECRILIBRARY_CONTAINERD.Ptr()
+				model.Nodepool.Labels = &map[string]string{"team": "data"}
+				model.Nodepool.Taints = &[]ske.Taint{
+					{Key: "dedicated", Value: utils.Ptr("data"), Effect: ske.TaintEffect("NoSchedule")},
+				}
+			}),
+		},
+		{
+			description: "no values",
+			argValues:   []string{},
+			flagValues:  map[string]string{},
+			isValid:     false,
+		},
+		{
+			description: "no arg values",
+			argValues:   []string{},
+			flagValues:  fixtureFlagValues(),
+			isValid:     false,
+		},
+		{
+			description: "project id missing",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, projectIdFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "project id invalid",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[projectIdFlag] = "invalid-uuid"
+			}),
+			isValid: false,
+		},
+		{
+			description: "cluster name missing",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, clusterNameFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "machine type missing",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, skeUtils.NodepoolMachineTypeFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "taint invalid",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[skeUtils.NodepoolTaintsFlag] = "dedicated=data"
+			}),
+			isValid: false,
+		},
+		{
+			description: "minimum negative",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[skeUtils.NodepoolMinimumFlag] = "-1"
+			}),
+			isValid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			testutils.TestParseInput(t, NewCmd, parseInput, tt.expectedModel, tt.argValues, tt.flagValues, tt.isValid)
+		})
+	}
+}
+
+func TestBuildRequest(t *testing.T) {
+	tests := []struct {
+		description     string
+		model           *inputModel
+		payload         *ske.CreateOrUpdateClusterPayload
+		expectedRequest ske.ApiCreateOrUpdateClusterRequest
+	}{
+		{
+			description:     "base",
+			model:           fixtureInputModel(),
+			payload:         &testPayload,
+			expectedRequest: fixtureRequest(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			request := buildRequest(testCtx, tt.model, testClient, tt.payload)
+
+			diff := cmp.Diff(request, tt.expectedRequest,
+				cmp.AllowUnexported(tt.expectedRequest),
+				cmpopts.EquateComparable(testCtx),
+				cmpopts.EquateComparable(testClient.DefaultAPI),
+			)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestOutputResult(t *testing.T) {
+	type args struct {
+		outputFormat string
+		async        bool
+		cluster      *ske.Cluster
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name:    "empty",
+			args:    args{},
+			wantErr: true,
+		},
+		{
+			name: "empty cluster",
+			args: args{
+				cluster: &ske.Cluster{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "async",
+			args: args{
+				async:   true,
+				cluster: &ske.Cluster{},
+			},
+			wantErr: false,
+		},
+	}
+	params := testparams.NewTestParams()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := outputResult(params.Printer, tt.args.outputFormat, tt.args.async, testClusterName, testNodepoolName, tt.args.cluster); (err != nil) != tt.wantErr {
+				t.Errorf("outputResult() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package describe
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+
+	"github.com/spf13/cobra"
+	ske "github.com/stackitcloud/stackit-sdk-go/services/ske/v2api"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/client"
+	skeUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/utils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/tables"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+)
+
+const (
+	nodepoolNameArg = "NODEPOOL_NAME"
+
+	clusterNameFlag = "cluster-name"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+	ClusterName  string
+	NodepoolName string
+}
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("describe %s", nodepoolNameArg),
+		Short: "Shows details of a nodepool of a SKE cluster",
+		Long:  "Shows details of a nodepool of a STACKIT Kubernetes Engine (SKE) cluster.",
+		Args:  args.SingleArg(nodepoolNameArg, nil),
+		Example: examples.Build(
+			examples.NewExample(
+				`Get details of the nodepool "my-pool" of the SKE cluster "my-cluster"`,
+				"$ stackit ske nodepool describe my-pool --cluster-name my-cluster"),
+			examples.NewExample(
+				`Get details of the nodepool "my-pool" of the SKE cluster "my-cluster" in JSON format`,
+				"$ stackit ske nodepool describe my-pool --cluster-name my-cluster --output-format json"),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			// Configure API client
+			apiClient, err := client.ConfigureClient(params.Printer, params.CliVersion)
+			if err != nil {
+				return err
+			}
+
+			// Call API
+			req := buildRequest(ctx, model, apiClient)
+			resp, err := req.Execute()
+			if err != nil {
+				return fmt.Errorf("get SKE cluster: %w", err)
+			}
+			nodepool, err := skeUtils.GetNodepool(resp, model.NodepoolName)
+			if err != nil {
+				return err
+			}
+
+			return outputResult(params.Printer, model.OutputFormat, nodepool)
+		},
+	}
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().String(clusterNameFlag, "", "Name of the cluster")
+
+	err := flags.MarkFlagsRequired(cmd, clusterNameFlag)
+	cobra.CheckErr(err)
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inputModel, error) {
+	nodepoolName := inputArgs[0]
+
+	globalFlags := globalflags.Parse(p, cmd)
+	if globalFlags.ProjectId == "" {
+		return nil, &errors.ProjectIdError{}
+	}
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		ClusterName:     flags.FlagToStringValue(p, cmd, clusterNameFlag),
+		NodepoolName:    nodepoolName,
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+func buildRequest(ctx context.Context, model *inputModel, apiClient *ske.APIClient) ske.ApiGetClusterRequest {
+	req := apiClient.DefaultAPI.GetCluster(ctx, model.ProjectId, model.Region, model.ClusterName)
+	return req
+}
+
+func outputResult(p *print.Printer, outputFormat string, nodepool *ske.Nodepool) error {
+	if nodepool == nil {
+		return fmt.Errorf("nodepool is nil")
+	}
+
+	return p.OutputResult(outputFormat, nodepool, func() error {
+		cri := ""
+		if nodepool.Cri != nil {
+			cri = utils.PtrString(nodepool.Cri.Name)
+		}
+
+		table := tables.NewTable()
+		table.AddRow("NAME", nodepool.Name)
+		table.AddSeparator()
+		table.AddRow("MACHINE TYPE", nodepool.Machine.Type)
+		table.AddSeparator()
+		table.AddRow("IMAGE", fmt.Sprintf("%s %s", nodepool.Machine.Image.Name, nodepool.Machine.Image.Version))
+		table.AddSeparator()
+		table.AddRow("CRI", cri)
+		table.AddSeparator()
+		table.AddRow("MINIMUM", nodepool.Minimum)
+		table.AddSeparator()
+		table.AddRow("MAXIMUM", nodepool.Maximum)
+		table.AddSeparator()
+		table.AddRow("MAX SURGE", utils.PtrString(nodepool.MaxSurge))
+		table.AddSeparator()
+		table.AddRow("MAX UNAVAILABLE", utils.PtrString(nodepool.MaxUnavailable))
+		table.AddSeparator()
+		table.AddRow("AVAILABILITY ZONES", strings.Join(nodepool.AvailabilityZones, "\n"))
+		table.AddSeparator()
+		table.AddRow("VOLUME", fmt.Sprintf("%s (%d GB)", utils.PtrString(nodepool.Volume.Type), nodepool.Volume.Size))
+		table.AddSeparator()
+		table.AddRow("LABELS", strings.Join(skeUtils.FormatLabels(nodepool.GetLabels()), "\n"))
+		table.AddSeparator()
+		table.AddRow("TAINTS", strings.Join(skeUtils.FormatTaints(nodepool.Taints), "\n"))
+		err := table.Display(p)
+		if err != nil {
+			return fmt.Errorf("render table: %w", err)
+		}
+
+		return nil
+	})
+}
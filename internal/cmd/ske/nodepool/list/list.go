@@ -0,0 +1,155 @@
+package list
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+
+	"github.com/spf13/cobra"
+	ske "github.com/stackitcloud/stackit-sdk-go/services/ske/v2api"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/client"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/tables"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+)
+
+const (
+	clusterNameFlag = "cluster-name"
+	limitFlag       = "limit"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+	ClusterName string
+	Limit       *int64
+}
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Lists all nodepools of a SKE cluster",
+		Long:  "Lists all nodepools of a STACKIT Kubernetes Engine (SKE) cluster.",
+		Args:  args.NoArgs,
+		Example: examples.Build(
+			examples.NewExample(
+				`List all nodepools of the SKE cluster "my-cluster"`,
+				"$ stackit ske nodepool list --cluster-name my-cluster"),
+			examples.NewExample(
+				`List all nodepools of the SKE cluster "my-cluster" in JSON format`,
+				"$ stackit ske nodepool list --cluster-name my-cluster --output-format json"),
+			examples.NewExample(
+				`List up to 10 nodepools of the SKE cluster "my-cluster"`,
+				"$ stackit ske nodepool list --cluster-name my-cluster --limit 10"),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			// Configure API client
+			apiClient, err := client.ConfigureClient(params.Printer, params.CliVersion)
+			if err != nil {
+				return err
+			}
+
+			// Call API
+			req := buildRequest(ctx, model, apiClient)
+			resp, err := req.Execute()
+			if err != nil {
+				return fmt.Errorf("get SKE cluster: %w", err)
+			}
+			nodepools := resp.Nodepools
+
+			// Truncate output
+			if model.Limit != nil && len(nodepools) > int(*model.Limit) {
+				nodepools = nodepools[:*model.Limit]
+			}
+
+			return outputResult(params.Printer, model.OutputFormat, model.ClusterName, nodepools)
+		},
+	}
+
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().String(clusterNameFlag, "", "Name of the cluster")
+	cmd.Flags().Int64(limitFlag, 0, "Maximum number of entries to list")
+
+	err := flags.MarkFlagsRequired(cmd, clusterNameFlag)
+	cobra.CheckErr(err)
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
+	globalFlags := globalflags.Parse(p, cmd)
+	if globalFlags.ProjectId == "" {
+		return nil, &errors.ProjectIdError{}
+	}
+
+	limit := flags.FlagToInt64Pointer(p, cmd, limitFlag)
+	if limit != nil && *limit < 1 {
+		return nil, &errors.FlagValidationError{
+			Flag:    limitFlag,
+			Details: "must be greater than 0",
+		}
+	}
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		ClusterName:     flags.FlagToStringValue(p, cmd, clusterNameFlag),
+		Limit:           limit,
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+func buildRequest(ctx context.Context, model *inputModel, apiClient *ske.APIClient) ske.ApiGetClusterRequest {
+	req := apiClient.DefaultAPI.GetCluster(ctx, model.ProjectId, model.Region, model.ClusterName)
+	return req
+}
+
+func outputResult(p *print.Printer, outputFormat, clusterName string, nodepools []ske.Nodepool) error {
+	return p.OutputResult(outputFormat, nodepools, func() error {
+		if len(nodepools) == 0 {
+			p.Outputf("No nodepools found for cluster %q\n", clusterName)
+			return nil
+		}
+
+		table := tables.NewTable()
+		table.SetHeader("NAME", "MACHINE TYPE", "IMAGE", "MIN", "MAX", "ZONES", "CRI")
+		for i := range nodepools {
+			nodepool := nodepools[i]
+			cri := ""
+			if nodepool.Cri != nil {
+				cri = utils.PtrString(nodepool.Cri.Name)
+			}
+			table.AddRow(
+				nodepool.Name,
+				nodepool.Machine.Type,
+				fmt.Sprintf("%s %s", nodepool.Machine.Image.Name, nodepool.Machine.Image.Version),
+				nodepool.Minimum,
+				nodepool.Maximum,
+				strings.Join(nodepool.AvailabilityZones, ", "),
+				cri,
+			)
+		}
+		err := table.Display(p)
+		if err != nil {
+			return fmt.Errorf("render table: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,36 @@
+package nodepool
+
+import (
+	"github.com/stackitcloud/stackit-cli/internal/cmd/ske/nodepool/add"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/ske/nodepool/describe"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/ske/nodepool/list"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/ske/nodepool/remove"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/ske/nodepool/scale"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/ske/nodepool/update"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nodepool",
+		Short: "Provides functionality for SKE nodepools",
+		Long:  "Provides functionality for STACKIT Kubernetes Engine (SKE) nodepools.",
+		Args:  args.NoArgs,
+		Run:   utils.CmdHelp,
+	}
+	addSubcommands(cmd, params)
+	return cmd
+}
+
+func addSubcommands(cmd *cobra.Command, params *types.CmdParams) {
+	cmd.AddCommand(list.NewCmd(params))
+	cmd.AddCommand(describe.NewCmd(params))
+	cmd.AddCommand(add.NewCmd(params))
+	cmd.AddCommand(remove.NewCmd(params))
+	cmd.AddCommand(scale.NewCmd(params))
+	cmd.AddCommand(update.NewCmd(params))
+}
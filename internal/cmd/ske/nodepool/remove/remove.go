@@ -0,0 +1,149 @@
+package remove
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+
+	"github.com/spf13/cobra"
+	ske "github.com/stackitcloud/stackit-sdk-go/services/ske/v2api"
+	wait "github.com/stackitcloud/stackit-sdk-go/services/ske/v2api/wait"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/client"
+	skeUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/utils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/spinner"
+)
+
+const (
+	nodepoolNameArg = "NODEPOOL_NAME"
+
+	clusterNameFlag = "cluster-name"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+	ClusterName  string
+	NodepoolName string
+}
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("remove %s", nodepoolNameArg),
+		Short: "Removes a nodepool from a SKE cluster",
+		Long: fmt.Sprintf("%s\n%s",
+			"Removes a nodepool from a STACKIT Kubernetes Engine (SKE) cluster.",
+			"The nodes of the nodepool are drained and deleted. A cluster needs at least one nodepool, so its last nodepool can't be removed.",
+		),
+		Args: args.SingleArg(nodepoolNameArg, nil),
+		Example: examples.Build(
+			examples.NewExample(
+				`Remove the nodepool "my-pool" from the SKE cluster "my-cluster"`,
+				"$ stackit ske nodepool remove my-pool --cluster-name my-cluster"),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			// Configure API client
+			apiClient, err := client.ConfigureClient(params.Printer, params.CliVersion)
+			if err != nil {
+				return err
+			}
+
+			prompt := fmt.Sprintf("Are you sure you want to remove nodepool %q from cluster %q?", model.NodepoolName, model.ClusterName)
+			err = params.Printer.PromptForConfirmation(prompt)
+			if err != nil {
+				return err
+			}
+
+			cluster, err := apiClient.DefaultAPI.GetCluster(ctx, model.ProjectId, model.Region, model.ClusterName).Execute()
+			if err != nil {
+				return fmt.Errorf("get SKE cluster: %w", err)
+			}
+			payload := skeUtils.PayloadFromCluster(cluster)
+			err = skeUtils.ApplyClusterSpec(payload, &skeUtils.ClusterSpecOptions{RemoveNodepools: []string{model.NodepoolName}}, nil)
+			if err != nil {
+				return err
+			}
+
+			// Call API
+			req := buildRequest(ctx, model, apiClient, payload)
+			resp, err := req.Execute()
+			if err != nil {
+				return fmt.Errorf("update SKE cluster: %w", err)
+			}
+
+			// Wait for async operation, if async mode not enabled
+			if !model.Async {
+				err := spinner.Run(params.Printer, "Removing nodepool", func() error {
+					_, err = wait.UpdateClusterWaitHandler(ctx, apiClient.DefaultAPI, model.ProjectId, model.Region, model.ClusterName).WaitWithContext(ctx)
+					return err
+				})
+				if err != nil {
+					return fmt.Errorf("wait for SKE cluster update: %w", err)
+				}
+			}
+
+			return outputResult(params.Printer, model.OutputFormat, model.Async, model.ClusterName, model.NodepoolName, resp)
+		},
+	}
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().String(clusterNameFlag, "", "Name of the cluster")
+
+	err := flags.MarkFlagsRequired(cmd, clusterNameFlag)
+	cobra.CheckErr(err)
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inputModel, error) {
+	nodepoolName := inputArgs[0]
+
+	globalFlags := globalflags.Parse(p, cmd)
+	if globalFlags.ProjectId == "" {
+		return nil, &errors.ProjectIdError{}
+	}
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		ClusterName:     flags.FlagToStringValue(p, cmd, clusterNameFlag),
+		NodepoolName:    nodepoolName,
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+func buildRequest(ctx context.Context, model *inputModel, apiClient *ske.APIClient, payload *ske.CreateOrUpdateClusterPayload) ske.ApiCreateOrUpdateClusterRequest {
+	req := apiClient.DefaultAPI.CreateOrUpdateCluster(ctx, model.ProjectId, model.Region, model.ClusterName)
+
+	req = req.CreateOrUpdateClusterPayload(*payload)
+	return req
+}
+
+func outputResult(p *print.Printer, outputFormat string, async bool, clusterName, nodepoolName string, cluster *ske.Cluster) error {
+	if cluster == nil {
+		return fmt.Errorf("cluster is nil")
+	}
+
+	return p.OutputResult(outputFormat, cluster, func() error {
+		operationState := "Removed"
+		if async {
+			operationState = "Triggered removal of"
+		}
+		p.Info("%s nodepool %q from cluster %q\n", operationState, nodepoolName, clusterName)
+		return nil
+	})
+}
@@ -0,0 +1,182 @@
+package scale
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+
+	"github.com/spf13/cobra"
+	ske "github.com/stackitcloud/stackit-sdk-go/services/ske/v2api"
+	wait "github.com/stackitcloud/stackit-sdk-go/services/ske/v2api/wait"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/client"
+	skeUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/utils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/spinner"
+)
+
+const (
+	nodepoolNameArg = "NODEPOOL_NAME"
+
+	clusterNameFlag = "cluster-name"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+	ClusterName string
+	Nodepool    *skeUtils.NodepoolOptions
+}
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("scale %s", nodepoolNameArg),
+		Short: "Scales a nodepool of a SKE cluster",
+		Long: fmt.Sprintf("%s\n%s",
+			"Scales a nodepool of a STACKIT Kubernetes Engine (SKE) cluster by changing its minimum and maximum number of nodes.",
+			"The number of nodes is adjusted by the cluster autoscaler within these bounds.",
+		),
+		Args: args.SingleArg(nodepoolNameArg, nil),
+		Example: examples.Build(
+			examples.NewExample(
+				`Scale the nodepool "my-pool" of the SKE cluster "my-cluster" to 2 to 5 nodes`,
+				"$ stackit ske nodepool scale my-pool --cluster-name my-cluster --min 2 --max 5"),
+			examples.NewExample(
+				`Increase the maximum number of nodes of the nodepool "my-pool" of the SKE cluster "my-cluster" to 10`,
+				"$ stackit ske nodepool scale my-pool --cluster-name my-cluster --max 10"),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			// Configure API client
+			apiClient, err := client.ConfigureClient(params.Printer, params.CliVersion)
+			if err != nil {
+				return err
+			}
+
+			prompt := fmt.Sprintf("Are you sure you want to scale nodepool %q of cluster %q?", model.Nodepool.Name, model.ClusterName)
+			err = params.Printer.PromptForConfirmation(prompt)
+			if err != nil {
+				return err
+			}
+
+			cluster, err := apiClient.DefaultAPI.GetCluster(ctx, model.ProjectId, model.Region, model.ClusterName).Execute()
+			if err != nil {
+				return fmt.Errorf("get SKE cluster: %w", err)
+			}
+			_, err = skeUtils.GetNodepool(cluster, model.Nodepool.Name)
+			if err != nil {
+				return err
+			}
+			payload := skeUtils.PayloadFromCluster(cluster)
+			err = skeUtils.ApplyClusterSpec(payload, &skeUtils.ClusterSpecOptions{Nodepools: []skeUtils.NodepoolOptions{*model.Nodepool}}, nil)
+			if err != nil {
+				return err
+			}
+
+			// Call API
+			req := buildRequest(ctx, model, apiClient, payload)
+			resp, err := req.Execute()
+			if err != nil {
+				return fmt.Errorf("update SKE cluster: %w", err)
+			}
+
+			// Wait for async operation, if async mode not enabled
+			if !model.Async {
+				err := spinner.Run(params.Printer, "Scaling nodepool", func() error {
+					_, err = wait.UpdateClusterWaitHandler(ctx, apiClient.DefaultAPI, model.ProjectId, model.Region, model.ClusterName).WaitWithContext(ctx)
+					return err
+				})
+				if err != nil {
+					return fmt.Errorf("wait for SKE cluster update: %w", err)
+				}
+			}
+
+			return outputResult(params.Printer, model.OutputFormat, model.Async, model.ClusterName, model.Nodepool.Name, resp)
+		},
+	}
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().String(clusterNameFlag, "", "Name of the cluster")
+	cmd.Flags().Int32(skeUtils.NodepoolMinimumFlag, 0, "Minimum number of nodes")
+	cmd.Flags().Int32(skeUtils.NodepoolMaximumFlag, 0, "Maximum number of nodes")
+
+	err := flags.MarkFlagsRequired(cmd, clusterNameFlag)
+	cobra.CheckErr(err)
+	cmd.MarkFlagsOneRequired(skeUtils.NodepoolMinimumFlag, skeUtils.NodepoolMaximumFlag)
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inputModel, error) {
+	nodepoolName := inputArgs[0]
+
+	globalFlags := globalflags.Parse(p, cmd)
+	if globalFlags.ProjectId == "" {
+		return nil, &errors.ProjectIdError{}
+	}
+
+	nodepool := &skeUtils.NodepoolOptions{
+		Name:    nodepoolName,
+		Minimum: flags.FlagToInt32Pointer(p, cmd, skeUtils.NodepoolMinimumFlag),
+		Maximum: flags.FlagToInt32Pointer(p, cmd, skeUtils.NodepoolMaximumFlag),
+	}
+	for flag, value := range map[string]*int32{
+		skeUtils.NodepoolMinimumFlag: nodepool.Minimum,
+		skeUtils.NodepoolMaximumFlag: nodepool.Maximum,
+	} {
+		if value != nil && *value < 0 {
+			return nil, &errors.FlagValidationError{
+				Flag:    flag,
+				Details: "must not be negative",
+			}
+		}
+	}
+	if nodepool.Minimum != nil && nodepool.Maximum != nil && *nodepool.Minimum > *nodepool.Maximum {
+		return nil, &errors.FlagValidationError{
+			Flag:    skeUtils.NodepoolMinimumFlag,
+			Details: fmt.Sprintf("must not be larger than --%s", skeUtils.NodepoolMaximumFlag),
+		}
+	}
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		ClusterName:     flags.FlagToStringValue(p, cmd, clusterNameFlag),
+		Nodepool:        nodepool,
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+func buildRequest(ctx context.Context, model *inputModel, apiClient *ske.APIClient, payload *ske.CreateOrUpdateClusterPayload) ske.ApiCreateOrUpdateClusterRequest {
+	req := apiClient.DefaultAPI.CreateOrUpdateCluster(ctx, model.ProjectId, model.Region, model.ClusterName)
+
+	req = req.CreateOrUpdateClusterPayload(*payload)
+	return req
+}
+
+func outputResult(p *print.Printer, outputFormat string, async bool, clusterName, nodepoolName string, cluster *ske.Cluster) error {
+	if cluster == nil {
+		return fmt.Errorf("cluster is nil")
+	}
+
+	return p.OutputResult(outputFormat, cluster, func() error {
+		operationState := "Scaled"
+		if async {
+			operationState = "Triggered scaling of"
+		}
+		p.Info("%s nodepool %q of cluster %q\n", operationState, nodepoolName, clusterName)
+		return nil
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/cmd/ske/disable"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/ske/enable"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/ske/kubeconfig"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/ske/nodepool"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/ske/options"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
@@ -34,5 +35,6 @@ func addSubcommands(cmd *cobra.Command, params *types.CmdParams) {
 	cmd.AddCommand(disable.NewCmd(params))
 	cmd.AddCommand(enable.NewCmd(params))
 	cmd.AddCommand(kubeconfig.NewCmd(params))
+	cmd.AddCommand(nodepool.NewCmd(params))
 	cmd.AddCommand(options.NewCmd(params))
 }
@@ -0,0 +1,167 @@
+package list
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	sqlserverflex "github.com/stackitcloud/stackit-sdk-go/services/sqlserverflex/v3api"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/services/sqlserverflex/client"
+	sqlserverflexUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/sqlserverflex/utils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/tables"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+)
+
+const (
+	instanceIdFlag = "instance-id"
+	limitFlag      = "limit"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+
+	InstanceId string
+	Limit      *int64
+}
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Lists all backups which are available for a SQLServer Flex instance",
+		Long:  "Lists all backups which are available for a SQLServer Flex instance.",
+		Example: examples.Build(
+			examples.NewExample(
+				`List all backups of instance with ID "xxx"`,
+				"$ stackit sqlserverflex backup list --instance-id xxx"),
+			examples.NewExample(
+				`List all backups of instance with ID "xxx" in JSON format`,
+				"$ stackit sqlserverflex backup list --instance-id xxx --output-format json"),
+			examples.NewExample(
+				`List up to 10 backups of instance with ID "xxx"`,
+				"$ stackit sqlserverflex backup list --instance-id xxx --limit 10"),
+		),
+		Args: args.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			// Configure API client
+			apiClient, err := client.ConfigureClient(params.Printer, params.CliVersion)
+			if err != nil {
+				return err
+			}
+
+			instanceLabel, err := sqlserverflexUtils.GetInstanceName(ctx, apiClient.DefaultAPI, model.ProjectId, model.InstanceId, model.Region)
+			if err != nil {
+				params.Printer.Debug(print.ErrorLevel, "get instance name: %v", err)
+				instanceLabel = model.InstanceId
+			}
+
+			// Call API
+			req := buildRequest(ctx, model, apiClient)
+			resp, err := req.Execute()
+			if err != nil {
+				return fmt.Errorf("get backups for SQLServer Flex instance %q: %w", instanceLabel, err)
+			}
+
+			return outputResult(params.Printer, model.OutputFormat, instanceLabel, resp.Backups)
+		},
+	}
+
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().Var(flags.UUIDFlag(), instanceIdFlag, "Instance ID")
+	cmd.Flags().Int64(limitFlag, 0, "Maximum number of entries to list")
+
+	err := flags.MarkFlagsRequired(cmd, instanceIdFlag)
+	cobra.CheckErr(err)
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
+	globalFlags := globalflags.Parse(p, cmd)
+	if globalFlags.ProjectId == "" {
+		return nil, &errors.ProjectIdError{}
+	}
+
+	limit := flags.FlagToInt64Pointer(p, cmd, limitFlag)
+	if limit != nil && *limit < 1 {
+		return nil, &errors.FlagValidationError{
+			Flag:    limitFlag,
+			Details: "must be greater than 0",
+		}
+	}
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		InstanceId:      flags.FlagToStringValue(p, cmd, instanceIdFlag),
+		Limit:           limit,
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+func buildRequest(ctx context.Context, model *inputModel, apiClient *sqlserverflex.APIClient) sqlserverflex.ApiListBackupsRequest {
+	req := apiClient.DefaultAPI.ListBackups(ctx, model.ProjectId, model.Region, model.InstanceId)
+
+	if model.Limit != nil {
+		req = req.Size(*model.Limit)
+	} else {
+		// default page size is only 10
+		req = req.Size(100)
+	}
+
+	return req
+}
+
+func outputResult(p *print.Printer, outputFormat, instanceLabel string, backups []sqlserverflex.ListBackup) error {
+	return p.OutputResult(outputFormat, backups, func() error {
+		if len(backups) == 0 {
+			p.Outputf("No backups found for instance %q\n", instanceLabel)
+			return nil
+		}
+
+		table := tables.NewTable()
+		table.SetHeader("ID", "NAME", "COMPLETED AT", "RETAINED UNTIL", "BACKUP SIZE")
+
+		for _, backup := range backups {
+			backupCompletionTime, err := time.Parse(time.RFC3339, backup.CompletionTime)
+			if err != nil {
+				return fmt.Errorf("parse backup completion time: %w", err)
+			}
+
+			backupRetainedUntilTime, err := time.Parse(time.RFC3339, backup.RetainedUntil)
+			if err != nil {
+				return fmt.Errorf("parse backup retained until time: %w", err)
+			}
+
+			table.AddRow(
+				backup.Id,
+				backup.Name,
+				backupCompletionTime,
+				backupRetainedUntilTime,
+				backup.Size,
+			)
+		}
+		err := table.Display(p)
+		if err != nil {
+			return fmt.Errorf("render table: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,311 @@
+package restore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	sqlserverflex "github.com/stackitcloud/stackit-sdk-go/services/sqlserverflex/v3api"
+	"github.com/stackitcloud/stackit-sdk-go/services/sqlserverflex/v3api/wait"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	cliErr "github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/services/sqlserverflex/client"
+	sqlserverflexUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/sqlserverflex/utils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/spinner"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+)
+
+const (
+	instanceIdFlag         = "instance-id"
+	backupIdFlag           = "backup-id"
+	timestampFlag          = "timestamp"
+	databaseNameFlag       = "database-name"
+	targetInstanceIdFlag   = "target-instance-id"
+	targetDatabaseNameFlag = "target-database-name"
+	newInstanceNameFlag    = "new-instance-name"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+
+	InstanceId         string
+	BackupId           *int64
+	Timestamp          *time.Time
+	DatabaseName       *string
+	TargetInstanceId   *string
+	TargetDatabaseName *string
+	NewInstanceName    *string
+}
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Restores a SQLServer Flex instance or database from a backup",
+		Long: fmt.Sprintf("%s\n%s\n%s\n%s",
+			"Restores a SQLServer Flex instance or database to a point in time.",
+			`The point in time can be specified by a timestamp or by a backup ID, in which case the completion time of the backup is used.`,
+			`If the "--new-instance-name" flag is set, the instance is restored into a new instance.`,
+			`Otherwise, the database set with the "--database-name" flag is restored into an existing instance, which is the same instance if the "--target-instance-id" flag is not set.`,
+		),
+		Args: args.NoArgs,
+		Example: examples.Build(
+			examples.NewExample(
+				`Restore the database "my-database" of a SQLServer Flex instance with ID "xxx" to timestamp "2024-05-14T14:31:48Z" into the database "my-database-restored" of the same instance`,
+				`$ stackit sqlserverflex backup restore --instance-id xxx --timestamp 2024-05-14T14:31:48Z --database-name my-database --target-database-name my-database-restored`),
+			examples.NewExample(
+				`Restore the database "my-database" of a SQLServer Flex instance with ID "xxx" from backup with ID "42" into the SQLServer Flex instance with ID "yyy"`,
+				`$ stackit sqlserverflex backup restore --instance-id xxx --backup-id 42 --database-name my-database --target-instance-id yyy`),
+			examples.NewExample(
+				`Restore a SQLServer Flex instance with ID "xxx" to timestamp "2024-05-14T14:31:48Z" into a new instance with name "my-restored-instance"`,
+				`$ stackit sqlserverflex backup restore --instance-id xxx --timestamp 2024-05-14T14:31:48Z --new-instance-name my-restored-instance`),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			// Configure API client
+			apiClient, err := client.ConfigureClient(params.Printer, params.CliVersion)
+			if err != nil {
+				return err
+			}
+
+			instanceLabel, err := sqlserverflexUtils.GetInstanceName(ctx, apiClient.DefaultAPI, model.ProjectId, model.InstanceId, model.Region)
+			if err != nil {
+				params.Printer.Debug(print.ErrorLevel, "get instance name: %v", err)
+				instanceLabel = model.InstanceId
+			}
+
+			restoreTime, err := getRestoreTime(ctx, model, apiClient)
+			if err != nil {
+				return err
+			}
+
+			// If a new instance name is provided, restore the instance into a new instance
+			if model.NewInstanceName != nil {
+				prompt := fmt.Sprintf("Are you sure you want to restore instance %q to %s into the new instance %q?", instanceLabel, restoreTime.Format(time.RFC3339), *model.NewInstanceName)
+				err = params.Printer.PromptForConfirmation(prompt)
+				if err != nil {
+					return err
+				}
+
+				resp, err := buildCloneRequest(ctx, model, apiClient, restoreTime).Execute()
+				if err != nil {
+					return fmt.Errorf("restore SQLServer Flex instance into new instance: %w", err)
+				}
+
+				// Wait for async operation, if async mode not enabled
+				if !model.Async {
+					err := spinner.Run(params.Printer, "Restoring instance", func() error {
+						_, err = wait.CreateInstanceWaitHandler(ctx, apiClient.DefaultAPI, model.ProjectId, model.Region, resp.Id).WaitWithContext(ctx)
+						return err
+					})
+					if err != nil {
+						return fmt.Errorf("wait for SQLServer Flex instance restoration: %w", err)
+					}
+				}
+
+				return outputCloneResult(params.Printer, model.OutputFormat, model.Async, instanceLabel, resp)
+			}
+
+			// Else, restore the database into an existing instance
+			targetInstanceId := getTargetInstanceId(model)
+			targetInstanceLabel := instanceLabel
+			if targetInstanceId != model.InstanceId {
+				targetInstanceLabel, err = sqlserverflexUtils.GetInstanceName(ctx, apiClient.DefaultAPI, model.ProjectId, targetInstanceId, model.Region)
+				if err != nil {
+					params.Printer.Debug(print.ErrorLevel, "get target instance name: %v", err)
+					targetInstanceLabel = targetInstanceId
+				}
+			}
+			targetDatabaseName := getTargetDatabaseName(model)
+
+			prompt := fmt.Sprintf("Are you sure you want to restore database %q of instance %q to %s into database %q of instance %q? (An existing database with this name will be overwritten)", *model.DatabaseName, instanceLabel, restoreTime.Format(time.RFC3339), targetDatabaseName, targetInstanceLabel)
+			err = params.Printer.PromptForConfirmation(prompt)
+			if err != nil {
+				return err
+			}
+
+			err = buildRestoreRequest(ctx, model, apiClient, restoreTime).Execute()
+			if err != nil {
+				return fmt.Errorf("restore SQLServer Flex database: %w", err)
+			}
+
+			// Wait for async operation, if async mode not enabled
+			if !model.Async {
+				err := spinner.Run(params.Printer, "Restoring database", func() error {
+					_, err = sqlserverflexUtils.RestoreDatabaseWaitHandler(ctx, apiClient.DefaultAPI, model.ProjectId, model.Region, targetInstanceId, targetDatabaseName).WaitWithContext(ctx)
+					return err
+				})
+				if err != nil {
+					return fmt.Errorf("wait for SQLServer Flex database restoration: %w", err)
+				}
+			}
+
+			operationState := "Restored"
+			if model.Async {
+				operationState = "Triggered restore of"
+			}
+			params.Printer.Outputf("%s database %q of instance %q into database %q of instance %q\n", operationState, *model.DatabaseName, instanceLabel, targetDatabaseName, targetInstanceLabel)
+			return nil
+		},
+	}
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().Var(flags.UUIDFlag(), instanceIdFlag, "ID of the instance the backup was taken from")
+	cmd.Flags().Int64(backupIdFlag, 0, "ID of the backup to restore")
+	cmd.Flags().String(timestampFlag, "", "Timestamp to restore to, in a date-time with the RFC3339 layout format, e.g. 2024-01-01T00:00:00Z")
+	cmd.Flags().String(databaseNameFlag, "", "Name of the database to restore into an existing instance")
+	cmd.Flags().Var(flags.UUIDFlag(), targetInstanceIdFlag, "ID of the existing instance to restore the database into. If not set, the database is restored into the instance the backup was taken from")
+	cmd.Flags().String(targetDatabaseNameFlag, "", `Name of the restored database. If not set, the name set with the "--database-name" flag is used`)
+	cmd.Flags().String(newInstanceNameFlag, "", "Name of the new instance to restore the instance into")
+
+	cmd.MarkFlagsMutuallyExclusive(newInstanceNameFlag, targetInstanceIdFlag)
+	cmd.MarkFlagsMutuallyExclusive(newInstanceNameFlag, targetDatabaseNameFlag)
+	err := flags.MarkFlagsRequired(cmd, instanceIdFlag)
+	cobra.CheckErr(err)
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
+	globalFlags := globalflags.Parse(p, cmd)
+	if globalFlags.ProjectId == "" {
+		return nil, &cliErr.ProjectIdError{}
+	}
+
+	backupId := flags.FlagToInt64Pointer(p, cmd, backupIdFlag)
+	timestamp, err := flags.FlagToDateTimePointer(p, cmd, timestampFlag, time.RFC3339)
+	if err != nil {
+		return nil, &cliErr.FlagValidationError{
+			Flag:    timestampFlag,
+			Details: err.Error(),
+		}
+	}
+	if (backupId == nil) == (timestamp == nil) {
+		return nil, &cliErr.RequiredMutuallyExclusiveFlagsError{
+			Flags: []string{backupIdFlag, timestampFlag},
+		}
+	}
+	if timestamp != nil && timestamp.After(time.Now()) {
+		return nil, &cliErr.FlagValidationError{
+			Flag:    timestampFlag,
+			Details: "must not be in the future",
+		}
+	}
+
+	databaseName := flags.FlagToStringPointer(p, cmd, databaseNameFlag)
+	newInstanceName := flags.FlagToStringPointer(p, cmd, newInstanceNameFlag)
+	if (databaseName == nil) == (newInstanceName == nil) {
+		return nil, &cliErr.RequiredMutuallyExclusiveFlagsError{
+			Flags: []string{databaseNameFlag, newInstanceNameFlag},
+		}
+	}
+
+	model := inputModel{
+		GlobalFlagModel:    globalFlags,
+		InstanceId:         flags.FlagToStringValue(p, cmd, instanceIdFlag),
+		BackupId:           backupId,
+		Timestamp:          timestamp,
+		DatabaseName:       databaseName,
+		TargetInstanceId:   flags.FlagToStringPointer(p, cmd, targetInstanceIdFlag),
+		TargetDatabaseName: flags.FlagToStringPointer(p, cmd, targetDatabaseNameFlag),
+		NewInstanceName:    newInstanceName,
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+// getRestoreTime returns the point in time to restore to.
+// If a backup ID is set, the completion time of the backup is used.
+func getRestoreTime(ctx context.Context, model *inputModel, apiClient *sqlserverflex.APIClient) (time.Time, error) {
+	if model.Timestamp != nil {
+		return *model.Timestamp, nil
+	}
+	if model.BackupId == nil {
+		return time.Time{}, fmt.Errorf("neither timestamp nor backup ID set")
+	}
+
+	backup, err := apiClient.DefaultAPI.GetBackup(ctx, model.ProjectId, model.Region, model.InstanceId, *model.BackupId).Execute()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("get SQLServer Flex backup: %w", err)
+	}
+	completionTime, err := time.Parse(time.RFC3339, backup.CompletionTime)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse backup completion time: %w", err)
+	}
+	return completionTime, nil
+}
+
+func getTargetInstanceId(model *inputModel) string {
+	if model.TargetInstanceId != nil {
+		return *model.TargetInstanceId
+	}
+	return model.InstanceId
+}
+
+func getTargetDatabaseName(model *inputModel) string {
+	if model.TargetDatabaseName != nil {
+		return *model.TargetDatabaseName
+	}
+	if model.DatabaseName != nil {
+		return *model.DatabaseName
+	}
+	return ""
+}
+
+func buildRestoreRequest(ctx context.Context, model *inputModel, apiClient *sqlserverflex.APIClient, restoreTime time.Time) sqlserverflex.ApiRestoreDatabaseRequest {
+	var sourceDatabaseName string
+	if model.DatabaseName != nil {
+		sourceDatabaseName = *model.DatabaseName
+	}
+
+	req := apiClient.DefaultAPI.RestoreDatabase(ctx, model.ProjectId, model.Region, getTargetInstanceId(model))
+	req = req.RestoreDatabasePayload(sqlserverflex.RestoreDatabasePayload{
+		DatabaseName:       getTargetDatabaseName(model),
+		SourceInstanceId:   model.InstanceId,
+		SourceDatabaseName: sourceDatabaseName,
+		PointInTime:        restoreTime,
+	})
+	return req
+}
+
+func buildCloneRequest(ctx context.Context, model *inputModel, apiClient *sqlserverflex.APIClient, restoreTime time.Time) sqlserverflex.ApiCloneInstanceRequest {
+	var name string
+	if model.NewInstanceName != nil {
+		name = *model.NewInstanceName
+	}
+
+	req := apiClient.DefaultAPI.CloneInstance(ctx, model.ProjectId, model.Region, model.InstanceId)
+	req = req.CloneInstancePayload(sqlserverflex.CloneInstancePayload{
+		Name:        name,
+		PointInTime: restoreTime,
+	})
+	return req
+}
+
+func outputCloneResult(p *print.Printer, outputFormat string, async bool, instanceLabel string, resp *sqlserverflex.CloneInstanceResponse) error {
+	return p.OutputResult(outputFormat, resp, func() error {
+		if resp == nil {
+			return fmt.Errorf("response not set")
+		}
+
+		operationState := "Restored"
+		if async {
+			operationState = "Triggered restore of"
+		}
+		p.Outputf("%s instance %q into a new instance. New instance ID: %s\n", operationState, instanceLabel, resp.Id)
+		return nil
+	})
+}
@@ -0,0 +1,367 @@
+package restore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	sqlserverflex "github.com/stackitcloud/stackit-sdk-go/services/sqlserverflex/v3api"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testparams"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testutils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+)
+
+type testCtxKey struct{}
+
+const (
+	testRegion          = "eu01"
+	testTimestamp       = "2024-05-14T14:31:48Z"
+	testDatabaseName    = "my-database"
+	testNewInstanceName = "my-restored-instance"
+)
+
+var testCtx = context.WithValue(context.Background(), testCtxKey{}, "foo")
+var testClient = &sqlserverflex.APIClient{DefaultAPI: &sqlserverflex.DefaultAPIService{}}
+
+var testProjectId = uuid.NewString()
+var testInstanceId = uuid.NewString()
+var testTargetInstanceId = uuid.NewString()
+var testTime, _ = time.Parse(time.RFC3339, testTimestamp)
+
+func fixtureFlagValues(mods ...func(flagValues map[string]string)) map[string]string {
+	flagValues := map[string]string{
+		globalflags.ProjectIdFlag: testProjectId,
+		globalflags.RegionFlag:    testRegion,
+		instanceIdFlag:            testInstanceId,
+		timestampFlag:             testTimestamp,
+		databaseNameFlag:          testDatabaseName,
+	}
+	for _, mod := range mods {
+		mod(flagValues)
+	}
+	return flagValues
+}
+
+func fixtureInputModel(mods ...func(model *inputModel)) *inputModel {
+	model := &inputModel{
+		GlobalFlagModel: &globalflags.GlobalFlagModel{
+			ProjectId: testProjectId,
+			Region:    testRegion,
+			Verbosity: globalflags.VerbosityDefault,
+		},
+		InstanceId:   testInstanceId,
+		Timestamp:    utils.Ptr(testTime),
+		DatabaseName: utils.Ptr(testDatabaseName),
+	}
+	for _, mod := range mods {
+		mod(model)
+	}
+	return model
+}
+
+func fixtureRestoreRequest(mods ...func(request *sqlserverflex.ApiRestoreDatabaseRequest)) sqlserverflex.ApiRestoreDatabaseRequest {
+	request := testClient.DefaultAPI.RestoreDatabase(testCtx, testProjectId, testRegion, testInstanceId)
+	request = request.RestoreDatabasePayload(sqlserverflex.RestoreDatabasePayload{
+		DatabaseName:       testDatabaseName,
+		SourceInstanceId:   testInstanceId,
+		SourceDatabaseName: testDatabaseName,
+		PointInTime:        testTime,
+	})
+	for _, mod := range mods {
+		mod(&request)
+	}
+	return request
+}
+
+func fixtureCloneRequest(mods ...func(request *sqlserverflex.ApiCloneInstanceRequest)) sqlserverflex.ApiCloneInstanceRequest {
+	request := testClient.DefaultAPI.CloneInstance(testCtx, testProjectId, testRegion, testInstanceId)
+	request = request.CloneInstancePayload(sqlserverflex.CloneInstancePayload{
+		Name:        testNewInstanceName,
+		PointInTime: testTime,
+	})
+	for _, mod := range mods {
+		mod(&request)
+	}
+	return request
+}
+
+func TestParseInput(t *testing.T) {
+	tests := []struct {
+		description   string
+		argValues     []string
+		flagValues    map[string]string
+		isValid       bool
+		expectedModel *inputModel
+	}{
+		{
+			description:   "base",
+			flagValues:    fixtureFlagValues(),
+			isValid:       true,
+			expectedModel: fixtureInputModel(),
+		},
+		{
+			description: "backup id instead of timestamp",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, timestampFlag)
+				flagValues[backupIdFlag] = "42"
+			}),
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.Timestamp = nil
+				model.BackupId = utils.Ptr(int64(42))
+			}),
+		},
+		{
+			description: "target instance and database",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[targetInstanceIdFlag] = testTargetInstanceId
+				flagValues[targetDatabaseNameFlag] = "my-database-restored"
+			}),
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.TargetInstanceId = utils.Ptr(testTargetInstanceId)
+				model.TargetDatabaseName = utils.Ptr("my-database-restored")
+			}),
+		},
+		{
+			description: "new instance",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, databaseNameFlag)
+				flagValues[newInstanceNameFlag] = testNewInstanceName
+			}),
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.DatabaseName = nil
+				model.NewInstanceName = utils.Ptr(testNewInstanceName)
+			}),
+		},
+		{
+			description: "no values",
+			flagValues:  map[string]string{},
+			isValid:     false,
+		},
+		{
+			description: "project id missing",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, globalflags.ProjectIdFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "project id invalid",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[globalflags.ProjectIdFlag] = "invalid-uuid"
+			}),
+			isValid: false,
+		},
+		{
+			description: "instance id missing",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, instanceIdFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "instance id invalid",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[instanceIdFlag] = "invalid-uuid"
+			}),
+			isValid: false,
+		},
+		{
+			description: "backup id and timestamp missing",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, timestampFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "backup id and timestamp both set",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[backupIdFlag] = "42"
+			}),
+			isValid: false,
+		},
+		{
+			description: "backup id invalid",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, timestampFlag)
+				flagValues[backupIdFlag] = "invalid"
+			}),
+			isValid: false,
+		},
+		{
+			description: "timestamp invalid",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[timestampFlag] = "2024-05-14 14:31:48"
+			}),
+			isValid: false,
+		},
+		{
+			description: "timestamp in the future",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[timestampFlag] = time.Now().Add(time.Hour).Format(time.RFC3339)
+			}),
+			isValid: false,
+		},
+		{
+			description: "database name and new instance name missing",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, databaseNameFlag)
+			}),
+			isValid: false,
+		},
+		{
+			description: "database name and new instance name both set",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[newInstanceNameFlag] = testNewInstanceName
+			}),
+			isValid: false,
+		},
+		{
+			description: "new instance name with target instance id",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, databaseNameFlag)
+				flagValues[newInstanceNameFlag] = testNewInstanceName
+				flagValues[targetInstanceIdFlag] = testTargetInstanceId
+			}),
+			isValid: false,
+		},
+		{
+			description: "new instance name with target database name",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				delete(flagValues, databaseNameFlag)
+				flagValues[newInstanceNameFlag] = testNewInstanceName
+				flagValues[targetDatabaseNameFlag] = "my-database-restored"
+			}),
+			isValid: false,
+		},
+		{
+			description: "target instance id invalid",
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[targetInstanceIdFlag] = "invalid-uuid"
+			}),
+			isValid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			testutils.TestParseInput(t, NewCmd, parseInput, tt.expectedModel, tt.argValues, tt.flagValues, tt.isValid)
+		})
+	}
+}
+
+func TestBuildRestoreRequest(t *testing.T) {
+	tests := []struct {
+		description     string
+		model           *inputModel
+		expectedRequest sqlserverflex.ApiRestoreDatabaseRequest
+	}{
+		{
+			description:     "base",
+			model:           fixtureInputModel(),
+			expectedRequest: fixtureRestoreRequest(),
+		},
+		{
+			description: "target instance and database",
+			model: fixtureInputModel(func(model *inputModel) {
+				model.TargetInstanceId = utils.Ptr(testTargetInstanceId)
+				model.TargetDatabaseName = utils.Ptr("my-database-restored")
+			}),
+			expectedRequest: testClient.DefaultAPI.RestoreDatabase(testCtx, testProjectId, testRegion, testTargetInstanceId).
+				RestoreDatabasePayload(sqlserverflex.RestoreDatabasePayload{
+					DatabaseName:       "my-database-restored",
+					SourceInstanceId:   testInstanceId,
+					SourceDatabaseName: testDatabaseName,
+					PointInTime:        testTime,
+				}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			request := buildRestoreRequest(testCtx, tt.model, testClient, testTime)
+
+			diff := cmp.Diff(request, tt.expectedRequest,
+				cmp.AllowUnexported(tt.expectedRequest),
+				cmpopts.EquateComparable(testCtx, sqlserverflex.DefaultAPIService{}),
+			)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildCloneRequest(t *testing.T) {
+	tests := []struct {
+		description     string
+		model           *inputModel
+		expectedRequest sqlserverflex.ApiCloneInstanceRequest
+	}{
+		{
+			description: "base",
+			model: fixtureInputModel(func(model *inputModel) {
+				model.DatabaseName = nil
+				model.NewInstanceName = utils.Ptr(testNewInstanceName)
+			}),
+			expectedRequest: fixtureCloneRequest(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			request := buildCloneRequest(testCtx, tt.model, testClient, testTime)
+
+			diff := cmp.Diff(request, tt.expectedRequest,
+				cmp.AllowUnexported(tt.expectedRequest),
+				cmpopts.EquateComparable(testCtx, sqlserverflex.DefaultAPIService{}),
+			)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestOutputCloneResult(t *testing.T) {
+	tests := []struct {
+		description string
+		async       bool
+		resp        *sqlserverflex.CloneInstanceResponse
+		isValid     bool
+	}{
+		{
+			description: "response not set",
+			isValid:     false,
+		},
+		{
+			description: "base",
+			resp:        &sqlserverflex.CloneInstanceResponse{Id: "id"},
+			isValid:     true,
+		},
+		{
+			description: "async",
+			async:       true,
+			resp:        &sqlserverflex.CloneInstanceResponse{Id: "id"},
+			isValid:     true,
+		},
+	}
+
+	params := testparams.NewTestParams()
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := outputCloneResult(params.Printer, print.PrettyOutputFormat, tt.async, "label", tt.resp)
+			if (err != nil) == tt.isValid {
+				t.Fatalf("outputCloneResult() error = %v, isValid %v", err, tt.isValid)
+			}
+		})
+	}
+}
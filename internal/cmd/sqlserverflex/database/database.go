@@ -4,7 +4,9 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/cmd/sqlserverflex/database/create"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/sqlserverflex/database/delete"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/sqlserverflex/database/describe"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/sqlserverflex/database/grant"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/sqlserverflex/database/list"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/sqlserverflex/database/revoke"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
@@ -28,5 +30,7 @@ func addSubcommands(cmd *cobra.Command, params *types.CmdParams) {
 	cmd.AddCommand(create.NewCmd(params))
 	cmd.AddCommand(delete.NewCmd(params))
 	cmd.AddCommand(describe.NewCmd(params))
+	cmd.AddCommand(grant.NewCmd(params))
 	cmd.AddCommand(list.NewCmd(params))
+	cmd.AddCommand(revoke.NewCmd(params))
 }
@@ -3,6 +3,8 @@ package describe
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
 
@@ -16,27 +18,42 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/services/sqlserverflex/client"
+	sqlserverflexUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/sqlserverflex/utils"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/tables"
 )
 
 const (
 	databaseNameArg = "DATABASE_NAME"
 
-	instanceIdFlag = "instance-id"
+	instanceIdFlag      = "instance-id"
+	showPermissionsFlag = "show-permissions"
 )
 
 type inputModel struct {
 	*globalflags.GlobalFlagModel
-	DatabaseName string
-	InstanceId   string
+	DatabaseName    string
+	InstanceId      string
+	ShowPermissions bool
+	QueryOptions    *sqlserverflexUtils.QueryOptions
+}
+
+// permissionMatrix holds the role memberships of the users in the databases of the instance
+type permissionMatrix struct {
+	Users       []string
+	Databases   []string
+	Permissions []sqlserverflexUtils.Permission
 }
 
 func NewCmd(params *types.CmdParams) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   fmt.Sprintf("describe %s", databaseNameArg),
 		Short: "Shows details of an SQLServer Flex database",
-		Long:  "Shows details of an SQLServer Flex database.",
-		Args:  args.SingleArg(databaseNameArg, nil),
+		Long: fmt.Sprintf("%s\n%s\n%s",
+			"Shows details of an SQLServer Flex database.",
+			fmt.Sprintf(`With the --%s flag, the database roles of all users in all databases of the instance are shown as a matrix. They are read by running T-SQL with "sqlcmd", which needs to be installed, as the user set with the --%s flag.`, showPermissionsFlag, sqlserverflexUtils.LoginUserIdFlag),
+			"In that case, the public IP address of the caller is temporarily added to the ACL of the instance. It is detected using api.ipify.org, unless it is set with the --ip flag.",
+		),
+		Args: args.SingleArg(databaseNameArg, nil),
 		Example: examples.Build(
 			examples.NewExample(
 				`Get details of an SQLServer Flex database with name "my-database" of instance with ID "xxx"`,
@@ -44,6 +61,9 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 			examples.NewExample(
 				`Get details of an SQLServer Flex database with name "my-database" of instance with ID "xxx" in JSON format`,
 				"$ stackit sqlserverflex database describe my-database --instance-id xxx --output-format json"),
+			examples.NewExample(
+				`Get details of an SQLServer Flex database with name "my-database" of instance with ID "xxx" and the database roles of all users, read as user with ID "1"`,
+				"$ stackit sqlserverflex database describe my-database --instance-id xxx --show-permissions --login-user-id 1"),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.Background()
@@ -64,7 +84,15 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 				return fmt.Errorf("read SQLServer Flex database: %w", err)
 			}
 
-			return outputResult(params.Printer, model.OutputFormat, resp)
+			var matrix *permissionMatrix
+			if model.ShowPermissions {
+				matrix, err = getPermissions(ctx, params.Printer, model, apiClient.DefaultAPI)
+				if err != nil {
+					return err
+				}
+			}
+
+			return outputResult(params.Printer, model.OutputFormat, resp, matrix)
 		},
 	}
 	configureFlags(cmd)
@@ -73,6 +101,8 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 
 func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().Var(flags.UUIDFlag(), instanceIdFlag, "SQLServer Flex instance ID")
+	cmd.Flags().Bool(showPermissionsFlag, false, "If set, the database roles of all users in all databases of the instance are shown")
+	sqlserverflexUtils.ConfigureQueryFlags(cmd)
 
 	err := flags.MarkFlagsRequired(cmd, instanceIdFlag)
 	cobra.CheckErr(err)
@@ -90,6 +120,21 @@ func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inpu
 		GlobalFlagModel: globalFlags,
 		DatabaseName:    databaseName,
 		InstanceId:      flags.FlagToStringValue(p, cmd, instanceIdFlag),
+		ShowPermissions: flags.FlagToBoolValue(p, cmd, showPermissionsFlag),
+	}
+
+	if model.ShowPermissions {
+		queryOptions, err := sqlserverflexUtils.ParseQueryFlags(p, cmd)
+		if err != nil {
+			return nil, err
+		}
+		if queryOptions.LoginUserId == 0 {
+			return nil, &errors.FlagValidationError{
+				Flag:    sqlserverflexUtils.LoginUserIdFlag,
+				Details: fmt.Sprintf("must be set together with --%s", showPermissionsFlag),
+			}
+		}
+		model.QueryOptions = queryOptions
 	}
 
 	p.DebugInputModel(model)
@@ -101,8 +146,98 @@ func buildRequest(ctx context.Context, model *inputModel, apiClient *sqlserverfl
 	return req
 }
 
-func outputResult(p *print.Printer, outputFormat string, resp *sqlserverflex.GetDatabaseResponse) error {
-	return p.OutputResult(outputFormat, resp, func() error {
+// getPermissions reads the database roles of the users in all databases of the instance
+func getPermissions(ctx context.Context, p *print.Printer, model *inputModel, apiClient sqlserverflex.DefaultAPI) (*permissionMatrix, error) {
+	databasesResp, err := apiClient.ListDatabases(ctx, model.ProjectId, model.Region, model.InstanceId).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get SQLServer Flex databases: %w", err)
+	}
+	usersResp, err := apiClient.ListUsers(ctx, model.ProjectId, model.Region, model.InstanceId).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get SQLServer Flex users: %w", err)
+	}
+
+	// The described database comes first
+	databases := []string{model.DatabaseName}
+	for _, database := range databasesResp.GetDatabases() {
+		if database.Name != model.DatabaseName {
+			databases = append(databases, database.Name)
+		}
+	}
+	users := []string{}
+	for _, user := range usersResp.Users {
+		users = append(users, user.Username)
+	}
+
+	output, err := sqlserverflexUtils.RunQuery(ctx, p, apiClient, model.ProjectId, model.Region, model.InstanceId, model.QueryOptions, sqlserverflexUtils.PermissionsQuery(databases))
+	if err != nil {
+		return nil, fmt.Errorf("read permissions of SQLServer Flex databases: %w", err)
+	}
+	permissions, err := sqlserverflexUtils.ParsePermissions(output)
+	if err != nil {
+		return nil, err
+	}
+	return buildPermissionMatrix(users, databases, permissions), nil
+}
+
+// buildPermissionMatrix adds the database users which aren't users of the API, e.g. the owner, to the users
+func buildPermissionMatrix(users, databases []string, permissions []sqlserverflexUtils.Permission) *permissionMatrix {
+	users = slices.Clone(users)
+	for _, permission := range permissions {
+		if !slices.Contains(users, permission.User) {
+			users = append(users, permission.User)
+		}
+	}
+	slices.Sort(users)
+	return &permissionMatrix{
+		Users:       users,
+		Databases:   databases,
+		Permissions: permissions,
+	}
+}
+
+func buildPermissionsTable(matrix *permissionMatrix) tables.Table {
+	table := tables.NewTable()
+	table.SetTitle("Permissions")
+	header := []any{"USER"}
+	for _, database := range matrix.Databases {
+		header = append(header, strings.ToUpper(database))
+	}
+	table.SetHeader(header...)
+	for _, user := range matrix.Users {
+		row := []any{user}
+		for _, database := range matrix.Databases {
+			roles := []string{}
+			for _, permission := range matrix.Permissions {
+				if permission.User == user && permission.Database == database {
+					roles = append(roles, permission.Role)
+				}
+			}
+			cell := "-"
+			if len(roles) > 0 {
+				cell = strings.Join(roles, "\n")
+			}
+			row = append(row, cell)
+		}
+		table.AddRow(row...)
+		table.AddSeparator()
+	}
+	return table
+}
+
+func outputResult(p *print.Printer, outputFormat string, resp *sqlserverflex.GetDatabaseResponse, matrix *permissionMatrix) error {
+	var output any = resp
+	if matrix != nil {
+		output = struct {
+			Database    *sqlserverflex.GetDatabaseResponse `json:"database"`
+			Permissions []sqlserverflexUtils.Permission    `json:"permissions"`
+		}{
+			Database:    resp,
+			Permissions: matrix.Permissions,
+		}
+	}
+
+	return p.OutputResult(outputFormat, output, func() error {
 		if resp == nil {
 			return fmt.Errorf("database response is empty")
 		}
@@ -117,11 +252,18 @@ func outputResult(p *print.Printer, outputFormat string, resp *sqlserverflex.Get
 		table.AddRow("OWNER", resp.Owner)
 		table.AddSeparator()
 		table.AddRow("COLLATION", resp.CollationName)
-		err := table.Display(p)
-		if err != nil {
-			return fmt.Errorf("render table: %w", err)
+		if matrix == nil {
+			err := table.Display(p)
+			if err != nil {
+				return fmt.Errorf("render table: %w", err)
+			}
+			return nil
 		}
 
+		err := tables.DisplayTables(p, []tables.Table{table, buildPermissionsTable(matrix)})
+		if err != nil {
+			return fmt.Errorf("render tables: %w", err)
+		}
 		return nil
 	})
 }
@@ -11,7 +11,9 @@ import (
 
 	"github.com/stackitcloud/stackit-cli/internal/pkg/testparams"
 
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dbconnect"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	sqlserverflexUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/sqlserverflex/utils"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/testutils"
 )
 
@@ -152,6 +154,41 @@ func TestParseInput(t *testing.T) {
 			}),
 			isValid: false,
 		},
+		{
+			description: "show permissions",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[showPermissionsFlag] = "true"
+				flagValues[sqlserverflexUtils.LoginUserIdFlag] = "1"
+				flagValues[dbconnect.IPFlag] = "1.2.3.4"
+			}),
+			isValid: true,
+			expectedModel: fixtureInputModel(func(model *inputModel) {
+				model.ShowPermissions = true
+				model.QueryOptions = &sqlserverflexUtils.QueryOptions{
+					LoginUserId: 1,
+					Connect:     &dbconnect.Options{IP: "1.2.3.4"},
+				}
+			}),
+		},
+		{
+			description: "show permissions without login user",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[showPermissionsFlag] = "true"
+			}),
+			isValid: false,
+		},
+		{
+			description: "show permissions with invalid ip",
+			argValues:   fixtureArgValues(),
+			flagValues: fixtureFlagValues(func(flagValues map[string]string) {
+				flagValues[showPermissionsFlag] = "true"
+				flagValues[sqlserverflexUtils.LoginUserIdFlag] = "1"
+				flagValues[dbconnect.IPFlag] = "invalid-ip"
+			}),
+			isValid: false,
+		},
 		{
 			description: "database name invalid",
 			argValues:   []string{""},
@@ -195,10 +232,30 @@ func TestBuildRequest(t *testing.T) {
 	}
 }
 
+func TestBuildPermissionMatrix(t *testing.T) {
+	permissions := []sqlserverflexUtils.Permission{
+		{Database: "db1", User: "owner", Role: "db_owner"},
+		{Database: "db1", User: "user", Role: "db_datareader"},
+	}
+
+	matrix := buildPermissionMatrix([]string{"user", "admin"}, []string{"db1", "db2"}, permissions)
+
+	expected := &permissionMatrix{
+		Users:       []string{"admin", "owner", "user"},
+		Databases:   []string{"db1", "db2"},
+		Permissions: permissions,
+	}
+	diff := cmp.Diff(matrix, expected)
+	if diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+}
+
 func TestOutputResult(t *testing.T) {
 	type args struct {
 		outputFormat string
 		resp         *sqlserverflex.GetDatabaseResponse
+		matrix       *permissionMatrix
 	}
 	tests := []struct {
 		name    string
@@ -210,12 +267,43 @@ func TestOutputResult(t *testing.T) {
 			args:    args{},
 			wantErr: true,
 		},
+		{
+			name: "set response",
+			args: args{
+				resp: &sqlserverflex.GetDatabaseResponse{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "set response and permissions",
+			args: args{
+				resp: &sqlserverflex.GetDatabaseResponse{},
+				matrix: &permissionMatrix{
+					Users:     []string{"user", "other"},
+					Databases: []string{"db1", "db2"},
+					Permissions: []sqlserverflexUtils.Permission{
+						{Database: "db1", User: "user", Role: "db_datareader"},
+						{Database: "db1", User: "user", Role: "db_datawriter"},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "set response and permissions, JSON",
+			args: args{
+				outputFormat: "json",
+				resp:         &sqlserverflex.GetDatabaseResponse{},
+				matrix:       &permissionMatrix{},
+			},
+			wantErr: false,
+		},
 	}
 	params := testparams.NewTestParams()
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if err := outputResult(params.Printer, tt.args.outputFormat, tt.args.resp); (err != nil) != tt.wantErr {
+			if err := outputResult(params.Printer, tt.args.outputFormat, tt.args.resp, tt.args.matrix); (err != nil) != tt.wantErr {
 				t.Errorf("outputResult() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
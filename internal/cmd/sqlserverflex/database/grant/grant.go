@@ -0,0 +1,171 @@
+package grant
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	sqlserverflex "github.com/stackitcloud/stackit-sdk-go/services/sqlserverflex/v3api"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/services/sqlserverflex/client"
+	sqlserverflexUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/sqlserverflex/utils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+)
+
+const (
+	databaseNameArg = "DATABASE_NAME"
+
+	instanceIdFlag = "instance-id"
+	userFlag       = "user"
+	roleFlag       = "role"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+
+	DatabaseName string
+	InstanceId   string
+	User         string
+	Role         string
+	QueryOptions *sqlserverflexUtils.QueryOptions
+}
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("grant %s", databaseNameArg),
+		Short: "Grants a role on a SQLServer Flex database to a user",
+		Long: fmt.Sprintf("%s\n%s\n%s",
+			"Grants a role on a SQLServer Flex database to a user. The user is added to the database, if it isn't yet.",
+			`The role is granted by running T-SQL with "sqlcmd", which needs to be installed, as the user set with the --login-user-id flag.`,
+			"The public IP address of the caller is temporarily added to the ACL of the instance. It is detected using api.ipify.org, unless it is set with the --ip flag.",
+		),
+		Args: args.SingleArg(databaseNameArg, nil),
+		Example: examples.Build(
+			examples.NewExample(
+				`Grant the role "db_datareader" on the SQLServer Flex database "my-database" of instance with ID "xxx" to user "my-user", running the query as user with ID "1"`,
+				"$ stackit sqlserverflex database grant my-database --instance-id xxx --user my-user --role db_datareader --login-user-id 1"),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			// Configure API client
+			apiClient, err := client.ConfigureClient(params.Printer, params.CliVersion)
+			if err != nil {
+				return err
+			}
+
+			// Check the user up front, the query would only fail for a missing login
+			users, err := apiClient.DefaultAPI.ListUsers(ctx, model.ProjectId, model.Region, model.InstanceId).Execute()
+			if err != nil {
+				return fmt.Errorf("get SQLServer Flex users: %w", err)
+			}
+			if users == nil {
+				return fmt.Errorf("get SQLServer Flex users: empty response")
+			}
+			err = validateUser(model.User, users.Users)
+			if err != nil {
+				return err
+			}
+
+			prompt := fmt.Sprintf("Are you sure you want to grant role %q on database %q to user %q?", model.Role, model.DatabaseName, model.User)
+			err = params.Printer.PromptForConfirmation(prompt)
+			if err != nil {
+				return err
+			}
+
+			query := sqlserverflexUtils.GrantRoleQuery(model.DatabaseName, model.User, model.Role)
+			_, err = sqlserverflexUtils.RunQuery(ctx, params.Printer, apiClient.DefaultAPI, model.ProjectId, model.Region, model.InstanceId, model.QueryOptions, query)
+			if err != nil {
+				return fmt.Errorf("grant role on SQLServer Flex database: %w", err)
+			}
+
+			return outputResult(params.Printer, model.OutputFormat, &sqlserverflexUtils.Permission{
+				Database: model.DatabaseName,
+				User:     model.User,
+				Role:     model.Role,
+			})
+		},
+	}
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().Var(flags.UUIDFlag(), instanceIdFlag, "SQLServer Flex instance ID")
+	cmd.Flags().String(userFlag, "", "Username of the user to grant the role to")
+	cmd.Flags().String(roleFlag, "", fmt.Sprintf("Database role to grant, one of %q", sqlserverflexUtils.DatabaseRoles))
+	sqlserverflexUtils.ConfigureQueryFlags(cmd)
+
+	err := flags.MarkFlagsRequired(cmd, instanceIdFlag, userFlag, roleFlag, sqlserverflexUtils.LoginUserIdFlag)
+	cobra.CheckErr(err)
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inputModel, error) {
+	databaseName := inputArgs[0]
+
+	globalFlags := globalflags.Parse(p, cmd)
+	if globalFlags.ProjectId == "" {
+		return nil, &errors.ProjectIdError{}
+	}
+
+	role := flags.FlagToStringValue(p, cmd, roleFlag)
+	if err := sqlserverflexUtils.ValidateDatabaseRole(role); err != nil {
+		return nil, &errors.FlagValidationError{
+			Flag:    roleFlag,
+			Details: err.Error(),
+		}
+	}
+
+	queryOptions, err := sqlserverflexUtils.ParseQueryFlags(p, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		DatabaseName:    databaseName,
+		InstanceId:      flags.FlagToStringValue(p, cmd, instanceIdFlag),
+		User:            flags.FlagToStringValue(p, cmd, userFlag),
+		Role:            role,
+		QueryOptions:    queryOptions,
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+func validateUser(username string, users []sqlserverflex.ListUser) error {
+	usernames := []string{}
+	for _, user := range users {
+		usernames = append(usernames, user.Username)
+	}
+	if !slices.Contains(usernames, username) {
+		return &errors.FlagValidationError{
+			Flag:    userFlag,
+			Details: fmt.Sprintf("user %q doesn't exist on the instance, the users are: %s", username, strings.Join(usernames, ", ")),
+		}
+	}
+	return nil
+}
+
+func outputResult(p *print.Printer, outputFormat string, permission *sqlserverflexUtils.Permission) error {
+	return p.OutputResult(outputFormat, permission, func() error {
+		if permission == nil {
+			return fmt.Errorf("permission is empty")
+		}
+		p.Outputf("Granted role %q on database %q to user %q\n", permission.Role, permission.Database, permission.User)
+		return nil
+	})
+}
@@ -0,0 +1,111 @@
+package compare
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	sqlserverflex "github.com/stackitcloud/stackit-sdk-go/services/sqlserverflex/v3api"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	cliErr "github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flexsizing"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/services/sqlserverflex/client"
+	sqlserverflexUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/sqlserverflex/utils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+)
+
+const service = sqlserverflexUtils.ServiceCmd
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+
+	Query *flexsizing.Query
+}
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "compare",
+		Short: "Compares SQLServer Flex flavors",
+		Long: fmt.Sprintf("%s\n%s\n%s",
+			"Compares SQLServer Flex flavors, joining the flavors with their storage ranges, storage classes and the supported versions in one table.",
+			"If resources are given, only the flavors providing them are shown and the smallest one is marked.",
+			fmt.Sprintf("If --%s is set, the planned flavor, storage size and storage class are validated instead.", flexsizing.FlavorIdFlag),
+		),
+		Args: args.NoArgs,
+		Example: examples.Build(
+			examples.NewExample(
+				`Compare all SQLServer Flex flavors`,
+				"$ stackit sqlserverflex flavor compare"),
+			examples.NewExample(
+				`Compare SQLServer Flex flavors with at least 4 CPUs and 16 GB RAM, supporting 100 GB storage`,
+				"$ stackit sqlserverflex flavor compare --cpu 4 --ram 16 --storage 100"),
+			examples.NewExample(
+				`Validate a planned SQLServer Flex instance with flavor ID "xxx", 100 GB storage and storage class "premium-perf2-stackit"`,
+				"$ stackit sqlserverflex flavor compare --flavor-id xxx --storage 100 --storage-class premium-perf2-stackit"),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			// Configure API client
+			apiClient, err := client.ConfigureClient(params.Printer, params.CliVersion)
+			if err != nil {
+				return err
+			}
+
+			// Call API
+			options, err := loadOptions(ctx, model, apiClient.DefaultAPI)
+			if err != nil {
+				return err
+			}
+
+			options, err = flexsizing.Compare(service, options, model.Query)
+			if err != nil {
+				return err
+			}
+
+			return flexsizing.OutputResult(params.Printer, model.OutputFormat, options)
+		},
+	}
+	flexsizing.ConfigureFlags(cmd)
+	return cmd
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel, error) {
+	globalFlags := globalflags.Parse(p, cmd)
+	if globalFlags.ProjectId == "" {
+		return nil, &cliErr.ProjectIdError{}
+	}
+
+	query, err := flexsizing.ParseFlags(p, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		Query:           query,
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+func loadOptions(ctx context.Context, model *inputModel, apiClient sqlserverflex.DefaultAPI) ([]flexsizing.Option, error) {
+	flavors, err := sqlserverflexUtils.ListAllFlavors(ctx, apiClient, model.ProjectId, model.Region)
+	if err != nil {
+		return nil, fmt.Errorf("get SQLServer Flex flavors: %w", err)
+	}
+	versions, err := apiClient.ListVersions(ctx, model.ProjectId, model.Region).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get SQLServer Flex versions: %w", err)
+	}
+	return sqlserverflexUtils.SizingOptions(flavors, versions.Versions), nil
+}
@@ -0,0 +1,136 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dbconnect"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/services/sqlserverflex/client"
+	sqlserverflexUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/sqlserverflex/utils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+)
+
+const (
+	instanceIdArg = "INSTANCE_ID"
+
+	userIdFlag = "user-id"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+
+	InstanceId     string
+	UserId         int64
+	ConnectOptions *dbconnect.Options
+}
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("connect %s", instanceIdArg),
+		Short: "Connects to a SQLServer Flex instance",
+		Long: fmt.Sprintf("%s\n%s\n%s",
+			"Connects to a SQLServer Flex instance by temporarily adding the public IP address of the caller to the ACL of the instance.",
+			`If "sqlcmd" is installed, it is launched with the connection details of the user and the ACL entry is removed when it exits. Otherwise the connection URI is printed and the ACL entry is removed on Ctrl-C.`,
+			"The public IP address is detected using api.ipify.org, unless it is set with the --ip flag.",
+		),
+		Args: args.SingleArg(instanceIdArg, utils.ValidateUUID),
+		Example: examples.Build(
+			examples.NewExample(
+				`Connect to a SQLServer Flex instance with ID "xxx" as user with ID "1"`,
+				"$ stackit sqlserverflex instance connect xxx --user-id 1"),
+			examples.NewExample(
+				`Allow IP address "1.2.3.4" to connect to a SQLServer Flex instance with ID "xxx" and print the connection URI of user with ID "1"`,
+				"$ stackit sqlserverflex instance connect xxx --user-id 1 --ip 1.2.3.4 --no-launch"),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			// Configure API client
+			apiClient, err := client.ConfigureClient(params.Printer, params.CliVersion)
+			if err != nil {
+				return err
+			}
+
+			instanceLabel, err := sqlserverflexUtils.GetInstanceName(ctx, apiClient.DefaultAPI, model.ProjectId, model.InstanceId, model.Region)
+			if err != nil {
+				params.Printer.Debug(print.ErrorLevel, "get instance name: %v", err)
+				instanceLabel = model.InstanceId
+			}
+
+			user, err := apiClient.DefaultAPI.GetUser(ctx, model.ProjectId, model.Region, model.InstanceId, model.UserId).Execute()
+			if err != nil {
+				return fmt.Errorf("get SQLServer Flex user: %w", err)
+			}
+
+			ip := model.ConnectOptions.IP
+			if ip == "" {
+				ip, err = dbconnect.GetPublicIP(ctx)
+				if err != nil {
+					return fmt.Errorf("detect public IP address, set it with the --%s flag instead: %w", dbconnect.IPFlag, err)
+				}
+			}
+			aclEntry := dbconnect.ACLEntry(ip)
+
+			prompt := fmt.Sprintf("Are you sure you want to temporarily add %q to the ACL of instance %q?", aclEntry, instanceLabel)
+			err = params.Printer.PromptForConfirmation(prompt)
+			if err != nil {
+				return err
+			}
+
+			acl := &sqlserverflexUtils.InstanceACL{
+				APIClient:  apiClient.DefaultAPI,
+				ProjectId:  model.ProjectId,
+				Region:     model.Region,
+				InstanceId: model.InstanceId,
+			}
+			return dbconnect.Run(ctx, params.Printer, acl, sqlserverflexUtils.UserTarget(user), aclEntry, model.ConnectOptions)
+		},
+	}
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().Int64(userIdFlag, 0, "ID of the user to connect as")
+	dbconnect.ConfigureFlags(cmd)
+
+	err := flags.MarkFlagsRequired(cmd, userIdFlag)
+	cobra.CheckErr(err)
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inputModel, error) {
+	instanceId := inputArgs[0]
+
+	globalFlags := globalflags.Parse(p, cmd)
+	if globalFlags.ProjectId == "" {
+		return nil, &errors.ProjectIdError{}
+	}
+
+	connectOptions, err := dbconnect.ParseFlags(p, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		InstanceId:      instanceId,
+		UserId:          flags.FlagWithDefaultToInt64Value(p, cmd, userIdFlag),
+		ConnectOptions:  connectOptions,
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
@@ -1,6 +1,7 @@
 package instance
 
 import (
+	"github.com/stackitcloud/stackit-cli/internal/cmd/sqlserverflex/instance/connect"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/sqlserverflex/instance/create"
 	dbcollation "github.com/stackitcloud/stackit-cli/internal/cmd/sqlserverflex/instance/db-collation"
 	dbcompatibility "github.com/stackitcloud/stackit-cli/internal/cmd/sqlserverflex/instance/db-compatibility"
@@ -29,6 +30,7 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 }
 
 func addSubcommands(cmd *cobra.Command, params *types.CmdParams) {
+	cmd.AddCommand(connect.NewCmd(params))
 	cmd.AddCommand(create.NewCmd(params))
 	cmd.AddCommand(delete.NewCmd(params))
 	cmd.AddCommand(describe.NewCmd(params))
@@ -1,6 +1,7 @@
 package sqlserverflex
 
 import (
+	"github.com/stackitcloud/stackit-cli/internal/cmd/sqlserverflex/backup"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/sqlserverflex/database"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/sqlserverflex/flavor"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/sqlserverflex/instance"
@@ -31,4 +32,5 @@ func addSubcommands(cmd *cobra.Command, params *types.CmdParams) {
 	cmd.AddCommand(user.NewCmd(params))
 	cmd.AddCommand(version.NewCmd(params))
 	cmd.AddCommand(flavor.NewCmd(params))
+	cmd.AddCommand(backup.NewCmd(params))
 }
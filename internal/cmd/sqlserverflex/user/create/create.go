@@ -3,6 +3,7 @@ package create
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
@@ -12,6 +13,7 @@ import (
 	"github.com/stackitcloud/stackit-sdk-go/services/sqlserverflex/v3api/wait"
 
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dbcredentials"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
@@ -31,9 +33,10 @@ const (
 type inputModel struct {
 	*globalflags.GlobalFlagModel
 
-	InstanceId string
-	Username   string
-	Roles      []string
+	InstanceId        string
+	Username          string
+	Roles             []string
+	CredentialsFormat *dbcredentials.Options
 }
 
 func NewCmd(params *types.CmdParams) *cobra.Command {
@@ -56,6 +59,9 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 			examples.NewExample(
 				`Create a SQLServer Flex user for instance with ID "xxx", specifying multiple roles`,
 				`$ stackit sqlserverflex user create --instance-id xxx --username johndoe --roles "##STACKIT_LoginManager##,##STACKIT_DatabaseManager##"`),
+			examples.NewExample(
+				`Create a SQLServer Flex user for instance with ID "xxx" and output its JDBC connection string`,
+				`$ stackit sqlserverflex user create --instance-id xxx --username johndoe --roles "##STACKIT_DatabaseManager##" --format-as jdbc`),
 		),
 		Args: args.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -101,6 +107,10 @@ func NewCmd(params *types.CmdParams) *cobra.Command {
 				}
 			}
 
+			if model.CredentialsFormat.FormatAs != "" {
+				return dbcredentials.Output(params.Printer, model.CredentialsFormat, buildCredentials(resp), "")
+			}
+
 			return outputResult(params.Printer, model, instanceLabel, resp)
 		},
 	}
@@ -113,6 +123,7 @@ func configureFlags(cmd *cobra.Command) {
 	cmd.Flags().Var(flags.UUIDFlag(), instanceIdFlag, "ID of the instance")
 	cmd.Flags().String(usernameFlag, "", "Username of the user")
 	cmd.Flags().StringSlice(rolesFlag, []string{}, "Roles of the user")
+	dbcredentials.ConfigureFlags(cmd)
 
 	err := flags.MarkFlagsRequired(cmd, instanceIdFlag, usernameFlag, rolesFlag)
 	cobra.CheckErr(err)
@@ -124,11 +135,17 @@ func parseInput(p *print.Printer, cmd *cobra.Command, _ []string) (*inputModel,
 		return nil, &errors.ProjectIdError{}
 	}
 
+	credentialsFormat, err := dbcredentials.ParseFlags(p, cmd)
+	if err != nil {
+		return nil, err
+	}
+
 	model := inputModel{
-		GlobalFlagModel: globalFlags,
-		InstanceId:      flags.FlagToStringValue(p, cmd, instanceIdFlag),
-		Username:        flags.FlagToStringValue(p, cmd, usernameFlag),
-		Roles:           flags.FlagToStringSliceValue(p, cmd, rolesFlag),
+		GlobalFlagModel:   globalFlags,
+		InstanceId:        flags.FlagToStringValue(p, cmd, instanceIdFlag),
+		Username:          flags.FlagToStringValue(p, cmd, usernameFlag),
+		Roles:             flags.FlagToStringSliceValue(p, cmd, rolesFlag),
+		CredentialsFormat: credentialsFormat,
 	}
 
 	p.DebugInputModel(model)
@@ -172,3 +189,17 @@ func outputResult(p *print.Printer, model *inputModel, instanceLabel string, use
 		return nil
 	})
 }
+
+func buildCredentials(user *sqlserverflex.CreateUserResponse) *dbcredentials.Credentials {
+	credentials := &dbcredentials.Credentials{
+		Engine:   dbcredentials.EngineSQLServer,
+		Host:     user.Host,
+		Username: user.Username,
+		Password: user.Password,
+		URI:      user.Uri,
+	}
+	if user.Port != 0 {
+		credentials.Port = strconv.FormatInt(int64(user.Port), 10)
+	}
+	return credentials
+}
@@ -0,0 +1,230 @@
+package rotate
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	sqlserverflex "github.com/stackitcloud/stackit-sdk-go/services/sqlserverflex/v3api"
+	"github.com/stackitcloud/stackit-sdk-go/services/sqlserverflex/v3api/wait"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dbcredentials"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/examples"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/globalflags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/services/sqlserverflex/client"
+	sqlserverflexUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/sqlserverflex/utils"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/spinner"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
+)
+
+const (
+	userIdArg = "USER_ID"
+
+	instanceIdFlag = "instance-id"
+	usernameFlag   = "username"
+)
+
+type inputModel struct {
+	*globalflags.GlobalFlagModel
+
+	InstanceId    string
+	UserId        int64
+	Username      string
+	RotateOptions *dbcredentials.RotateOptions
+}
+
+func NewCmd(params *types.CmdParams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("rotate %s", userIdArg),
+		Short: "Rotates the credentials of a SQLServer Flex user",
+		Long: fmt.Sprintf("%s\n%s\n%s",
+			"Rotates the credentials of a SQLServer Flex user by replacing it with a new user with the same roles.",
+			"The credentials of the new user are output and can optionally be written to a Secrets Manager secret.",
+			`The old user is deleted after the grace period set with the "--grace-period" flag.`,
+		),
+		Example: examples.Build(
+			examples.NewExample(
+				`Rotate the credentials of a SQLServer Flex user with ID "xxx" of instance with ID "yyy"`,
+				"$ stackit sqlserverflex user rotate xxx --instance-id yyy"),
+			examples.NewExample(
+				`Rotate the credentials of a SQLServer Flex user with ID "xxx" of instance with ID "yyy", name the new user "app" and delete the old user after 10 minutes`,
+				"$ stackit sqlserverflex user rotate xxx --instance-id yyy --username app --grace-period 10m"),
+			examples.NewExample(
+				`Rotate the credentials of a SQLServer Flex user with ID "xxx" of instance with ID "yyy" and write them to the secret "sqlserver/app" of Secrets Manager instance with ID "zzz"`,
+				"$ stackit sqlserverflex user rotate xxx --instance-id yyy --secrets-manager-instance-id zzz --secrets-manager-secret-path sqlserver/app --secrets-manager-username my-user"),
+		),
+		Args: args.SingleArg(userIdArg, nil),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			model, err := parseInput(params.Printer, cmd, args)
+			if err != nil {
+				return err
+			}
+
+			// Configure API client
+			apiClient, err := client.ConfigureClient(params.Printer, params.CliVersion)
+			if err != nil {
+				return err
+			}
+
+			instanceLabel, err := sqlserverflexUtils.GetInstanceName(ctx, apiClient.DefaultAPI, model.ProjectId, model.InstanceId, model.Region)
+			if err != nil {
+				params.Printer.Debug(print.ErrorLevel, "get instance name: %v", err)
+				instanceLabel = model.InstanceId
+			}
+
+			// The new user gets the roles of the old user
+			oldUser, err := buildGetUserRequest(ctx, model, apiClient).Execute()
+			if err != nil {
+				return fmt.Errorf("get SQLServer Flex user: %w", err)
+			}
+			if oldUser == nil {
+				return fmt.Errorf("get SQLServer Flex user: empty response")
+			}
+
+			username := model.Username
+			if username == "" {
+				username = dbcredentials.RotatedUsername(oldUser.Username, "_", time.Now())
+			}
+
+			return dbcredentials.Rotate(ctx, params.Printer, model.OutputFormat, model.Region, model.RotateOptions, &dbcredentials.Rotation{
+				Service:       "SQLServer Flex",
+				Kind:          "user",
+				Label:         fmt.Sprintf("%q", oldUser.Username),
+				InstanceLabel: instanceLabel,
+				Create: func(ctx context.Context) (*dbcredentials.NewCredentials, error) {
+					resp, err := buildCreateRequest(ctx, model, apiClient, username, oldUser.Roles).Execute()
+					if err != nil {
+						return nil, err
+					}
+					if resp == nil {
+						return nil, fmt.Errorf("empty response")
+					}
+
+					// Wait for async operation, if async mode not enabled
+					if !model.Async {
+						err = spinner.Run(params.Printer, "Creating SQLServer Flex user", func() error {
+							_, err = wait.CreateUserWaitHandler(ctx, apiClient.DefaultAPI, model.ProjectId, model.Region, model.InstanceId, resp.Id).WaitWithContext(ctx)
+							return err
+						})
+						if err != nil {
+							return nil, fmt.Errorf("wait for SQLServer Flex user creation: %w", err)
+						}
+					}
+					return buildNewCredentials(resp)
+				},
+				Delete: func(ctx context.Context) error {
+					err := buildDeleteRequest(ctx, model, apiClient).Execute()
+					if err != nil {
+						return err
+					}
+
+					// Wait for async operation, if async mode not enabled
+					if !model.Async {
+						err = spinner.Run(params.Printer, "Deleting SQLServer Flex user", func() error {
+							_, err = wait.DeleteUserWaitHandler(ctx, apiClient.DefaultAPI, model.ProjectId, model.Region, model.InstanceId, model.UserId).WaitWithContext(ctx)
+							return err
+						})
+						if err != nil {
+							return fmt.Errorf("wait for SQLServer Flex user deletion: %w", err)
+						}
+					}
+					return nil
+				},
+			})
+		},
+	}
+
+	configureFlags(cmd)
+	return cmd
+}
+
+func configureFlags(cmd *cobra.Command) {
+	cmd.Flags().Var(flags.UUIDFlag(), instanceIdFlag, "ID of the instance")
+	cmd.Flags().String(usernameFlag, "", "Username of the new user. Defaults to the username of the old user with the time of the rotation appended")
+	dbcredentials.ConfigureRotateFlags(cmd, "user")
+
+	err := flags.MarkFlagsRequired(cmd, instanceIdFlag)
+	cobra.CheckErr(err)
+}
+
+func parseInput(p *print.Printer, cmd *cobra.Command, inputArgs []string) (*inputModel, error) {
+	userIdStr := inputArgs[0]
+
+	globalFlags := globalflags.Parse(p, cmd)
+	if globalFlags.ProjectId == "" {
+		return nil, &errors.ProjectIdError{}
+	}
+
+	userId, err := strconv.ParseInt(userIdStr, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user id format, must be an integer: %w", err)
+	}
+
+	rotateOptions, err := dbcredentials.ParseRotateFlags(p, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	model := inputModel{
+		GlobalFlagModel: globalFlags,
+		InstanceId:      flags.FlagToStringValue(p, cmd, instanceIdFlag),
+		UserId:          userId,
+		Username:        flags.FlagToStringValue(p, cmd, usernameFlag),
+		RotateOptions:   rotateOptions,
+	}
+
+	p.DebugInputModel(model)
+	return &model, nil
+}
+
+func buildGetUserRequest(ctx context.Context, model *inputModel, apiClient *sqlserverflex.APIClient) sqlserverflex.ApiGetUserRequest {
+	req := apiClient.DefaultAPI.GetUser(ctx, model.ProjectId, model.Region, model.InstanceId, model.UserId)
+	return req
+}
+
+func buildCreateRequest(ctx context.Context, model *inputModel, apiClient *sqlserverflex.APIClient, username string, roles []string) sqlserverflex.ApiCreateUserRequest {
+	req := apiClient.DefaultAPI.CreateUser(ctx, model.ProjectId, model.Region, model.InstanceId)
+	req = req.CreateUserPayload(sqlserverflex.CreateUserPayload{
+		Username: username,
+		Roles:    roles,
+	})
+	return req
+}
+
+func buildDeleteRequest(ctx context.Context, model *inputModel, apiClient *sqlserverflex.APIClient) sqlserverflex.ApiDeleteUserRequest {
+	req := apiClient.DefaultAPI.DeleteUser(ctx, model.ProjectId, model.Region, model.InstanceId, model.UserId)
+	return req
+}
+
+func buildNewCredentials(user *sqlserverflex.CreateUserResponse) (*dbcredentials.NewCredentials, error) {
+	if user == nil {
+		return nil, fmt.Errorf("empty response")
+	}
+
+	credentials := &dbcredentials.Credentials{
+		Engine:   dbcredentials.EngineSQLServer,
+		Host:     user.Host,
+		Username: user.Username,
+		Password: user.Password,
+		URI:      user.Uri,
+	}
+	if user.Port != 0 {
+		credentials.Port = strconv.FormatInt(int64(user.Port), 10)
+	}
+
+	return &dbcredentials.NewCredentials{
+		Id:          strconv.FormatInt(user.Id, 10),
+		Credentials: credentials,
+		Response:    user,
+		HidePassword: func() {
+			user.Password = "hidden"
+		},
+	}, nil
+}
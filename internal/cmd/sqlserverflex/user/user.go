@@ -6,6 +6,7 @@ import (
 	"github.com/stackitcloud/stackit-cli/internal/cmd/sqlserverflex/user/describe"
 	"github.com/stackitcloud/stackit-cli/internal/cmd/sqlserverflex/user/list"
 	resetpassword "github.com/stackitcloud/stackit-cli/internal/cmd/sqlserverflex/user/reset-password"
+	"github.com/stackitcloud/stackit-cli/internal/cmd/sqlserverflex/user/rotate"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/args"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/types"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
@@ -31,4 +32,5 @@ func addSubcommands(cmd *cobra.Command, params *types.CmdParams) {
 	cmd.AddCommand(describe.NewCmd(params))
 	cmd.AddCommand(list.NewCmd(params))
 	cmd.AddCommand(resetpassword.NewCmd(params))
+	cmd.AddCommand(rotate.NewCmd(params))
 }
@@ -0,0 +1,155 @@
+package aliases
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/viper"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/config"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+)
+
+const rootCommandName = "stackit"
+
+var (
+	aliasNameRegex   = regexp.MustCompile("^[a-z0-9][a-z0-9-]*$")
+	placeholderRegex = regexp.MustCompile(`\$([1-9][0-9]*)`)
+)
+
+// List returns the aliases stored in the active profile configuration, mapping alias names to their expansion.
+func List() map[string]string {
+	return viper.GetStringMapString(config.AliasesKey)
+}
+
+// Get returns the expansion of the alias and whether the alias exists.
+func Get(name string) (string, bool) {
+	expansion, ok := List()[name]
+	return expansion, ok
+}
+
+// Set stores the alias in the active profile configuration, overwriting an existing alias with the same name.
+func Set(name, expansion string) error {
+	err := ValidateName(name)
+	if err != nil {
+		return err
+	}
+	if _, err := Split(expansion); err != nil {
+		return fmt.Errorf("parse expansion: %w", err)
+	}
+
+	aliases := List()
+	aliases[name] = expansion
+	viper.Set(config.AliasesKey, aliases)
+
+	err = config.Write()
+	if err != nil {
+		return fmt.Errorf("write config to file: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the alias from the active profile configuration.
+// If the alias does not exist, it returns an error.
+func Delete(name string) error {
+	aliases := List()
+	if _, ok := aliases[name]; !ok {
+		return &errors.AliasDoesNotExistError{Alias: name}
+	}
+	delete(aliases, name)
+	viper.Set(config.AliasesKey, aliases)
+
+	err := config.Write()
+	if err != nil {
+		return fmt.Errorf("write config to file: %w", err)
+	}
+	return nil
+}
+
+// ValidateName validates the alias name.
+// It can only use lowercase letters, numbers, or "-" and cannot be empty.
+// It can't start with a "-".
+func ValidateName(name string) error {
+	if !aliasNameRegex.MatchString(name) {
+		return &errors.InvalidAliasNameError{Alias: name}
+	}
+	return nil
+}
+
+// Expand returns the arguments resulting from expanding the alias expansion with the given arguments.
+// Positional placeholders ("$1", "$2", ...) in the expansion are replaced by the corresponding argument,
+// arguments which are not referenced by a placeholder are appended at the end.
+// A leading "stackit" in the expansion is ignored.
+func Expand(expansion string, args []string) ([]string, error) {
+	words, err := Split(expansion)
+	if err != nil {
+		return nil, fmt.Errorf("parse expansion: %w", err)
+	}
+	if len(words) > 0 && words[0] == rootCommandName {
+		words = words[1:]
+	}
+
+	usedArgs := make([]bool, len(args))
+	expanded := make([]string, 0, len(words)+len(args))
+	for _, word := range words {
+		var replaceErr error
+		word = placeholderRegex.ReplaceAllStringFunc(word, func(placeholder string) string {
+			index, err := strconv.Atoi(placeholder[1:])
+			if err != nil || index > len(args) {
+				replaceErr = fmt.Errorf("missing argument for placeholder %q", placeholder)
+				return placeholder
+			}
+			usedArgs[index-1] = true
+			return args[index-1]
+		})
+		if replaceErr != nil {
+			return nil, replaceErr
+		}
+		expanded = append(expanded, word)
+	}
+
+	for i, arg := range args {
+		if !usedArgs[i] {
+			expanded = append(expanded, arg)
+		}
+	}
+	return expanded, nil
+}
+
+// Split splits the expansion into words separated by whitespace.
+// Single and double quotes can be used to include whitespace in a word.
+func Split(expansion string) ([]string, error) {
+	words := []string{}
+	var word strings.Builder
+	inWord := false
+	var quote rune
+	for _, r := range expansion {
+		switch {
+		case quote != 0 && r == quote:
+			quote = 0
+		case quote != 0:
+			word.WriteRune(r)
+		case r == '\'' || r == '"':
+			quote = r
+			inWord = true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated quote %q", string(quote))
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return words, nil
+}
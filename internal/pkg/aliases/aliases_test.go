@@ -0,0 +1,199 @@
+package aliases
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestValidateName(t *testing.T) {
+	tests := []struct {
+		description string
+		name        string
+		isValid     bool
+	}{
+		{
+			description: "valid with letters",
+			name:        "kc",
+			isValid:     true,
+		},
+		{
+			description: "valid with letters, numbers and hyphen",
+			name:        "kc-prod-1",
+			isValid:     true,
+		},
+		{
+			description: "invalid empty",
+			name:        "",
+			isValid:     false,
+		},
+		{
+			description: "invalid starting with -",
+			name:        "-kc",
+			isValid:     false,
+		},
+		{
+			description: "invalid with uppercase letters",
+			name:        "Kc",
+			isValid:     false,
+		},
+		{
+			description: "invalid with spaces",
+			name:        "k c",
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := ValidateName(tt.name)
+			if tt.isValid && err != nil {
+				t.Errorf("expected alias name to be valid but got error: %v", err)
+			}
+			if !tt.isValid && err == nil {
+				t.Errorf("expected alias name to be invalid but got no error")
+			}
+		})
+	}
+}
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		description string
+		expansion   string
+		isValid     bool
+		expected    []string
+	}{
+		{
+			description: "base",
+			expansion:   "ske kubeconfig create $1 --login",
+			isValid:     true,
+			expected:    []string{"ske", "kubeconfig", "create", "$1", "--login"},
+		},
+		{
+			description: "multiple whitespaces",
+			expansion:   "  ske   cluster\tlist ",
+			isValid:     true,
+			expected:    []string{"ske", "cluster", "list"},
+		},
+		{
+			description: "quotes",
+			expansion:   `project list --label-selector 'team=a b' --name="my project" ""`,
+			isValid:     true,
+			expected:    []string{"project", "list", "--label-selector", "team=a b", "--name=my project", ""},
+		},
+		{
+			description: "empty",
+			expansion:   "",
+			isValid:     true,
+			expected:    []string{},
+		},
+		{
+			description: "unterminated quote",
+			expansion:   `project list --name "my project`,
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			words, err := Split(tt.expansion)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected error to be nil, got %v", err)
+			}
+			diff := cmp.Diff(words, tt.expected)
+			if diff != "" {
+				t.Fatalf("unexpected words: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpand(t *testing.T) {
+	tests := []struct {
+		description string
+		expansion   string
+		args        []string
+		isValid     bool
+		expected    []string
+	}{
+		{
+			description: "placeholder",
+			expansion:   "ske kubeconfig create $1 --login --overwrite",
+			args:        []string{"prod"},
+			isValid:     true,
+			expected:    []string{"ske", "kubeconfig", "create", "prod", "--login", "--overwrite"},
+		},
+		{
+			description: "leading root command",
+			expansion:   "stackit ske cluster list",
+			isValid:     true,
+			expected:    []string{"ske", "cluster", "list"},
+		},
+		{
+			description: "remaining args are appended",
+			expansion:   "ske cluster describe $1",
+			args:        []string{"prod", "--output-format", "json"},
+			isValid:     true,
+			expected:    []string{"ske", "cluster", "describe", "prod", "--output-format", "json"},
+		},
+		{
+			description: "no placeholders",
+			expansion:   "ske cluster list",
+			args:        []string{"-o", "json"},
+			isValid:     true,
+			expected:    []string{"ske", "cluster", "list", "-o", "json"},
+		},
+		{
+			description: "placeholders in flag values and in different order",
+			expansion:   "server create --name=$2 --machine-type $1",
+			args:        []string{"c1.2", "web"},
+			isValid:     true,
+			expected:    []string{"server", "create", "--name=web", "--machine-type", "c1.2"},
+		},
+		{
+			description: "placeholder used multiple times",
+			expansion:   "dns record-set create --zone-id $1 --name $2 --record $2",
+			args:        []string{"xxx", "www"},
+			isValid:     true,
+			expected:    []string{"dns", "record-set", "create", "--zone-id", "xxx", "--name", "www", "--record", "www"},
+		},
+		{
+			description: "missing argument",
+			expansion:   "ske kubeconfig create $1",
+			args:        []string{},
+			isValid:     false,
+		},
+		{
+			description: "invalid expansion",
+			expansion:   "ske kubeconfig create '$1",
+			args:        []string{"prod"},
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			expanded, err := Expand(tt.expansion, tt.args)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected error to be nil, got %v", err)
+			}
+			diff := cmp.Diff(expanded, tt.expected)
+			if diff != "" {
+				t.Fatalf("unexpected expanded args: %s", diff)
+			}
+		})
+	}
+}
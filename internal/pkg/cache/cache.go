@@ -7,10 +7,12 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/stackitcloud/stackit-cli/internal/pkg/auth"
@@ -150,6 +152,61 @@ func DeleteObject(identifier string) error {
 	return nil
 }
 
+// ListObjects returns the identifiers of the cached objects which start with the prefix
+func ListObjects(prefix string) ([]string, error) {
+	if err := validateCacheFolderPath(); err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(cacheFolderPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return []string{}, nil
+		}
+		return nil, err
+	}
+
+	identifiers := []string{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, prefix) || !identifierRegex.MatchString(name) {
+			continue
+		}
+		identifiers = append(identifiers, name)
+	}
+	return identifiers, nil
+}
+
+// Prune deletes the cached objects which start with the prefix and either can't be decrypted anymore or are expired according to isExpired, which may be nil.
+// It returns the identifiers of the deleted objects
+func Prune(prefix string, isExpired func(identifier string, data []byte) bool) ([]string, error) {
+	identifiers, err := ListObjects(prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	pruned := []string{}
+	for _, identifier := range identifiers {
+		data, err := GetObject(identifier)
+		var pathErr *fs.PathError
+		switch {
+		case errors.Is(err, os.ErrNotExist):
+			// deleted in the meantime
+			continue
+		case errors.As(err, &pathErr):
+			return pruned, err
+		case err == nil && (isExpired == nil || !isExpired(identifier, data)):
+			continue
+		}
+
+		if err := DeleteObject(identifier); err != nil {
+			return pruned, err
+		}
+		pruned = append(pruned, identifier)
+	}
+	return pruned, nil
+}
+
 func validateCacheFolderPath() error {
 	if cacheFolderPath == "" {
 		return errors.New("cacheFolderPath not set. Forgot to call Init()?")
@@ -286,3 +286,100 @@ func TestInit(t *testing.T) {
 		t.Fatalf("cache init failed: %s", err)
 	}
 }
+
+func TestListObjects(t *testing.T) {
+	defer overwriteCacheDir(t)()
+	if err := Init(); err != nil {
+		t.Fatalf("cache init failed: %s", err)
+	}
+
+	identifiers, err := ListObjects("test-list-")
+	if err != nil {
+		t.Fatalf("listobjects failed: %v", err)
+	}
+	if len(identifiers) != 0 {
+		t.Fatalf("expected no identifiers, got %v", identifiers)
+	}
+
+	for _, id := range []string{"test-list-a", "test-list-b", "other"} {
+		if err := PutObject(id, []byte("test-data")); err != nil {
+			t.Fatalf("putobject failed: %v", err)
+		}
+	}
+	if err := os.Mkdir(filepath.Join(cacheFolderPath, "test-list-dir"), 0o750); err != nil {
+		t.Fatalf("create folder: %v", err)
+	}
+
+	identifiers, err = ListObjects("test-list-")
+	if err != nil {
+		t.Fatalf("listobjects failed: %v", err)
+	}
+	diff := cmp.Diff(identifiers, []string{"test-list-a", "test-list-b"})
+	if diff != "" {
+		t.Fatalf("unexpected identifiers diff: %v", diff)
+	}
+}
+
+func TestPrune(t *testing.T) {
+	tests := []struct {
+		description    string
+		isExpired      func(identifier string, data []byte) bool
+		expectedPruned []string
+	}{
+		{
+			description:    "undecryptable objects",
+			isExpired:      nil,
+			expectedPruned: []string{"test-prune-invalid"},
+		},
+		{
+			description: "expired objects",
+			isExpired: func(_ string, data []byte) bool {
+				return string(data) == "expired"
+			},
+			expectedPruned: []string{"test-prune-expired", "test-prune-invalid"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			defer overwriteCacheDir(t)()
+			if err := Init(); err != nil {
+				t.Fatalf("cache init failed: %s", err)
+			}
+
+			objects := map[string]string{
+				"test-prune-valid":   "valid",
+				"test-prune-expired": "expired",
+				"other-expired":      "expired",
+			}
+			for id, data := range objects {
+				if err := PutObject(id, []byte(data)); err != nil {
+					t.Fatalf("putobject failed: %v", err)
+				}
+			}
+			if err := os.WriteFile(filepath.Join(cacheFolderPath, "test-prune-invalid"), []byte("dummy"), 0o600); err != nil {
+				t.Fatalf("setup: WriteFile failed: %v", err)
+			}
+
+			pruned, err := Prune("test-prune-", tt.isExpired)
+			if err != nil {
+				t.Fatalf("prune failed: %v", err)
+			}
+			diff := cmp.Diff(pruned, tt.expectedPruned)
+			if diff != "" {
+				t.Fatalf("unexpected pruned diff: %v", diff)
+			}
+
+			for _, id := range tt.expectedPruned {
+				if _, err := os.Stat(filepath.Join(cacheFolderPath, id)); !errors.Is(err, os.ErrNotExist) {
+					t.Fatalf("expected file (%q) to not exist", id)
+				}
+			}
+			for _, id := range []string{"test-prune-valid", "other-expired"} {
+				if _, err := GetObject(id); err != nil {
+					t.Fatalf("expected object (%q) to be kept: %v", id, err)
+				}
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -23,47 +24,54 @@ const (
 	IdentityProviderCustomClientIdKey               = "identity_provider_custom_client_id"
 	AllowedUrlDomainKey                             = "allowed_url_domain"
 
-	AuthorizationCustomEndpointKey     = "authorization_custom_endpoint"
-	AlbCustomEndpoint                  = "alb_custom _endpoint"
-	AlbWafCustomEndpointKey            = "alb_waf_custom_endpoint"
-	DNSCustomEndpointKey               = "dns_custom_endpoint"
-	EdgeCustomEndpointKey              = "edge_custom_endpoint"
-	LoadBalancerCustomEndpointKey      = "load_balancer_custom_endpoint"
-	LogMeCustomEndpointKey             = "logme_custom_endpoint"
-	MariaDBCustomEndpointKey           = "mariadb_custom_endpoint"
-	MongoDBFlexCustomEndpointKey       = "mongodbflex_custom_endpoint"
-	ObjectStorageCustomEndpointKey     = "object_storage_custom_endpoint"
-	ObservabilityCustomEndpointKey     = "observability_custom_endpoint"
-	OpenSearchCustomEndpointKey        = "opensearch_custom_endpoint"
-	PostgresFlexCustomEndpointKey      = "postgresflex_custom_endpoint"
-	RabbitMQCustomEndpointKey          = "rabbitmq_custom_endpoint"
-	RedisCustomEndpointKey             = "redis_custom_endpoint"
-	ResourceManagerEndpointKey         = "resource_manager_custom_endpoint"
-	SecretsManagerCustomEndpointKey    = "secrets_manager_custom_endpoint"
-	KMSCustomEndpointKey               = "kms_custom_endpoint"
-	ServiceAccountCustomEndpointKey    = "service_account_custom_endpoint"
-	ServiceEnablementCustomEndpointKey = "service_enablement_custom_endpoint"
-	ServerBackupCustomEndpointKey      = "serverbackup_custom_endpoint"
-	ServerOsUpdateCustomEndpointKey    = "serverosupdate_custom_endpoint"
-	RunCommandCustomEndpointKey        = "runcommand_custom_endpoint"
-	SfsCustomEndpointKey               = "sfs_custom_endpoint"
-	SKECustomEndpointKey               = "ske_custom_endpoint"
-	SQLServerFlexCustomEndpointKey     = "sqlserverflex_custom_endpoint"
-	IaaSCustomEndpointKey              = "iaas_custom_endpoint"
-	TokenCustomEndpointKey             = "token_custom_endpoint"
-	GitCustomEndpointKey               = "git_custom_endpoint"
-	CDNCustomEndpointKey               = "cdn_custom_endpoint"
-	IntakeCustomEndpointKey            = "intake_custom_endpoint"
-	LogsCustomEndpointKey              = "logs_custom_endpoint"
-	VPNCustomEndpointKey               = "vpn_custom_endpoint"
-
-	ProjectNameKey     = "project_name"
+	AuthorizationCustomEndpointKey       = "authorization_custom_endpoint"
+	AlbCustomEndpoint                    = "alb_custom _endpoint"
+	AlbWafCustomEndpointKey              = "alb_waf_custom_endpoint"
+	DNSCustomEndpointKey                 = "dns_custom_endpoint"
+	EdgeCustomEndpointKey                = "edge_custom_endpoint"
+	LoadBalancerCustomEndpointKey        = "load_balancer_custom_endpoint"
+	LogMeCustomEndpointKey               = "logme_custom_endpoint"
+	MariaDBCustomEndpointKey             = "mariadb_custom_endpoint"
+	MongoDBFlexCustomEndpointKey         = "mongodbflex_custom_endpoint"
+	ObjectStorageCustomEndpointKey       = "object_storage_custom_endpoint"
+	ObservabilityCustomEndpointKey       = "observability_custom_endpoint"
+	OpenSearchCustomEndpointKey          = "opensearch_custom_endpoint"
+	PostgresFlexCustomEndpointKey        = "postgresflex_custom_endpoint"
+	RabbitMQCustomEndpointKey            = "rabbitmq_custom_endpoint"
+	RedisCustomEndpointKey               = "redis_custom_endpoint"
+	ResourceManagerEndpointKey           = "resource_manager_custom_endpoint"
+	SecretsManagerCustomEndpointKey      = "secrets_manager_custom_endpoint"
+	SecretsManagerVaultCustomEndpointKey = "secrets_manager_vault_custom_endpoint"
+	KMSCustomEndpointKey                 = "kms_custom_endpoint"
+	ServiceAccountCustomEndpointKey      = "service_account_custom_endpoint"
+	ServiceEnablementCustomEndpointKey   = "service_enablement_custom_endpoint"
+	ServerBackupCustomEndpointKey        = "serverbackup_custom_endpoint"
+	ServerOsUpdateCustomEndpointKey      = "serverosupdate_custom_endpoint"
+	RunCommandCustomEndpointKey          = "runcommand_custom_endpoint"
+	SfsCustomEndpointKey                 = "sfs_custom_endpoint"
+	SKECustomEndpointKey                 = "ske_custom_endpoint"
+	SQLServerFlexCustomEndpointKey       = "sqlserverflex_custom_endpoint"
+	IaaSCustomEndpointKey                = "iaas_custom_endpoint"
+	TokenCustomEndpointKey               = "token_custom_endpoint"
+	GitCustomEndpointKey                 = "git_custom_endpoint"
+	CDNCustomEndpointKey                 = "cdn_custom_endpoint"
+	IntakeCustomEndpointKey              = "intake_custom_endpoint"
+	LogsCustomEndpointKey                = "logs_custom_endpoint"
+	VPNCustomEndpointKey                 = "vpn_custom_endpoint"
+
+	ProjectNameKey = "project_name"
+	AliasesKey     = "aliases"
+
+	ProtectedKey           = "protected"
+	ProtectedProjectIdsKey = "protected_project_ids"
+
 	DefaultProfileName = "default"
 
 	AsyncDefault            = false
 	AssumeYesDefault        = false
 	RegionDefault           = "eu01"
 	SessionTimeLimitDefault = "12h"
+	SessionTimeLimitMax     = 24 * time.Hour
 
 	AllowedUrlDomainDefault = "stackit.cloud"
 )
@@ -118,6 +126,7 @@ var ConfigKeys = []string{
 	SKECustomEndpointKey,
 	SQLServerFlexCustomEndpointKey,
 	SecretsManagerCustomEndpointKey,
+	SecretsManagerVaultCustomEndpointKey,
 	ServerBackupCustomEndpointKey,
 	ServerOsUpdateCustomEndpointKey,
 	ServiceAccountCustomEndpointKey,
@@ -131,6 +140,9 @@ var defaultConfigFolderPath string
 var configFolderPath string
 var profileFilePath string
 
+// Config values read from the profile configuration file, before the project configuration is merged
+var profileConfig map[string]any
+
 func InitConfig() {
 	initConfig(getInitialConfigDir())
 }
@@ -139,6 +151,10 @@ func initConfig(configPath string) {
 	defaultConfigFolderPath = configPath
 	profileFilePath = getInitialProfileFilePath() // Profile file path is in the default config folder
 
+	// The project configuration file can select the profile, so it needs to be loaded first
+	err := loadProjectConfig()
+	cobra.CheckErr(err)
+
 	configProfile, err := GetProfile()
 	cobra.CheckErr(err)
 
@@ -165,6 +181,12 @@ func initConfig(configPath string) {
 		}
 	}()
 
+	// The project configuration takes precedence over the profile configuration,
+	// but environment variables and flags still take precedence over it
+	profileConfig = viper.AllSettings()
+	err = viper.MergeConfigMap(getProjectConfigValues())
+	cobra.CheckErr(err)
+
 	setConfigDefaults()
 
 	viper.AutomaticEnv()
@@ -172,12 +194,57 @@ func initConfig(configPath string) {
 }
 
 // Write saves the config file (wrapping `viper.WriteConfig`) and ensures that its directory exists
+//
+// Values coming from the project configuration file are not persisted in the profile configuration,
+// unless they have been changed in the meantime (e.g. via `viper.Set`)
 func Write() error {
 	err := os.MkdirAll(configFolderPath, 0o750)
 	if err != nil {
 		return fmt.Errorf("create config directory: %w", err)
 	}
-	return viper.WriteConfig()
+	if len(getProjectConfigValues()) == 0 {
+		return viper.WriteConfig()
+	}
+
+	settings := viper.AllSettings()
+	for key, value := range getProjectConfigValues() {
+		if viper.GetString(key) != value {
+			continue
+		}
+		profileValue, ok := profileConfig[key]
+		if !ok {
+			delete(settings, key)
+			continue
+		}
+		settings[key] = profileValue
+	}
+
+	profileViper := viper.New()
+	for key, value := range settings {
+		profileViper.Set(key, value)
+	}
+	profileViper.SetConfigType(configFileExtension)
+	return profileViper.WriteConfigAs(viper.ConfigFileUsed())
+}
+
+// ParseSessionTimeLimit validates a session time limit and returns it in a format accepted by time.ParseDuration.
+// time.ParseDuration doesn't recognize unit "d", for simplicity the value "1d" is allowed and returned as "24h"
+func ParseSessionTimeLimit(value string) (string, error) {
+	if value == "1d" {
+		value = "24h"
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return "", fmt.Errorf("parse value \"%s\": %w", value, err)
+	}
+	if duration <= 0 {
+		return "", fmt.Errorf("value must be positive")
+	}
+	if duration > SessionTimeLimitMax {
+		return "", fmt.Errorf("value can't be larger than 24h")
+	}
+	return value, nil
 }
 
 // All config keys should be set to a default value so that they can be set as an environment variable
@@ -201,6 +268,7 @@ func setConfigDefaults() {
 	viper.SetDefault(PostgresFlexCustomEndpointKey, "")
 	viper.SetDefault(ResourceManagerEndpointKey, "")
 	viper.SetDefault(SecretsManagerCustomEndpointKey, "")
+	viper.SetDefault(SecretsManagerVaultCustomEndpointKey, "")
 	viper.SetDefault(KMSCustomEndpointKey, "")
 	viper.SetDefault(ServiceAccountCustomEndpointKey, "")
 	viper.SetDefault(ServiceEnablementCustomEndpointKey, "")
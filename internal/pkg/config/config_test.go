@@ -125,3 +125,58 @@ func TestGetInitialProfileFilePath(t *testing.T) {
 		})
 	}
 }
+
+func TestParseSessionTimeLimit(t *testing.T) {
+	tests := []struct {
+		description   string
+		value         string
+		isValid       bool
+		expectedValue string
+	}{
+		{
+			description:   "hours",
+			value:         "12h",
+			isValid:       true,
+			expectedValue: "12h",
+		},
+		{
+			description:   "one day",
+			value:         "1d",
+			isValid:       true,
+			expectedValue: "24h",
+		},
+		{
+			description: "too long",
+			value:       "25h",
+			isValid:     false,
+		},
+		{
+			description: "negative",
+			value:       "-1h",
+			isValid:     false,
+		},
+		{
+			description: "malformed",
+			value:       "12",
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			value, err := ParseSessionTimeLimit(tt.value)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("should have failed")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("should not have failed: %v", err)
+			}
+			if value != tt.expectedValue {
+				t.Fatalf("expected %q, got %q", tt.expectedValue, value)
+			}
+		})
+	}
+}
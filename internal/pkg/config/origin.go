@@ -0,0 +1,40 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+const envVarPrefix = "STACKIT"
+
+// GetEnvVarName returns the name of the environment variable that can be used to set the config key
+func GetEnvVarName(key string) string {
+	return fmt.Sprintf("%s_%s", envVarPrefix, strings.ToUpper(key))
+}
+
+// GetOrigin returns a description of where the value of the config key comes from,
+// following the precedence used by the CLI: flag, environment variable, project configuration file,
+// profile configuration file and default value.
+// The flag matching the config key is looked up in flagSet, which can be nil.
+func GetOrigin(key string, flagSet *pflag.FlagSet) string {
+	if flagSet != nil {
+		flag := flagSet.Lookup(strings.ReplaceAll(key, "_", "-"))
+		if flag != nil && flag.Changed {
+			return fmt.Sprintf("flag %q", "--"+flag.Name)
+		}
+	}
+	envVar := GetEnvVarName(key)
+	if _, ok := os.LookupEnv(envVar); ok {
+		return fmt.Sprintf("environment variable %q", envVar)
+	}
+	if IsSetInProjectConfig(key) {
+		return fmt.Sprintf("project file %q", projectConfigFilePath)
+	}
+	if _, ok := profileConfig[key]; ok {
+		return fmt.Sprintf("profile file %q", getConfigFilePath(configFolderPath))
+	}
+	return "default"
+}
@@ -0,0 +1,78 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/spf13/pflag"
+)
+
+func TestGetOrigin(t *testing.T) {
+	tests := []struct {
+		description   string
+		flagValue     string
+		envValue      string
+		projectConfig map[string]string
+		profileConfig map[string]any
+		expected      string
+	}{
+		{
+			description: "default",
+			expected:    "default",
+		},
+		{
+			description:   "profile file",
+			profileConfig: map[string]any{RegionKey: "eu01"},
+			expected:      `profile file "/config/cli-config.json"`,
+		},
+		{
+			description:   "project file",
+			projectConfig: map[string]string{RegionKey: "eu02"},
+			profileConfig: map[string]any{RegionKey: "eu01"},
+			expected:      `project file "/project/.stackit.yaml"`,
+		},
+		{
+			description:   "environment variable",
+			envValue:      "eu02",
+			projectConfig: map[string]string{RegionKey: "eu02"},
+			expected:      `environment variable "STACKIT_REGION"`,
+		},
+		{
+			description:   "flag",
+			flagValue:     "eu02",
+			envValue:      "eu01",
+			projectConfig: map[string]string{RegionKey: "eu02"},
+			expected:      `flag "--region"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			configFolderPath = "/config"
+			projectConfigFilePath = "/project/.stackit.yaml"
+			projectConfig = tt.projectConfig
+			profileConfig = tt.profileConfig
+			t.Cleanup(func() {
+				projectConfigFilePath = ""
+				projectConfig = nil
+				profileConfig = nil
+			})
+
+			flagSet := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			flagSet.String("region", "", "")
+			if tt.flagValue != "" {
+				err := flagSet.Set("region", tt.flagValue)
+				if err != nil {
+					t.Fatalf("set flag: %v", err)
+				}
+			}
+			if tt.envValue != "" {
+				t.Setenv("STACKIT_REGION", tt.envValue)
+			}
+
+			actual := GetOrigin(RegionKey, flagSet)
+			if actual != tt.expected {
+				t.Fatalf("expected %q, got %q", tt.expected, actual)
+			}
+		})
+	}
+}
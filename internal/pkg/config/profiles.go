@@ -6,6 +6,8 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
+	"strings"
 
 	"github.com/spf13/viper"
 
@@ -18,8 +20,8 @@ const ProfileEnvVar = "STACKIT_CLI_PROFILE"
 
 // GetProfile returns the current profile to be used by the CLI.
 // The profile is determined by the value of the STACKIT_CLI_PROFILE environment variable, or, if not set,
-// by the contents of the profile file in the CLI config folder.
-// If the profile is not set (env var or profile file) or is set but does not exist, it falls back to the default profile.
+// by the project configuration file, or, if not set, by the contents of the profile file in the CLI config folder.
+// If the profile is not set (env var, project configuration file or profile file) or is set but does not exist, it falls back to the default profile.
 // If the profile is not valid, it returns an error.
 func GetProfile() (string, error) {
 	_, profile, _, err := GetConfiguredProfile()
@@ -32,14 +34,21 @@ func GetProfile() (string, error) {
 
 // GetConfiguredProfile returns the profile configured by the user, the profile to be used by the CLI and the method used to configure the profile.
 // The profile is determined by the value of the STACKIT_CLI_PROFILE environment variable, or, if not set,
-// by the contents of the profile file in the CLI config folder.
-// If the configured profile is not set (env var or profile file) or is set but does not exist, it falls back to the default profile.
-// The configuration method can be environment variable, profile file or empty if profile is not configured.
+// by the project configuration file, or, if not set, by the contents of the profile file in the CLI config folder.
+// If the configured profile is not set (env var, project configuration file or profile file) or is set but does not exist, it falls back to the default profile.
+// The configuration method can be environment variable, project configuration file, profile file or empty if profile is not configured.
 // If the profile is not valid, it returns an error.
 func GetConfiguredProfile() (configuredProfile, activeProfile, configurationMethod string, err error) {
 	var configMethod string
 	profile, profileSetInEnv := GetProfileFromEnv()
-	if !profileSetInEnv {
+	profileFromProjectConfig, profileSetInProjectConfig := projectConfig[ProjectConfigProfileKey]
+	switch {
+	case profileSetInEnv:
+		configMethod = "environment variable"
+	case profileSetInProjectConfig:
+		profile = profileFromProjectConfig
+		configMethod = fmt.Sprintf("project configuration file %q", projectConfigFilePath)
+	default:
 		contents, exists, err := fileutils.ReadFileIfExists(profileFilePath)
 		if err != nil {
 			return "", "", "", fmt.Errorf("read profile from file: %w", err)
@@ -50,8 +59,6 @@ func GetConfiguredProfile() (configuredProfile, activeProfile, configurationMeth
 		}
 		profile = contents
 		configMethod = "profile file"
-	} else {
-		configMethod = "environment variable"
 	}
 
 	// Make sure the profile exists
@@ -453,3 +460,88 @@ func ExportProfile(p *print.Printer, profile, exportPath string) error {
 
 	return nil
 }
+
+// IsProfileProtected returns whether the active profile is protected.
+// Protection is only read from the profile configuration file, so it can't be turned off
+// by environment variables or the project configuration file.
+func IsProfileProtected() bool {
+	return getProfileConfigViper().GetBool(ProtectedKey)
+}
+
+// IsProjectProtected returns whether the project is protected in the active profile.
+// Protection is only read from the profile configuration file, so it can't be turned off
+// by environment variables or the project configuration file.
+func IsProjectProtected(projectId string) bool {
+	if projectId == "" {
+		return false
+	}
+	return slices.Contains(getProfileConfigViper().GetStringSlice(ProtectedProjectIdsKey), projectId)
+}
+
+// SetProfileProtection marks the profile as protected or unprotected.
+// If projectId is not empty, only the project is marked as protected or unprotected in the profile.
+// If the profile does not exist, it returns an error.
+func SetProfileProtection(p *print.Printer, profile, projectId string, protected bool) error {
+	err := ValidateProfile(profile)
+	if err != nil {
+		return fmt.Errorf("validate profile: %w", err)
+	}
+
+	exists, err := ProfileExists(profile)
+	if err != nil {
+		return fmt.Errorf("check if profile exists: %w", err)
+	}
+	if !exists {
+		return &errors.ProfileDoesNotExistError{Profile: profile}
+	}
+
+	// A separate viper instance is used, so that only the profile configuration file is changed,
+	// without persisting values coming from flags, environment variables or the project configuration file
+	filePath := getConfigFilePath(GetProfileFolderPath(profile))
+	profileViper := viper.New()
+	profileViper.SetConfigType(configFileExtension)
+	contents, exists, err := fileutils.ReadFileIfExists(filePath)
+	if err != nil {
+		return fmt.Errorf("read config file: %w", err)
+	}
+	if exists {
+		err = profileViper.ReadConfig(strings.NewReader(contents))
+		if err != nil {
+			return fmt.Errorf("parse config file: %w", err)
+		}
+	}
+
+	if projectId == "" {
+		profileViper.Set(ProtectedKey, protected)
+	} else {
+		projectIds := slices.DeleteFunc(profileViper.GetStringSlice(ProtectedProjectIdsKey), func(id string) bool {
+			return id == projectId
+		})
+		if protected {
+			projectIds = append(projectIds, projectId)
+		}
+		profileViper.Set(ProtectedProjectIdsKey, projectIds)
+	}
+
+	err = os.MkdirAll(filepath.Dir(filePath), 0o750)
+	if err != nil {
+		return fmt.Errorf("create config directory: %w", err)
+	}
+	err = profileViper.WriteConfigAs(filePath)
+	if err != nil {
+		return fmt.Errorf("write config file: %w", err)
+	}
+
+	if p != nil {
+		p.Debug(print.DebugLevel, "updated protection of profile %q in: %s", profile, filePath)
+	}
+	return nil
+}
+
+// getProfileConfigViper returns a viper instance containing only the values of the profile configuration file
+func getProfileConfigViper() *viper.Viper {
+	profileViper := viper.New()
+	// The values were read from a valid configuration file, so merging them can't fail
+	_ = profileViper.MergeConfigMap(profileConfig)
+	return profileViper
+}
@@ -7,6 +7,9 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/viper"
+
 	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
 	"github.com/stackitcloud/stackit-cli/internal/pkg/testparams"
 )
@@ -270,3 +273,82 @@ func TestExportProfile(t *testing.T) {
 		})
 	}
 }
+
+func TestSetProfileProtection(t *testing.T) {
+	defaultConfigFolderPath = t.TempDir()
+	t.Cleanup(func() {
+		defaultConfigFolderPath = ""
+		profileConfig = nil
+	})
+
+	profile := "prod"
+	profileFolderPath := GetProfileFolderPath(profile)
+	err := os.MkdirAll(profileFolderPath, 0o750)
+	if err != nil {
+		t.Fatalf("create profile folder: %v", err)
+	}
+	err = os.WriteFile(getConfigFilePath(profileFolderPath), []byte(`{"region":"eu02"}`), 0o600)
+	if err != nil {
+		t.Fatalf("write config file: %v", err)
+	}
+
+	p := print.NewPrinter(nil, nil, nil)
+	steps := []struct {
+		projectId string
+		protected bool
+	}{
+		{projectId: "project-a", protected: true},
+		{projectId: "project-b", protected: true},
+		{projectId: "project-a", protected: true},
+		{projectId: "project-b", protected: false},
+		{protected: true},
+	}
+	for _, step := range steps {
+		err = SetProfileProtection(p, profile, step.projectId, step.protected)
+		if err != nil {
+			t.Fatalf("set profile protection: %v", err)
+		}
+	}
+
+	err = SetProfileProtection(p, "inexistent", "", true)
+	if err == nil {
+		t.Fatalf("expected error for inexistent profile, got nil")
+	}
+
+	profileViper := viper.New()
+	profileViper.SetConfigFile(getConfigFilePath(profileFolderPath))
+	err = profileViper.ReadInConfig()
+	if err != nil {
+		t.Fatalf("read config file: %v", err)
+	}
+	if region := profileViper.GetString(RegionKey); region != "eu02" {
+		t.Errorf("expected existing value %q to be kept, got %q", "eu02", region)
+	}
+
+	profileConfig = profileViper.AllSettings()
+	if !IsProfileProtected() {
+		t.Errorf("expected profile to be protected")
+	}
+	if !IsProjectProtected("project-a") {
+		t.Errorf("expected project %q to be protected", "project-a")
+	}
+	if IsProjectProtected("project-b") {
+		t.Errorf("expected project %q not to be protected", "project-b")
+	}
+	if diff := cmp.Diff(profileViper.GetStringSlice(ProtectedProjectIdsKey), []string{"project-a"}); diff != "" {
+		t.Errorf("unexpected protected project IDs: %s", diff)
+	}
+
+	err = SetProfileProtection(p, profile, "", false)
+	if err != nil {
+		t.Fatalf("set profile protection: %v", err)
+	}
+	err = profileViper.ReadInConfig()
+	if err != nil {
+		t.Fatalf("read config file: %v", err)
+	}
+	profileConfig = profileViper.AllSettings()
+	if IsProfileProtected() {
+		t.Errorf("expected profile not to be protected")
+	}
+}
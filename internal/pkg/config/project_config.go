@@ -0,0 +1,138 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/goccy/go-yaml"
+)
+
+const (
+	// ProjectConfigProfileKey is the key used in a project configuration file to select a profile
+	ProjectConfigProfileKey = "profile"
+
+	projectConfigFileName = ".stackit"
+)
+
+// Extensions of the project configuration file, in order of precedence
+var projectConfigFileExtensions = []string{"yaml", "yml", "json"}
+
+// ProjectConfigKeys are the config keys that can be set in a project configuration file
+var ProjectConfigKeys = []string{
+	OutputFormatKey,
+	ProjectIdKey,
+	RegionKey,
+}
+
+var projectConfigFilePath string
+var projectConfig map[string]string
+
+// FindProjectConfigFile looks for a project configuration file (".stackit.yaml", ".stackit.yml" or ".stackit.json")
+// in dir and all of its parent directories. The closest file is returned.
+// If no project configuration file is found, it returns an empty string.
+func FindProjectConfigFile(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", fmt.Errorf("get absolute path of %q: %w", dir, err)
+	}
+
+	for {
+		for _, extension := range projectConfigFileExtensions {
+			filePath := filepath.Join(dir, fmt.Sprintf("%s.%s", projectConfigFileName, extension))
+			info, err := os.Stat(filePath)
+			if err == nil && !info.IsDir() {
+				return filePath, nil
+			}
+			if err != nil && !os.IsNotExist(err) {
+				return "", fmt.Errorf("get project configuration file: %w", err)
+			}
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", nil
+		}
+		dir = parent
+	}
+}
+
+// readProjectConfig reads the project configuration file in filePath.
+// Both YAML and JSON files are supported. Only the keys in ProjectConfigKeys and the profile can be set.
+func readProjectConfig(filePath string) (map[string]string, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("read file: %w", err)
+	}
+
+	values := map[string]any{}
+	err = yaml.Unmarshal(content, &values)
+	if err != nil {
+		return nil, fmt.Errorf("parse file: %w", err)
+	}
+
+	config := map[string]string{}
+	for key, value := range values {
+		if key != ProjectConfigProfileKey && !slices.Contains(ProjectConfigKeys, key) {
+			return nil, fmt.Errorf("unsupported key %q, supported keys are %q and %q", key, ProjectConfigProfileKey, ProjectConfigKeys)
+		}
+		valueString, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("value of key %q must be a string", key)
+		}
+		config[key] = valueString
+	}
+	return config, nil
+}
+
+// loadProjectConfig finds and reads the project configuration file for the current working directory.
+func loadProjectConfig() error {
+	projectConfigFilePath = ""
+	projectConfig = nil
+
+	workingDir, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("get working directory: %w", err)
+	}
+	filePath, err := FindProjectConfigFile(workingDir)
+	if err != nil {
+		return err
+	}
+	if filePath == "" {
+		return nil
+	}
+
+	config, err := readProjectConfig(filePath)
+	if err != nil {
+		return fmt.Errorf("read project configuration file %q: %w", filePath, err)
+	}
+	projectConfigFilePath = filePath
+	projectConfig = config
+	return nil
+}
+
+// GetProjectConfigFilePath returns the path of the project configuration file in use.
+// If no project configuration file is used, it returns an empty string.
+func GetProjectConfigFilePath() string {
+	return projectConfigFilePath
+}
+
+// IsSetInProjectConfig returns true if the key is set in the project configuration file in use.
+func IsSetInProjectConfig(key string) bool {
+	_, ok := projectConfig[key]
+	return ok
+}
+
+// getProjectConfigValues returns the config values set in the project configuration file,
+// excluding the profile selection.
+func getProjectConfigValues() map[string]any {
+	values := map[string]any{}
+	for key, value := range projectConfig {
+		if key == ProjectConfigProfileKey {
+			continue
+		}
+		values[key] = value
+	}
+	return values
+}
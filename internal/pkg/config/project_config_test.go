@@ -0,0 +1,189 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFindProjectConfigFile(t *testing.T) {
+	tests := []struct {
+		description string
+		files       []string
+		searchDir   string
+		expected    string
+	}{
+		{
+			description: "no file",
+			searchDir:   "a/b",
+			expected:    "",
+		},
+		{
+			description: "file in search dir",
+			files:       []string{"a/b/.stackit.yaml"},
+			searchDir:   "a/b",
+			expected:    "a/b/.stackit.yaml",
+		},
+		{
+			description: "file in parent dir",
+			files:       []string{"a/.stackit.json"},
+			searchDir:   "a/b",
+			expected:    "a/.stackit.json",
+		},
+		{
+			description: "closest file wins",
+			files:       []string{"a/.stackit.yaml", "a/b/.stackit.yml"},
+			searchDir:   "a/b",
+			expected:    "a/b/.stackit.yml",
+		},
+		{
+			description: "yaml takes precedence over json",
+			files:       []string{"a/b/.stackit.json", "a/b/.stackit.yaml"},
+			searchDir:   "a/b",
+			expected:    "a/b/.stackit.yaml",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			root := t.TempDir()
+			err := os.MkdirAll(filepath.Join(root, tt.searchDir), 0o750)
+			if err != nil {
+				t.Fatalf("create search dir: %v", err)
+			}
+			for _, file := range tt.files {
+				err := os.WriteFile(filepath.Join(root, file), []byte{}, 0o600)
+				if err != nil {
+					t.Fatalf("create file: %v", err)
+				}
+			}
+
+			actual, err := FindProjectConfigFile(filepath.Join(root, tt.searchDir))
+			if err != nil {
+				t.Fatalf("expected error to be nil, got %v", err)
+			}
+
+			expected := tt.expected
+			if expected != "" {
+				expected = filepath.Join(root, expected)
+			}
+			if actual != expected {
+				t.Fatalf("expected %q, got %q", expected, actual)
+			}
+		})
+	}
+}
+
+func TestReadProjectConfig(t *testing.T) {
+	tests := []struct {
+		description string
+		fileName    string
+		content     string
+		isValid     bool
+		expected    map[string]string
+	}{
+		{
+			description: "yaml",
+			fileName:    ".stackit.yaml",
+			content:     "project_id: 0f2a2b3c-0000-0000-0000-000000000000\nregion: eu02\nprofile: prod\n",
+			isValid:     true,
+			expected: map[string]string{
+				ProjectIdKey:            "0f2a2b3c-0000-0000-0000-000000000000",
+				RegionKey:               "eu02",
+				ProjectConfigProfileKey: "prod",
+			},
+		},
+		{
+			description: "json",
+			fileName:    ".stackit.json",
+			content:     `{"output_format": "json"}`,
+			isValid:     true,
+			expected: map[string]string{
+				OutputFormatKey: "json",
+			},
+		},
+		{
+			description: "empty",
+			fileName:    ".stackit.yaml",
+			content:     "",
+			isValid:     true,
+			expected:    map[string]string{},
+		},
+		{
+			description: "unsupported key",
+			fileName:    ".stackit.yaml",
+			content:     "session_time_limit: 1h\n",
+			isValid:     false,
+		},
+		{
+			description: "value is not a string",
+			fileName:    ".stackit.yaml",
+			content:     "region:\n  - eu01\n",
+			isValid:     false,
+		},
+		{
+			description: "invalid syntax",
+			fileName:    ".stackit.json",
+			content:     `{"region": `,
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			filePath := filepath.Join(t.TempDir(), tt.fileName)
+			err := os.WriteFile(filePath, []byte(tt.content), 0o600)
+			if err != nil {
+				t.Fatalf("create file: %v", err)
+			}
+
+			actual, err := readProjectConfig(filePath)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected error to be nil, got %v", err)
+			}
+			diff := cmp.Diff(actual, tt.expected)
+			if diff != "" {
+				t.Fatalf("unexpected project config: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGetConfiguredProfileFromProjectConfig(t *testing.T) {
+	defaultConfigFolderPath = t.TempDir()
+	profileFilePath = getInitialProfileFilePath()
+	t.Cleanup(func() {
+		projectConfig = nil
+		projectConfigFilePath = ""
+	})
+
+	err := os.MkdirAll(GetProfileFolderPath("project-profile"), 0o750)
+	if err != nil {
+		t.Fatalf("create profile folder: %v", err)
+	}
+	err = os.WriteFile(profileFilePath, []byte("file-profile"), 0o600)
+	if err != nil {
+		t.Fatalf("write profile file: %v", err)
+	}
+
+	projectConfigFilePath = filepath.Join(t.TempDir(), ".stackit.yaml")
+	projectConfig = map[string]string{
+		ProjectConfigProfileKey: "project-profile",
+	}
+
+	configuredProfile, activeProfile, _, err := GetConfiguredProfile()
+	if err != nil {
+		t.Fatalf("expected error to be nil, got %v", err)
+	}
+	if configuredProfile != "project-profile" || activeProfile != "project-profile" {
+		t.Fatalf("expected profile %q, got configured %q and active %q", "project-profile", configuredProfile, activeProfile)
+	}
+}
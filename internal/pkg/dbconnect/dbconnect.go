@@ -0,0 +1,329 @@
+package dbconnect
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"os/signal"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dbcredentials"
+	cliErr "github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/spinner"
+)
+
+const (
+	IPFlag       = "ip"
+	NoLaunchFlag = "no-launch"
+
+	// publicIPURL returns the public IP of the caller as plain text
+	publicIPURL = "https://api.ipify.org"
+)
+
+var (
+	// Clients launched for each engine, if they are installed
+	clients = map[dbcredentials.Engine]string{
+		dbcredentials.EnginePostgreSQL: "psql",
+		dbcredentials.EngineMongoDB:    "mongosh",
+		dbcredentials.EngineSQLServer:  "sqlcmd",
+	}
+
+	// Overridden in tests
+	lookPath = exec.LookPath
+)
+
+// ACL reads and updates the ACL of an instance
+type ACL interface {
+	// Get returns the current ACL entries of the instance, in CIDR notation
+	Get(ctx context.Context) ([]string, error)
+	// Update replaces the ACL entries of the instance and waits until the update is applied
+	Update(ctx context.Context, acl []string) error
+}
+
+// Target is the instance to connect to. The password is not known, so the client prompts for it.
+type Target struct {
+	Engine   dbcredentials.Engine
+	Host     string
+	Port     string
+	Username string
+	Database string
+}
+
+// Options are the values of the flags configured by ConfigureFlags
+type Options struct {
+	// IP to add to the ACL. If empty, the public IP of the caller is detected
+	IP       string
+	NoLaunch bool
+}
+
+// ConfigureFlags adds the flags of the connect commands to the command
+func ConfigureFlags(cmd *cobra.Command) {
+	ConfigureExecFlags(cmd)
+	cmd.Flags().Bool(NoLaunchFlag, false, "If set, the database client is not launched, the connection URI is printed instead")
+}
+
+// ConfigureExecFlags adds the flags of commands which run queries with Exec to the command
+func ConfigureExecFlags(cmd *cobra.Command) {
+	cmd.Flags().String(IPFlag, "", "IP address to temporarily add to the ACL of the instance. If not set, the public IP address of the caller is detected")
+}
+
+// ParseFlags parses the flags configured by ConfigureFlags or ConfigureExecFlags
+func ParseFlags(p *print.Printer, cmd *cobra.Command) (*Options, error) {
+	opts := &Options{
+		IP: flags.FlagToStringValue(p, cmd, IPFlag),
+	}
+	if cmd.Flags().Lookup(NoLaunchFlag) != nil {
+		opts.NoLaunch = flags.FlagToBoolValue(p, cmd, NoLaunchFlag)
+	}
+	if opts.IP != "" && net.ParseIP(opts.IP) == nil {
+		return nil, &cliErr.FlagValidationError{
+			Flag:    IPFlag,
+			Details: fmt.Sprintf("%q is not a valid IP address", opts.IP),
+		}
+	}
+	return opts, nil
+}
+
+// GetPublicIP returns the public IP address of the caller
+func GetPublicIP(ctx context.Context) (string, error) {
+	return getPublicIP(ctx, publicIPURL)
+}
+
+func getPublicIP(ctx context.Context, ipURL string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ipURL, http.NoBody)
+	if err != nil {
+		return "", fmt.Errorf("create request: %w", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("get public IP address: %w", err)
+	}
+	defer resp.Body.Close() //nolint:errcheck // the body is only read
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("get public IP address: server responded with %s", resp.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 64))
+	if err != nil {
+		return "", fmt.Errorf("read public IP address: %w", err)
+	}
+	ip := strings.TrimSpace(string(body))
+	if net.ParseIP(ip) == nil {
+		return "", fmt.Errorf("get public IP address: invalid response %q", ip)
+	}
+	return ip, nil
+}
+
+// ACLEntry returns the ACL entry in CIDR notation which allows only the given IP address
+func ACLEntry(ip string) string {
+	if strings.Contains(ip, ":") {
+		return ip + "/128"
+	}
+	return ip + "/32"
+}
+
+// Run temporarily adds the ACL entry to the instance, connects to it and removes the entry again.
+//
+// If the client of the engine is installed and opts.NoLaunch is not set, the client is launched and
+// the entry is removed when it exits. Otherwise the connection URI is printed and the entry is removed
+// on Ctrl-C. If the entry already existed, it is kept.
+func Run(ctx context.Context, p *print.Printer, acl ACL, target *Target, aclEntry string, opts *Options) error {
+	// Cancel on Ctrl-C from now on, so the entry is removed in any case
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signals)
+	go func() {
+		select {
+		case <-signals:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	return withEntry(ctx, p, acl, aclEntry, func() error {
+		return launch(ctx, p, target, aclEntry, opts)
+	})
+}
+
+func launch(ctx context.Context, p *print.Printer, target *Target, aclEntry string, opts *Options) error {
+	uri, err := connectionURI(target)
+	if err != nil {
+		return err
+	}
+
+	client := clients[target.Engine]
+	clientPath, lookErr := lookPath(client)
+	if opts.NoLaunch || lookErr != nil {
+		if !opts.NoLaunch {
+			p.Info("%q is not installed, connect with a client of your choice\n", client)
+		}
+		p.Outputf("Connection URI: %s\n", uri)
+		p.Info("Press Ctrl-C to disconnect and remove %q from the ACL\n", aclEntry)
+		<-ctx.Done()
+		return nil
+	}
+
+	p.Info("Launching %q, the ACL entry %q is removed when it exits\n", client, aclEntry)
+	// Ctrl-C is also delivered to the client, which handles it on its own, so it must not kill the client
+	cmd := exec.CommandContext(context.WithoutCancel(ctx), clientPath, clientArgs(target, uri)...) //nolint:gosec // the client is a fixed program per engine
+	cmd.Stdin = p.StdIn
+	cmd.Stdout = p.StdOut
+	cmd.Stderr = p.StdErr
+	err = cmd.Run()
+	if err != nil {
+		return fmt.Errorf("run %q: %w", client, err)
+	}
+	return nil
+}
+
+// Exec temporarily adds the ACL entry to the instance, runs the query with the client of the engine and
+// removes the entry again. The output of the query is returned. Only SQLServer is supported.
+func Exec(ctx context.Context, p *print.Printer, acl ACL, target *Target, aclEntry, password, query string) (string, error) {
+	if target.Engine != dbcredentials.EngineSQLServer {
+		return "", fmt.Errorf("running queries is not supported for %s", target.Engine)
+	}
+	client := clients[target.Engine]
+	clientPath, err := lookPath(client)
+	if err != nil {
+		return "", fmt.Errorf("%q is required to run queries, please install it: %w", client, err)
+	}
+
+	// Cancel the query on Ctrl-C, so the entry is removed in any case
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signals)
+	go func() {
+		select {
+		case <-signals:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	var output string
+	err = withEntry(ctx, p, acl, aclEntry, func() error {
+		return spinner.Run(p, "Running query", func() error {
+			var stdout bytes.Buffer
+			cmd := exec.CommandContext(ctx, clientPath, queryArgs(target, query)...) //nolint:gosec // the client is a fixed program per engine
+			// Keep the password out of the process list
+			cmd.Env = append(os.Environ(), "SQLCMDPASSWORD="+password)
+			cmd.Stdout = &stdout
+			cmd.Stderr = &stdout
+			err := cmd.Run()
+			output = stdout.String()
+			if err != nil {
+				return fmt.Errorf("run %q: %w: %s", client, err, strings.TrimSpace(output))
+			}
+			return nil
+		})
+	})
+	return output, err
+}
+
+// withEntry adds the ACL entry to the instance, runs f and removes the entry again.
+// If the entry already existed, it is kept.
+func withEntry(ctx context.Context, p *print.Printer, acl ACL, aclEntry string, f func() error) (err error) {
+	current, err := acl.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("get ACL: %w", err)
+	}
+	if slices.Contains(current, aclEntry) {
+		p.Info("The ACL of the instance already contains %q, it is kept afterwards\n", aclEntry)
+		return f()
+	}
+
+	// The cleanup is registered first, the update can fail or be canceled after it was applied
+	defer func() {
+		// Use a new context, the command context may be canceled already
+		removeErr := removeEntry(context.Background(), p, acl, aclEntry)
+		if removeErr != nil {
+			err = errors.Join(err, removeErr)
+		}
+	}()
+	err = spinner.Run(p, fmt.Sprintf("Adding %q to the ACL", aclEntry), func() error {
+		return acl.Update(ctx, append(slices.Clone(current), aclEntry))
+	})
+	if err != nil {
+		return fmt.Errorf("add %q to ACL: %w", aclEntry, err)
+	}
+	return f()
+}
+
+// removeEntry removes the entry from the current ACL, so changes made to the ACL in the meantime are kept
+func removeEntry(ctx context.Context, p *print.Printer, acl ACL, aclEntry string) error {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Minute)
+	defer cancel()
+
+	return spinner.Run(p, fmt.Sprintf("Removing %q from the ACL", aclEntry), func() error {
+		current, err := acl.Get(ctx)
+		if err != nil {
+			return fmt.Errorf("get ACL: %w", err)
+		}
+		if !slices.Contains(current, aclEntry) {
+			return nil
+		}
+		updated := slices.DeleteFunc(slices.Clone(current), func(entry string) bool { return entry == aclEntry })
+		err = acl.Update(ctx, updated)
+		if err != nil {
+			return fmt.Errorf("remove %q from ACL, please remove it manually: %w", aclEntry, err)
+		}
+		return nil
+	})
+}
+
+// connectionURI returns the connection URI of the target, without password
+func connectionURI(target *Target) (string, error) {
+	rendered, err := dbcredentials.Render(&dbcredentials.Credentials{
+		Engine:   target.Engine,
+		Host:     target.Host,
+		Port:     target.Port,
+		Database: target.Database,
+	}, dbcredentials.FormatURI, "")
+	if err != nil {
+		return "", fmt.Errorf("build connection URI: %w", err)
+	}
+	uri, err := url.Parse(rendered)
+	if err != nil {
+		return "", fmt.Errorf("parse connection URI: %w", err)
+	}
+	if target.Username != "" {
+		uri.User = url.User(target.Username)
+	}
+	return uri.String(), nil
+}
+
+// clientArgs returns the arguments of the client of the engine
+func clientArgs(target *Target, uri string) []string {
+	if target.Engine == dbcredentials.EngineSQLServer {
+		// sqlcmd doesn't support connection URIs
+		args := []string{"-S", fmt.Sprintf("%s,%s", target.Host, target.Port), "-U", target.Username}
+		if target.Database != "" {
+			args = append(args, "-d", target.Database)
+		}
+		return args
+	}
+	return []string{uri}
+}
+
+// queryArgs returns the arguments of the client of the engine to run the query non-interactively.
+// The output has no headers and its columns are separated by "|"
+func queryArgs(target *Target, query string) []string {
+	return append(clientArgs(target, ""), "-Q", query, "-b", "-W", "-h", "-1", "-s", "|")
+}
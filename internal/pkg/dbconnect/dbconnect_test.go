@@ -0,0 +1,398 @@
+package dbconnect
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dbcredentials"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testparams"
+)
+
+type fakeACL struct {
+	entries   []string
+	updates   [][]string
+	getFails  bool
+	failAfter int
+	// failApplied is the number of the update which is applied but fails, like a failing wait
+	failApplied int
+}
+
+func (a *fakeACL) Get(_ context.Context) ([]string, error) {
+	if a.getFails {
+		return nil, fmt.Errorf("could not get ACL")
+	}
+	return a.entries, nil
+}
+
+func (a *fakeACL) Update(_ context.Context, acl []string) error {
+	if a.failAfter > 0 && len(a.updates) >= a.failAfter-1 {
+		return fmt.Errorf("could not update ACL")
+	}
+	a.updates = append(a.updates, acl)
+	a.entries = acl
+	if len(a.updates) == a.failApplied {
+		return fmt.Errorf("could not wait for ACL update")
+	}
+	return nil
+}
+
+var testTarget = &Target{
+	Engine:   dbcredentials.EnginePostgreSQL,
+	Host:     "host.example.com",
+	Port:     "5432",
+	Username: "user",
+	Database: "db",
+}
+
+func TestGetPublicIP(t *testing.T) {
+	tests := []struct {
+		description string
+		statusCode  int
+		body        string
+		isValid     bool
+		expected    string
+	}{
+		{
+			description: "ipv4",
+			statusCode:  http.StatusOK,
+			body:        "1.2.3.4\n",
+			isValid:     true,
+			expected:    "1.2.3.4",
+		},
+		{
+			description: "ipv6",
+			statusCode:  http.StatusOK,
+			body:        "2001:db8::1",
+			isValid:     true,
+			expected:    "2001:db8::1",
+		},
+		{
+			description: "invalid response",
+			statusCode:  http.StatusOK,
+			body:        "<html></html>",
+			isValid:     false,
+		},
+		{
+			description: "server error",
+			statusCode:  http.StatusInternalServerError,
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tt.statusCode)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			ip, err := getPublicIP(context.Background(), server.URL)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected error to be nil, got %v", err)
+			}
+			if ip != tt.expected {
+				t.Fatalf("expected %q, got %q", tt.expected, ip)
+			}
+		})
+	}
+}
+
+func TestACLEntry(t *testing.T) {
+	if got := ACLEntry("1.2.3.4"); got != "1.2.3.4/32" {
+		t.Fatalf("unexpected entry %q", got)
+	}
+	if got := ACLEntry("2001:db8::1"); got != "2001:db8::1/128" {
+		t.Fatalf("unexpected entry %q", got)
+	}
+}
+
+func TestConnectionURI(t *testing.T) {
+	tests := []struct {
+		description string
+		target      *Target
+		isValid     bool
+		expected    string
+	}{
+		{
+			description: "postgresql",
+			target:      testTarget,
+			isValid:     true,
+			expected:    "postgresql://user@host.example.com:5432/db",
+		},
+		{
+			description: "mongodb without database",
+			target: &Target{
+				Engine:   dbcredentials.EngineMongoDB,
+				Host:     "host.example.com",
+				Port:     "27017",
+				Username: "user",
+			},
+			isValid:  true,
+			expected: "mongodb://user@host.example.com:27017",
+		},
+		{
+			description: "host missing",
+			target:      &Target{Engine: dbcredentials.EnginePostgreSQL},
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			uri, err := connectionURI(tt.target)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected error to be nil, got %v", err)
+			}
+			if uri != tt.expected {
+				t.Fatalf("expected %q, got %q", tt.expected, uri)
+			}
+		})
+	}
+}
+
+func TestClientArgs(t *testing.T) {
+	got := clientArgs(testTarget, "postgresql://user@host.example.com:5432/db")
+	if diff := cmp.Diff(got, []string{"postgresql://user@host.example.com:5432/db"}); diff != "" {
+		t.Fatalf("unexpected args: %s", diff)
+	}
+
+	sqlserverTarget := &Target{
+		Engine:   dbcredentials.EngineSQLServer,
+		Host:     "host.example.com",
+		Port:     "1433",
+		Username: "user",
+		Database: "db",
+	}
+	got = clientArgs(sqlserverTarget, "")
+	if diff := cmp.Diff(got, []string{"-S", "host.example.com,1433", "-U", "user", "-d", "db"}); diff != "" {
+		t.Fatalf("unexpected args: %s", diff)
+	}
+}
+
+func TestRun(t *testing.T) {
+	const entry = "1.2.3.4/32"
+
+	tests := []struct {
+		description     string
+		acl             *fakeACL
+		noLaunch        bool
+		clientInstalled bool
+		isValid         bool
+		expectedUpdates [][]string
+	}{
+		{
+			description:     "print uri",
+			acl:             &fakeACL{entries: []string{"10.0.0.0/8"}},
+			noLaunch:        true,
+			isValid:         true,
+			expectedUpdates: [][]string{{"10.0.0.0/8", entry}, {"10.0.0.0/8"}},
+		},
+		{
+			description:     "client not installed",
+			acl:             &fakeACL{entries: []string{"10.0.0.0/8"}},
+			isValid:         true,
+			expectedUpdates: [][]string{{"10.0.0.0/8", entry}, {"10.0.0.0/8"}},
+		},
+		{
+			description:     "launch client",
+			acl:             &fakeACL{entries: []string{"10.0.0.0/8"}},
+			clientInstalled: true,
+			isValid:         true,
+			expectedUpdates: [][]string{{"10.0.0.0/8", entry}, {"10.0.0.0/8"}},
+		},
+		{
+			description: "entry already exists",
+			acl:         &fakeACL{entries: []string{entry}},
+			noLaunch:    true,
+			isValid:     true,
+		},
+		{
+			description: "get acl fails",
+			acl:         &fakeACL{getFails: true},
+			noLaunch:    true,
+			isValid:     false,
+		},
+		{
+			description: "add entry fails",
+			acl:         &fakeACL{failAfter: 1},
+			noLaunch:    true,
+			isValid:     false,
+		},
+		{
+			description:     "add entry fails after it was applied",
+			acl:             &fakeACL{entries: []string{"10.0.0.0/8"}, failApplied: 1},
+			noLaunch:        true,
+			isValid:         false,
+			expectedUpdates: [][]string{{"10.0.0.0/8", entry}, {"10.0.0.0/8"}},
+		},
+		{
+			description:     "remove entry fails",
+			acl:             &fakeACL{failAfter: 2},
+			noLaunch:        true,
+			isValid:         false,
+			expectedUpdates: [][]string{{entry}},
+		},
+	}
+
+	params := testparams.NewTestParams()
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			lookPath = func(string) (string, error) {
+				if tt.clientInstalled {
+					// Stand-in for the client, which exits immediately
+					return exec.LookPath("true")
+				}
+				return "", fmt.Errorf("not found")
+			}
+			defer func() { lookPath = exec.LookPath }()
+
+			// The command is canceled right away, like on Ctrl-C
+			ctx, cancel := context.WithCancel(context.Background())
+			if !tt.clientInstalled {
+				cancel()
+			}
+			defer cancel()
+
+			err := Run(ctx, params.Printer, tt.acl, testTarget, entry, &Options{NoLaunch: tt.noLaunch})
+			if tt.isValid && err != nil {
+				t.Fatalf("expected error to be nil, got %v", err)
+			}
+			if !tt.isValid && err == nil {
+				t.Fatalf("expected error, got nil")
+			}
+			if diff := cmp.Diff(tt.acl.updates, tt.expectedUpdates); diff != "" {
+				t.Fatalf("unexpected ACL updates: %s", diff)
+			}
+		})
+	}
+}
+
+func TestQueryArgs(t *testing.T) {
+	sqlserverTarget := &Target{
+		Engine:   dbcredentials.EngineSQLServer,
+		Host:     "host.example.com",
+		Port:     "1433",
+		Username: "user",
+	}
+	got := queryArgs(sqlserverTarget, "SELECT 1")
+	expected := []string{"-S", "host.example.com,1433", "-U", "user", "-Q", "SELECT 1", "-b", "-W", "-h", "-1", "-s", "|"}
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Fatalf("unexpected args: %s", diff)
+	}
+}
+
+func TestExec(t *testing.T) {
+	const entry = "1.2.3.4/32"
+
+	sqlserverTarget := &Target{
+		Engine:   dbcredentials.EngineSQLServer,
+		Host:     "host.example.com",
+		Port:     "1433",
+		Username: "user",
+	}
+
+	tests := []struct {
+		description     string
+		acl             *fakeACL
+		target          *Target
+		client          string
+		isValid         bool
+		expectedUpdates [][]string
+	}{
+		{
+			description:     "base",
+			acl:             &fakeACL{entries: []string{"10.0.0.0/8"}},
+			target:          sqlserverTarget,
+			client:          "echo",
+			isValid:         true,
+			expectedUpdates: [][]string{{"10.0.0.0/8", entry}, {"10.0.0.0/8"}},
+		},
+		{
+			description: "entry already exists",
+			acl:         &fakeACL{entries: []string{entry}},
+			target:      sqlserverTarget,
+			client:      "echo",
+			isValid:     true,
+		},
+		{
+			description:     "query fails",
+			acl:             &fakeACL{entries: []string{"10.0.0.0/8"}},
+			target:          sqlserverTarget,
+			client:          "false",
+			isValid:         false,
+			expectedUpdates: [][]string{{"10.0.0.0/8", entry}, {"10.0.0.0/8"}},
+		},
+		{
+			description:     "add entry fails after it was applied",
+			acl:             &fakeACL{entries: []string{"10.0.0.0/8"}, failApplied: 1},
+			target:          sqlserverTarget,
+			client:          "echo",
+			isValid:         false,
+			expectedUpdates: [][]string{{"10.0.0.0/8", entry}, {"10.0.0.0/8"}},
+		},
+		{
+			description: "client not installed",
+			acl:         &fakeACL{entries: []string{"10.0.0.0/8"}},
+			target:      sqlserverTarget,
+			isValid:     false,
+		},
+		{
+			description: "engine not supported",
+			acl:         &fakeACL{entries: []string{"10.0.0.0/8"}},
+			target:      testTarget,
+			client:      "echo",
+			isValid:     false,
+		},
+	}
+
+	params := testparams.NewTestParams()
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			lookPath = func(string) (string, error) {
+				if tt.client == "" {
+					return "", fmt.Errorf("not found")
+				}
+				// Stand-in for the client
+				return exec.LookPath(tt.client)
+			}
+			defer func() { lookPath = exec.LookPath }()
+
+			output, err := Exec(context.Background(), params.Printer, tt.acl, tt.target, entry, "password", "SELECT 1")
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("expected error to be nil, got %v", err)
+				}
+				if !strings.Contains(output, "SELECT 1") {
+					t.Fatalf("expected output to contain the query, got %q", output)
+				}
+			}
+			if diff := cmp.Diff(tt.acl.updates, tt.expectedUpdates); diff != "" {
+				t.Fatalf("unexpected ACL updates: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,345 @@
+package dbcredentials
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+	"github.com/spf13/cobra"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+)
+
+const (
+	FormatAsFlag   = "format-as"
+	OutputFileFlag = "output-file"
+)
+
+// Format is a format in which credentials can be rendered
+type Format string
+
+const (
+	FormatURI       Format = "uri"
+	FormatDotenv    Format = "dotenv"
+	FormatPgpass    Format = "pgpass"
+	FormatJDBC      Format = "jdbc"
+	FormatK8sSecret Format = "k8s-secret"
+)
+
+// Engine is the database or service the credentials belong to
+type Engine string
+
+const (
+	EnginePostgreSQL Engine = "postgresql"
+	EngineMongoDB    Engine = "mongodb"
+	EngineSQLServer  Engine = "sqlserver"
+	EngineMariaDB    Engine = "mariadb"
+	EngineRedis      Engine = "redis"
+	EngineRabbitMQ   Engine = "rabbitmq"
+	EngineOpenSearch Engine = "opensearch"
+	EngineLogMe      Engine = "logme"
+)
+
+var (
+	formats = []Format{FormatURI, FormatDotenv, FormatPgpass, FormatJDBC, FormatK8sSecret}
+
+	// URI schemes used when the API doesn't return a URI
+	uriSchemes = map[Engine]string{
+		EnginePostgreSQL: "postgresql",
+		EngineMongoDB:    "mongodb",
+		EngineSQLServer:  "sqlserver",
+		EngineMariaDB:    "mysql",
+		EngineRedis:      "redis",
+		EngineRabbitMQ:   "amqps",
+		EngineOpenSearch: "https",
+		EngineLogMe:      "https",
+	}
+
+	invalidSecretNameCharsRegex = regexp.MustCompile(`[^a-z0-9-]+`)
+)
+
+// Credentials are the connection details returned by the API. Empty values were not returned.
+type Credentials struct {
+	Engine   Engine
+	Host     string
+	Port     string
+	Username string
+	Password string
+	Database string
+	// URI returned by the API. If empty, it is built from the other values
+	URI string
+}
+
+// Options are the values of the flags configured by ConfigureFlags
+type Options struct {
+	// If empty, the credentials are not rendered and the command output is used
+	FormatAs   Format
+	OutputFile string
+}
+
+// ConfigureFlags adds the flags to render the credentials in a ready-to-use format to the command
+func ConfigureFlags(cmd *cobra.Command) {
+	flags.StringEnumFlag(FormatAsFlag, formats, "If set, outputs the credentials (including the password) in the given format instead of the regular output").Register(cmd.Flags())
+	cmd.Flags().String(OutputFileFlag, "", fmt.Sprintf(`If set, writes the credentials rendered using the "--%s" flag to the file, readable only by the current user, instead of printing them`, FormatAsFlag))
+}
+
+// ParseFlags parses the flags configured by ConfigureFlags
+func ParseFlags(p *print.Printer, cmd *cobra.Command) (*Options, error) {
+	opts := &Options{
+		FormatAs:   Format(flags.FlagToStringValue(p, cmd, FormatAsFlag)),
+		OutputFile: flags.FlagToStringValue(p, cmd, OutputFileFlag),
+	}
+
+	if opts.OutputFile != "" && opts.FormatAs == "" {
+		return nil, &errors.FlagValidationError{
+			Flag:    OutputFileFlag,
+			Details: fmt.Sprintf(`can only be used together with the "--%s" flag`, FormatAsFlag),
+		}
+	}
+	return opts, nil
+}
+
+// Output renders the credentials in the format given in the options and
+// either prints them or writes them to the output file with 0600 permissions.
+// secretName is the name of the Kubernetes Secret, if empty a name is derived from the credentials.
+func Output(p *print.Printer, opts *Options, credentials *Credentials, secretName string) error {
+	rendered, err := Render(credentials, opts.FormatAs, secretName)
+	if err != nil {
+		return err
+	}
+
+	if opts.OutputFile == "" {
+		p.Outputln(rendered)
+		return nil
+	}
+
+	err = writeFile(opts.OutputFile, rendered+"\n")
+	if err != nil {
+		return fmt.Errorf("write credentials to file: %w", err)
+	}
+	p.Info("Wrote credentials to %q\n", opts.OutputFile)
+	return nil
+}
+
+// Render renders the credentials in the given format.
+func Render(credentials *Credentials, format Format, secretName string) (string, error) {
+	switch format {
+	case FormatURI:
+		return buildURI(credentials)
+	case FormatDotenv:
+		return renderDotenv(credentials), nil
+	case FormatPgpass:
+		return renderPgpass(credentials)
+	case FormatJDBC:
+		return buildJDBC(credentials)
+	case FormatK8sSecret:
+		return renderK8sSecret(credentials, secretName)
+	default:
+		return "", fmt.Errorf("unsupported format %q", format)
+	}
+}
+
+func missingValueError(format Format, value string) error {
+	return fmt.Errorf("the %s required for the %q format was not returned by the API", value, format)
+}
+
+func hostPort(credentials *Credentials) string {
+	if credentials.Port == "" {
+		return credentials.Host
+	}
+	return net.JoinHostPort(credentials.Host, credentials.Port)
+}
+
+func buildURI(credentials *Credentials) (string, error) {
+	if credentials.URI != "" {
+		return credentials.URI, nil
+	}
+	if credentials.Host == "" {
+		return "", missingValueError(FormatURI, "host")
+	}
+
+	uri := url.URL{
+		Scheme: uriSchemes[credentials.Engine],
+		Host:   hostPort(credentials),
+	}
+	if credentials.Username != "" {
+		uri.User = url.UserPassword(credentials.Username, credentials.Password)
+	}
+	if credentials.Database != "" {
+		if credentials.Engine == EngineSQLServer {
+			uri.RawQuery = url.Values{"database": {credentials.Database}}.Encode()
+		} else {
+			uri.Path = "/" + credentials.Database
+		}
+	}
+	return uri.String(), nil
+}
+
+func buildJDBC(credentials *Credentials) (string, error) {
+	if credentials.Host == "" {
+		return "", missingValueError(FormatJDBC, "host")
+	}
+
+	switch credentials.Engine {
+	case EnginePostgreSQL, EngineMariaDB:
+		query := url.Values{}
+		if credentials.Username != "" {
+			query.Set("user", credentials.Username)
+			query.Set("password", credentials.Password)
+		}
+		jdbc := fmt.Sprintf("jdbc:%s://%s/%s", credentials.Engine, hostPort(credentials), url.PathEscape(credentials.Database))
+		if len(query) > 0 {
+			jdbc += "?" + query.Encode()
+		}
+		return jdbc, nil
+	case EngineSQLServer:
+		// Values are enclosed in braces, so they can contain special characters like ";"
+		escape := func(value string) string {
+			return "{" + strings.ReplaceAll(value, "}", "}}") + "}"
+		}
+		jdbc := fmt.Sprintf("jdbc:sqlserver://%s", hostPort(credentials))
+		if credentials.Database != "" {
+			jdbc += ";databaseName=" + escape(credentials.Database)
+		}
+		if credentials.Username != "" {
+			jdbc += ";user=" + escape(credentials.Username) + ";password=" + escape(credentials.Password)
+		}
+		return jdbc + ";encrypt=true", nil
+	default:
+		return "", fmt.Errorf("the %q format is not supported for %s", FormatJDBC, credentials.Engine)
+	}
+}
+
+func renderPgpass(credentials *Credentials) (string, error) {
+	if credentials.Engine != EnginePostgreSQL {
+		return "", fmt.Errorf("the %q format is only supported for PostgreSQL", FormatPgpass)
+	}
+
+	// Empty values are replaced by "*", which matches any value
+	escape := func(value string) string {
+		if value == "" {
+			return "*"
+		}
+		return strings.NewReplacer(`\`, `\\`, ":", `\:`).Replace(value)
+	}
+	fields := []string{
+		escape(credentials.Host),
+		escape(credentials.Port),
+		escape(credentials.Database),
+		escape(credentials.Username),
+		escape(credentials.Password),
+	}
+	return strings.Join(fields, ":"), nil
+}
+
+// envVars returns the environment variables for the credentials, skipping empty values.
+// PostgreSQL uses the variables read by libpq, the other engines use the engine name as prefix.
+func envVars(credentials *Credentials) yaml.MapSlice {
+	names := map[string]string{
+		"host":     "HOST",
+		"port":     "PORT",
+		"username": "USERNAME",
+		"password": "PASSWORD",
+		"database": "DATABASE",
+		"uri":      "URI",
+	}
+	prefix := strings.ToUpper(string(credentials.Engine)) + "_"
+	if credentials.Engine == EnginePostgreSQL {
+		names["username"] = "USER"
+		prefix = "PG"
+	}
+
+	uri, err := buildURI(credentials)
+	if err != nil {
+		uri = ""
+	}
+	values := []struct {
+		key   string
+		value string
+	}{
+		{"host", credentials.Host},
+		{"port", credentials.Port},
+		{"username", credentials.Username},
+		{"password", credentials.Password},
+		{"database", credentials.Database},
+		{"uri", uri},
+	}
+
+	vars := yaml.MapSlice{}
+	for _, v := range values {
+		if v.value == "" {
+			continue
+		}
+		name := prefix + names[v.key]
+		if credentials.Engine == EnginePostgreSQL && v.key == "uri" {
+			name = "DATABASE_URL"
+		}
+		vars = append(vars, yaml.MapItem{Key: name, Value: v.value})
+	}
+	return vars
+}
+
+func renderDotenv(credentials *Credentials) string {
+	lines := []string{}
+	for _, v := range envVars(credentials) {
+		value := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "$", `\$`, "\n", `\n`).Replace(v.Value.(string))
+		lines = append(lines, fmt.Sprintf(`%s="%s"`, v.Key, value))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func renderK8sSecret(credentials *Credentials, secretName string) (string, error) {
+	if secretName == "" {
+		secretName = defaultSecretName(credentials)
+	}
+	secret := yaml.MapSlice{
+		{Key: "apiVersion", Value: "v1"},
+		{Key: "kind", Value: "Secret"},
+		{Key: "metadata", Value: yaml.MapSlice{{Key: "name", Value: secretName}}},
+		{Key: "type", Value: "Opaque"},
+		{Key: "stringData", Value: envVars(credentials)},
+	}
+	manifest, err := yaml.Marshal(secret)
+	if err != nil {
+		return "", fmt.Errorf("marshal Kubernetes Secret: %w", err)
+	}
+	return strings.TrimSuffix(string(manifest), "\n"), nil
+}
+
+// defaultSecretName returns a valid Kubernetes resource name based on the engine and username
+func defaultSecretName(credentials *Credentials) string {
+	name := string(credentials.Engine)
+	if credentials.Username != "" {
+		name += "-" + credentials.Username
+	}
+	name = invalidSecretNameCharsRegex.ReplaceAllString(strings.ToLower(name), "-")
+	if len(name) > 63 {
+		name = name[:63]
+	}
+	return strings.Trim(name, "-")
+}
+
+func writeFile(path, content string) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600) //nolint:gosec // the file path is chosen by the user
+	if err != nil {
+		return err
+	}
+	// The file may have existed before with broader permissions
+	err = f.Chmod(0o600)
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+	_, err = f.WriteString(content)
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
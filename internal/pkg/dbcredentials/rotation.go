@@ -0,0 +1,317 @@
+package dbcredentials
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/config"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/services/secrets-manager/vault"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/spinner"
+)
+
+const (
+	GracePeriodFlag  = "grace-period"
+	ShowPasswordFlag = "show-password"
+
+	SecretsManagerInstanceIdFlag = "secrets-manager-instance-id"
+	SecretsManagerSecretPathFlag = "secrets-manager-secret-path"
+	SecretsManagerUsernameFlag   = "secrets-manager-username"
+
+	// If unset, the password of the Secrets Manager user is prompted
+	SecretsManagerPasswordEnv = "STACKIT_SECRETS_MANAGER_PASSWORD" //nolint:gosec // name of the environment variable, not a credential
+)
+
+// Suffix appended to the usernames of rotated users, see RotatedUsername
+var rotatedUsernameSuffixRegex = regexp.MustCompile(`[-_][0-9]{14}$`)
+
+// RotateOptions are the values of the flags configured by ConfigureRotateFlags
+type RotateOptions struct {
+	GracePeriod       time.Duration
+	ShowPassword      bool
+	CredentialsFormat *Options
+	SecretsManager    *SecretsManagerTarget
+}
+
+// Rotation are the service-specific parts of rotating credentials or a user
+type Rotation struct {
+	// Service is the name of the service used in messages, e.g. "Redis"
+	Service string
+	// Kind is what is rotated, e.g. "credentials" or "user"
+	Kind          string
+	Label         string
+	InstanceLabel string
+	// Create creates the credentials or user replacing the old one
+	Create func(ctx context.Context) (*NewCredentials, error)
+	// Delete deletes the old credentials or user
+	Delete func(ctx context.Context) error
+}
+
+// NewCredentials are the credentials created by a rotation
+type NewCredentials struct {
+	Id          string
+	Credentials *Credentials
+	// Response is the API response, which is output in the JSON and YAML output formats
+	Response any
+	// HidePassword hides the password in the response
+	HidePassword func()
+}
+
+// SecretsManagerTarget is the secret of a Secrets Manager instance the credentials are written to
+type SecretsManagerTarget struct {
+	InstanceId string
+	SecretPath string
+	Username   string
+}
+
+// ConfigureRotateFlags adds the flags of the rotate commands to the command,
+// including the flags configured by ConfigureFlags and ConfigureSecretsManagerFlags
+func ConfigureRotateFlags(cmd *cobra.Command, kind string) {
+	cmd.Flags().Duration(GracePeriodFlag, 0, fmt.Sprintf("Time to wait after creating the new %[1]s before deleting the old %[1]s, e.g. 10m", kind))
+	cmd.Flags().BoolP(ShowPasswordFlag, "s", false, "Show password in output")
+	ConfigureFlags(cmd)
+	ConfigureSecretsManagerFlags(cmd)
+}
+
+// ParseRotateFlags parses the flags configured by ConfigureRotateFlags
+func ParseRotateFlags(p *print.Printer, cmd *cobra.Command) (*RotateOptions, error) {
+	gracePeriod := flags.FlagWithDefaultToDurationValue(p, cmd, GracePeriodFlag)
+	if gracePeriod < 0 {
+		return nil, &errors.FlagValidationError{
+			Flag:    GracePeriodFlag,
+			Details: "must not be negative",
+		}
+	}
+
+	credentialsFormat, err := ParseFlags(p, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	secretsManager, err := ParseSecretsManagerFlags(p, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RotateOptions{
+		GracePeriod:       gracePeriod,
+		ShowPassword:      flags.FlagToBoolValue(p, cmd, ShowPasswordFlag),
+		CredentialsFormat: credentialsFormat,
+		SecretsManager:    secretsManager,
+	}, nil
+}
+
+// Rotate asks for confirmation and rotates credentials or a user:
+// the new ones are created, output and optionally written to Secrets Manager,
+// and the old ones are deleted after the grace period.
+// If Secrets Manager is used, the login happens before anything is created, so that a wrong password doesn't leave the rotation half-done.
+func Rotate(ctx context.Context, p *print.Printer, outputFormat, region string, opts *RotateOptions, rotation *Rotation) error {
+	prompt := fmt.Sprintf("Are you sure you want to rotate %s %s of instance %q? (The old %s will be deleted)", rotation.Kind, rotation.Label, rotation.InstanceLabel, rotation.Kind)
+	err := p.PromptForConfirmation(prompt)
+	if err != nil {
+		return err
+	}
+
+	var secretsManagerClient *vault.Client
+	if opts.SecretsManager != nil {
+		secretsManagerClient, err = LoginToSecretsManager(ctx, p, opts.SecretsManager, region)
+		if err != nil {
+			return err
+		}
+	}
+
+	newCredentials, err := rotation.Create(ctx)
+	if err != nil {
+		return fmt.Errorf("create new %s %s: %w", rotation.Service, rotation.Kind, err)
+	}
+
+	if opts.CredentialsFormat.FormatAs != "" {
+		err = Output(p, opts.CredentialsFormat, newCredentials.Credentials, "")
+	} else {
+		err = outputRotation(p, outputFormat, opts.ShowPassword, rotation, newCredentials)
+	}
+	if err != nil {
+		return err
+	}
+
+	if opts.SecretsManager != nil {
+		err = WriteToSecretsManager(ctx, p, secretsManagerClient, opts.SecretsManager, newCredentials.Credentials)
+		if err != nil {
+			return fmt.Errorf("write new %s with ID %q to Secrets Manager, skipped deleting the old %s: %w", rotation.Kind, newCredentials.Id, rotation.Kind, err)
+		}
+	}
+
+	WaitGracePeriod(p, opts.GracePeriod)
+
+	err = rotation.Delete(ctx)
+	if err != nil {
+		return fmt.Errorf("delete old %s %s %s after creating the new %s with ID %q: %w", rotation.Service, rotation.Kind, rotation.Label, rotation.Kind, newCredentials.Id, err)
+	}
+
+	p.Info("Deleted old %s %s of instance %q\n", rotation.Kind, rotation.Label, rotation.InstanceLabel)
+	return nil
+}
+
+func outputRotation(p *print.Printer, outputFormat string, showPassword bool, rotation *Rotation, newCredentials *NewCredentials) error {
+	if !showPassword && newCredentials.HidePassword != nil {
+		newCredentials.HidePassword()
+	}
+
+	return p.OutputResult(outputFormat, newCredentials.Response, func() error {
+		credentials := newCredentials.Credentials
+		p.Outputf("Created new %[1]s to replace %[1]s %[2]s of instance %[3]q. ID: %[4]s\n\n", rotation.Kind, rotation.Label, rotation.InstanceLabel, newCredentials.Id)
+		// The username can't be set for all services, so it is only displayed if it was returned
+		if credentials.Username != "" {
+			p.Outputf("Username: %s\n", credentials.Username)
+		}
+		if !showPassword {
+			p.Outputf("Password: <hidden>\n")
+		} else {
+			p.Outputf("Password: %s\n", credentials.Password)
+		}
+		if credentials.Host != "" {
+			p.Outputf("Host: %s\n", credentials.Host)
+		}
+		if credentials.Port != "" {
+			p.Outputf("Port: %s\n", credentials.Port)
+		}
+		if credentials.Database != "" {
+			p.Outputf("Database: %s\n", credentials.Database)
+		}
+		if credentials.URI != "" {
+			p.Outputf("URI: %s\n", credentials.URI)
+		}
+		return nil
+	})
+}
+
+// ConfigureSecretsManagerFlags adds the flags to write the credentials to Secrets Manager to the command
+func ConfigureSecretsManagerFlags(cmd *cobra.Command) {
+	cmd.Flags().Var(flags.UUIDFlag(), SecretsManagerInstanceIdFlag, "If set, writes the new credentials to a secret of the Secrets Manager instance with this ID")
+	cmd.Flags().String(SecretsManagerSecretPathFlag, "", "Path of the Secrets Manager secret the new credentials are written to")
+	cmd.Flags().String(SecretsManagerUsernameFlag, "", fmt.Sprintf("Username of the Secrets Manager user with write access used to write the secret. The password is read from the %s environment variable or prompted", SecretsManagerPasswordEnv))
+}
+
+// ParseSecretsManagerFlags parses the flags configured by ConfigureSecretsManagerFlags.
+// Returns nil if the credentials should not be written to Secrets Manager.
+func ParseSecretsManagerFlags(p *print.Printer, cmd *cobra.Command) (*SecretsManagerTarget, error) {
+	target := &SecretsManagerTarget{
+		InstanceId: flags.FlagToStringValue(p, cmd, SecretsManagerInstanceIdFlag),
+		SecretPath: flags.FlagToStringValue(p, cmd, SecretsManagerSecretPathFlag),
+		Username:   flags.FlagToStringValue(p, cmd, SecretsManagerUsernameFlag),
+	}
+
+	if target.InstanceId == "" {
+		for _, flag := range []string{SecretsManagerSecretPathFlag, SecretsManagerUsernameFlag} {
+			if cmd.Flag(flag).Changed {
+				return nil, &errors.FlagValidationError{
+					Flag:    flag,
+					Details: fmt.Sprintf(`can only be used together with the "--%s" flag`, SecretsManagerInstanceIdFlag),
+				}
+			}
+		}
+		return nil, nil
+	}
+	if target.SecretPath == "" {
+		return nil, &errors.FlagValidationError{
+			Flag:    SecretsManagerSecretPathFlag,
+			Details: fmt.Sprintf(`must be set when the "--%s" flag is set`, SecretsManagerInstanceIdFlag),
+		}
+	}
+	if target.Username == "" {
+		return nil, &errors.FlagValidationError{
+			Flag:    SecretsManagerUsernameFlag,
+			Details: fmt.Sprintf(`must be set when the "--%s" flag is set`, SecretsManagerInstanceIdFlag),
+		}
+	}
+	return target, nil
+}
+
+// LoginToSecretsManager logs in to the Vault API of the Secrets Manager with the user of the target.
+// It should be called before the credentials are rotated, so that a wrong password doesn't leave the rotation half-done.
+func LoginToSecretsManager(ctx context.Context, p *print.Printer, target *SecretsManagerTarget, region string) (*vault.Client, error) {
+	password, ok := os.LookupEnv(SecretsManagerPasswordEnv)
+	if !ok {
+		var err error
+		password, err = p.PromptForPassword(fmt.Sprintf("Enter the password of Secrets Manager user %q: ", target.Username))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	client := vault.NewClient(getVaultURL(region))
+	err := client.Login(ctx, target.Username, password)
+	if err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+// RotatedUsername returns the default username of the user replacing a rotated user,
+// which is the username of the old user with the time of the rotation appended.
+// The suffix of a previous rotation is replaced, so that the username doesn't grow with each rotation.
+func RotatedUsername(username, separator string, now time.Time) string {
+	username = rotatedUsernameSuffixRegex.ReplaceAllString(username, "")
+	return username + separator + now.UTC().Format("20060102150405")
+}
+
+// getVaultURL returns the URL of the Vault API of the Secrets Manager,
+// which is the Secrets Manager Vault custom endpoint if it is configured
+func getVaultURL(region string) string {
+	if customEndpoint := viper.GetString(config.SecretsManagerVaultCustomEndpointKey); customEndpoint != "" {
+		return customEndpoint
+	}
+	return vault.DefaultURL(region)
+}
+
+// WriteToSecretsManager writes the credentials to the secret of the target
+func WriteToSecretsManager(ctx context.Context, p *print.Printer, client *vault.Client, target *SecretsManagerTarget, credentials *Credentials) error {
+	err := client.WriteSecret(ctx, target.InstanceId, target.SecretPath, credentials.Values())
+	if err != nil {
+		return err
+	}
+	p.Info("Wrote credentials to secret %q of Secrets Manager instance %q\n", target.SecretPath, target.InstanceId)
+	return nil
+}
+
+// WaitGracePeriod blocks for the grace period, giving the consumers of the old credentials time to switch to the new ones
+func WaitGracePeriod(p *print.Printer, gracePeriod time.Duration) {
+	if gracePeriod <= 0 {
+		return
+	}
+	_ = spinner.Run(p, fmt.Sprintf("Waiting %s before deleting the old credentials", gracePeriod), func() error {
+		time.Sleep(gracePeriod)
+		return nil
+	})
+}
+
+// Values returns the credentials as key-value pairs, skipping empty values.
+// If the API didn't return a URI, it is built from the other values if possible.
+func (c *Credentials) Values() map[string]string {
+	uri, err := buildURI(c)
+	if err != nil {
+		uri = ""
+	}
+	values := map[string]string{
+		"host":     c.Host,
+		"port":     c.Port,
+		"username": c.Username,
+		"password": c.Password,
+		"database": c.Database,
+		"uri":      uri,
+	}
+	for key, value := range values {
+		if value == "" {
+			delete(values, key)
+		}
+	}
+	return values
+}
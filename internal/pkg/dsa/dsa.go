@@ -0,0 +1,185 @@
+package dsa
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+)
+
+// Service describes one of the Data Service Access services (LogMe, MariaDB, OpenSearch, RabbitMQ and Redis).
+// Their APIs share the same shapes, so the commands using the API are implemented once for all of them.
+type Service struct {
+	// Name of the service used in messages, e.g. "Redis"
+	Name string
+	// Cmd is the command of the service used in examples, e.g. "redis"
+	Cmd string
+	// ConfigureClient configures the API client of the service
+	ConfigureClient func(p *print.Printer, cliVersion string) (API, error)
+}
+
+// NameWithArticle returns the name of the service with its indefinite article, e.g. "a Redis"
+func (s *Service) NameWithArticle() string {
+	if strings.ContainsRune("AEIOU", rune(s.Name[0])) {
+		return "an " + s.Name
+	}
+	return "a " + s.Name
+}
+
+// API contains the operations shared by the Data Service Access APIs.
+// It is implemented by each service in its utils package.
+type API interface {
+	GetInstanceName(ctx context.Context, projectId, region, instanceId string) (string, error)
+	ListBackups(ctx context.Context, projectId, region, instanceId string) ([]Backup, error)
+	CreateBackup(ctx context.Context, projectId, region, instanceId string) ([]CreatedBackup, error)
+	TriggerRestore(ctx context.Context, projectId, region, instanceId string, backupId int32) (*TriggeredRestore, error)
+	ListRestores(ctx context.Context, projectId, region, instanceId string) ([]Restore, error)
+	DownloadBackup(ctx context.Context, projectId, region, instanceId string, backupId int32) (*os.File, error)
+	GetMetrics(ctx context.Context, projectId, region, instanceId string) (*Metrics, error)
+}
+
+type Backup struct {
+	Downloadable *bool   `json:"downloadable,omitempty"`
+	FinishedAt   string  `json:"finished_at"`
+	Id           int32   `json:"id"`
+	Size         *int32  `json:"size,omitempty"`
+	Status       string  `json:"status"`
+	TriggeredAt  *string `json:"triggered_at,omitempty"`
+}
+
+type CreatedBackup struct {
+	Id      int32  `json:"id"`
+	Message string `json:"message"`
+}
+
+type TriggeredRestore struct {
+	Id int32 `json:"id"`
+}
+
+type Restore struct {
+	BackupId    int32   `json:"backup_id"`
+	FinishedAt  string  `json:"finished_at"`
+	Id          int32   `json:"id"`
+	Status      string  `json:"status"`
+	TriggeredAt *string `json:"triggered_at,omitempty"`
+}
+
+type Metrics struct {
+	CpuIdleTime                          *int64  `json:"cpuIdleTime,omitempty"`
+	CpuLoadPercent                       float32 `json:"cpuLoadPercent"`
+	CpuSystemTime                        *int64  `json:"cpuSystemTime,omitempty"`
+	CpuUserTime                          *int64  `json:"cpuUserTime,omitempty"`
+	DiskEphemeralTotal                   int64   `json:"diskEphemeralTotal"`
+	DiskEphemeralUsed                    int64   `json:"diskEphemeralUsed"`
+	DiskPersistentTotal                  int64   `json:"diskPersistentTotal"`
+	DiskPersistentUsed                   int64   `json:"diskPersistentUsed"`
+	Load1                                float32 `json:"load1"`
+	Load15                               float32 `json:"load15"`
+	Load5                                float32 `json:"load5"`
+	MemoryTotal                          int64   `json:"memoryTotal"`
+	MemoryUsed                           int64   `json:"memoryUsed"`
+	ParachuteDiskEphemeralActivated      bool    `json:"parachuteDiskEphemeralActivated"`
+	ParachuteDiskEphemeralTotal          int64   `json:"parachuteDiskEphemeralTotal"`
+	ParachuteDiskEphemeralUsed           int64   `json:"parachuteDiskEphemeralUsed"`
+	ParachuteDiskEphemeralUsedPercent    int64   `json:"parachuteDiskEphemeralUsedPercent"`
+	ParachuteDiskEphemeralUsedThreshold  int64   `json:"parachuteDiskEphemeralUsedThreshold"`
+	ParachuteDiskPersistentActivated     bool    `json:"parachuteDiskPersistentActivated"`
+	ParachuteDiskPersistentTotal         int64   `json:"parachuteDiskPersistentTotal"`
+	ParachuteDiskPersistentUsed          int64   `json:"parachuteDiskPersistentUsed"`
+	ParachuteDiskPersistentUsedPercent   int64   `json:"parachuteDiskPersistentUsedPercent"`
+	ParachuteDiskPersistentUsedThreshold int64   `json:"parachuteDiskPersistentUsedThreshold"`
+}
+
+// BackupWaitHandler waits until the backup is finished, regardless of whether it succeeded
+func BackupWaitHandler(ctx context.Context, api API, projectId, region, instanceId string, backupId int32) *wait.AsyncActionHandler[Backup] {
+	handler := wait.New(func() (waitFinished bool, response *Backup, err error) {
+		backups, err := api.ListBackups(ctx, projectId, region, instanceId)
+		if err != nil {
+			return false, nil, err
+		}
+		for i := range backups {
+			backup := &backups[i]
+			if backup.Id == backupId {
+				return backup.FinishedAt != "", backup, nil
+			}
+		}
+		return false, nil, nil
+	})
+	handler.SetTimeout(45 * time.Minute)
+	return handler
+}
+
+// RestoreWaitHandler waits until the restore is finished, regardless of whether it succeeded
+func RestoreWaitHandler(ctx context.Context, api API, projectId, region, instanceId string, restoreId int32) *wait.AsyncActionHandler[Restore] {
+	handler := wait.New(func() (waitFinished bool, response *Restore, err error) {
+		restores, err := api.ListRestores(ctx, projectId, region, instanceId)
+		if err != nil {
+			return false, nil, err
+		}
+		for i := range restores {
+			restore := &restores[i]
+			if restore.Id == restoreId {
+				return restore.FinishedAt != "", restore, nil
+			}
+		}
+		return false, nil, nil
+	})
+	handler.SetTimeout(45 * time.Minute)
+	return handler
+}
+
+// Convert converts a response of a Data Service Access API to the shared type T.
+// The APIs use the same JSON representation, so the conversion is done through it.
+func Convert[T any](v any) (T, error) {
+	var result T
+	data, err := json.Marshal(v)
+	if err != nil {
+		return result, fmt.Errorf("marshal response: %w", err)
+	}
+	err = json.Unmarshal(data, &result)
+	if err != nil {
+		return result, fmt.Errorf("unmarshal response: %w", err)
+	}
+	return result, nil
+}
+
+// SaveFile copies the file downloaded by the API to the given path, readable only by the current user, and removes the downloaded file
+func SaveFile(file *os.File, path string) (written int64, err error) {
+	defer func() {
+		closeErr := file.Close()
+		removeErr := os.Remove(file.Name())
+		if err == nil && closeErr != nil {
+			err = fmt.Errorf("close downloaded file: %w", closeErr)
+		}
+		if err == nil && removeErr != nil && !os.IsNotExist(removeErr) {
+			err = fmt.Errorf("remove downloaded file: %w", removeErr)
+		}
+	}()
+
+	_, err = file.Seek(0, io.SeekStart)
+	if err != nil {
+		return 0, fmt.Errorf("read downloaded file: %w", err)
+	}
+
+	out, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600) //nolint:gosec // the file path is chosen by the user
+	if err != nil {
+		return 0, fmt.Errorf("create file: %w", err)
+	}
+	written, err = io.Copy(out, file)
+	if err != nil {
+		_ = out.Close()
+		return written, fmt.Errorf("write file: %w", err)
+	}
+	err = out.Close()
+	if err != nil {
+		return written, fmt.Errorf("write file: %w", err)
+	}
+	return written, nil
+}
@@ -0,0 +1,261 @@
+package dsa
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+)
+
+func TestConvert(t *testing.T) {
+	type apiBackup struct {
+		Downloadable         *bool   `json:"downloadable,omitempty"`
+		FinishedAt           string  `json:"finished_at"`
+		Id                   int32   `json:"id"`
+		Size                 *int32  `json:"size,omitempty"`
+		Status               string  `json:"status"`
+		TriggeredAt          *string `json:"triggered_at,omitempty"`
+		AdditionalProperties map[string]interface{}
+	}
+
+	tests := []struct {
+		description string
+		input       any
+		isValid     bool
+		expected    []Backup
+	}{
+		{
+			description: "base",
+			input: []apiBackup{
+				{
+					Downloadable: utils.Ptr(true),
+					FinishedAt:   "2024-01-01T01:00:00Z",
+					Id:           1,
+					Size:         utils.Ptr(int32(42)),
+					Status:       "done",
+					TriggeredAt:  utils.Ptr("2024-01-01T00:00:00Z"),
+				},
+				{
+					Id:     2,
+					Status: "running",
+				},
+			},
+			isValid: true,
+			expected: []Backup{
+				{
+					Downloadable: utils.Ptr(true),
+					FinishedAt:   "2024-01-01T01:00:00Z",
+					Id:           1,
+					Size:         utils.Ptr(int32(42)),
+					Status:       "done",
+					TriggeredAt:  utils.Ptr("2024-01-01T00:00:00Z"),
+				},
+				{
+					Id:     2,
+					Status: "running",
+				},
+			},
+		},
+		{
+			description: "nil",
+			input:       nil,
+			isValid:     true,
+			expected:    nil,
+		},
+		{
+			description: "different shape",
+			input:       map[string]string{"id": "not-a-number"},
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			result, err := Convert[[]Backup](tt.input)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected error to be nil, got %v", err)
+			}
+			if diff := cmp.Diff(result, tt.expected); diff != "" {
+				t.Fatalf("unexpected result: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSaveFile(t *testing.T) {
+	dir := t.TempDir()
+
+	downloaded, err := os.CreateTemp(dir, "download")
+	if err != nil {
+		t.Fatalf("create downloaded file: %v", err)
+	}
+	_, err = downloaded.WriteString("backup")
+	if err != nil {
+		t.Fatalf("write downloaded file: %v", err)
+	}
+
+	path := filepath.Join(dir, "backup.tar.gz")
+	written, err := SaveFile(downloaded, path)
+	if err != nil {
+		t.Fatalf("save file: %v", err)
+	}
+	if written != int64(len("backup")) {
+		t.Fatalf("expected %d bytes to be written, got %d", len("backup"), written)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read saved file: %v", err)
+	}
+	if string(content) != "backup" {
+		t.Fatalf("expected content %q, got %q", "backup", string(content))
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("stat saved file: %v", err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Fatalf("expected permissions 0600, got %o", info.Mode().Perm())
+	}
+	if _, err := os.Stat(downloaded.Name()); !os.IsNotExist(err) {
+		t.Fatalf("expected downloaded file to be removed")
+	}
+}
+
+type fakeAPI struct {
+	API
+	fails    bool
+	backups  []Backup
+	restores []Restore
+}
+
+func (a *fakeAPI) ListBackups(_ context.Context, _, _, _ string) ([]Backup, error) {
+	if a.fails {
+		return nil, fmt.Errorf("could not list backups")
+	}
+	return a.backups, nil
+}
+
+func (a *fakeAPI) ListRestores(_ context.Context, _, _, _ string) ([]Restore, error) {
+	if a.fails {
+		return nil, fmt.Errorf("could not list restores")
+	}
+	return a.restores, nil
+}
+
+func TestNameWithArticle(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{name: "Redis", expected: "a Redis"},
+		{name: "OpenSearch", expected: "an OpenSearch"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &Service{Name: tt.name}
+			if got := service.NameWithArticle(); got != tt.expected {
+				t.Fatalf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestBackupWaitHandler(t *testing.T) {
+	tests := []struct {
+		description string
+		api         *fakeAPI
+		wantErr     bool
+		expected    *Backup
+	}{
+		{
+			description: "backup finished",
+			api: &fakeAPI{backups: []Backup{
+				{Id: 1, Status: "done", FinishedAt: "2024-01-01T01:00:00Z"},
+				{Id: 2, Status: "failed", FinishedAt: "2024-01-01T02:00:00Z"},
+			}},
+			expected: &Backup{Id: 2, Status: "failed", FinishedAt: "2024-01-01T02:00:00Z"},
+		},
+		{
+			description: "backup not finished",
+			api:         &fakeAPI{backups: []Backup{{Id: 2, Status: "running"}}},
+			wantErr:     true,
+		},
+		{
+			description: "backup not found",
+			api:         &fakeAPI{},
+			wantErr:     true,
+		},
+		{
+			description: "list backups fails",
+			api:         &fakeAPI{fails: true},
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			handler := BackupWaitHandler(context.Background(), tt.api, "pid", "eu01", "iid", 2)
+			got, err := handler.SetTimeout(10 * time.Millisecond).SetThrottle(time.Millisecond).WaitWithContext(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("handler error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(got, tt.expected); !tt.wantErr && diff != "" {
+				t.Fatalf("unexpected backup: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRestoreWaitHandler(t *testing.T) {
+	tests := []struct {
+		description string
+		api         *fakeAPI
+		wantErr     bool
+		expected    *Restore
+	}{
+		{
+			description: "restore finished",
+			api: &fakeAPI{restores: []Restore{
+				{Id: 3, BackupId: 1, Status: "done", FinishedAt: "2024-01-01T01:00:00Z"},
+			}},
+			expected: &Restore{Id: 3, BackupId: 1, Status: "done", FinishedAt: "2024-01-01T01:00:00Z"},
+		},
+		{
+			description: "restore not finished",
+			api:         &fakeAPI{restores: []Restore{{Id: 3, BackupId: 1, Status: "running"}}},
+			wantErr:     true,
+		},
+		{
+			description: "list restores fails",
+			api:         &fakeAPI{fails: true},
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			handler := RestoreWaitHandler(context.Background(), tt.api, "pid", "eu01", "iid", 3)
+			got, err := handler.SetTimeout(10 * time.Millisecond).SetThrottle(time.Millisecond).WaitWithContext(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("handler error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(got, tt.expected); !tt.wantErr && diff != "" {
+				t.Fatalf("unexpected restore: %s", diff)
+			}
+		})
+	}
+}
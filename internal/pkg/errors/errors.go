@@ -187,6 +187,15 @@ To delete it, run:
 To list all profiles, run:
   $ stackit config profile list`
 
+	INVALID_ALIAS_NAME = `the alias name %q is invalid.
+
+The alias name can only contain lowercase letters, numbers, and "-" and cannot be empty. It can't start with a "-".`
+
+	ALIAS_DOES_NOT_EXIST = `the alias %q does not exist.
+
+To list all aliases, run:
+  $ stackit alias list`
+
 	FILE_ALREADY_EXISTS = `file %q already exists in the export path. Delete the existing file or define a different export path`
 
 	FLAG_MUST_BE_PROVIDED_WHEN_ANOTHER_FLAG_IS_SET = `The flag %[1]q must be provided when %[2]q is set`
@@ -516,6 +525,22 @@ func (e *ProfileDoesNotExistError) Error() string {
 	return fmt.Sprintf(PROFILE_DOES_NOT_EXIST, e.Profile)
 }
 
+type InvalidAliasNameError struct {
+	Alias string
+}
+
+func (e *InvalidAliasNameError) Error() string {
+	return fmt.Sprintf(INVALID_ALIAS_NAME, e.Alias)
+}
+
+type AliasDoesNotExistError struct {
+	Alias string
+}
+
+func (e *AliasDoesNotExistError) Error() string {
+	return fmt.Sprintf(ALIAS_DOES_NOT_EXIST, e.Alias)
+}
+
 type FileAlreadyExistsError struct {
 	Filename string
 }
@@ -218,6 +218,17 @@ func FlagWithDefaultToInt32Value(p *print.Printer, cmd *cobra.Command, flag stri
 	return value
 }
 
+// Returns the duration value set on the flag. If no value is set, returns the flag's default value.
+// Returns 0 if the flag value can not be converted to a duration or if the flag does not exist.
+func FlagWithDefaultToDurationValue(p *print.Printer, cmd *cobra.Command, flag string) time.Duration {
+	value, err := cmd.Flags().GetDuration(flag)
+	if err != nil {
+		p.Debug(print.ErrorLevel, "convert flag with default to duration value: %v", err)
+		return 0
+	}
+	return value
+}
+
 // Returns the string value set on the flag. If no value is set, returns the flag's default value.
 // Returns nil if the flag value can not be converted to string or if the flag does not exist.
 func FlagWithDefaultToStringValue(p *print.Printer, cmd *cobra.Command, flag string) string {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -232,3 +233,50 @@ func TestFlagWithDefaultToInt32Value(t *testing.T) {
 		})
 	}
 }
+
+func TestFlagWithDefaultToDurationValue(t *testing.T) {
+	const flagName = "grace-period"
+	tests := []struct {
+		name      string
+		flagValue *string
+		want      time.Duration
+	}{
+		{
+			name:      "flag unset default value",
+			flagValue: nil,
+			want:      time.Minute,
+		},
+		{
+			name:      "flag value",
+			flagValue: utils.Ptr("1h30m"),
+			want:      90 * time.Minute,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := testparams.NewTestParams()
+			cmd := func() *cobra.Command {
+				cmd := &cobra.Command{
+					Use:   "greet",
+					Short: "A simple greeting command",
+					Long:  "A simple greeting command",
+					Run: func(_ *cobra.Command, _ []string) {
+						fmt.Println("Hello world")
+					},
+				}
+				cmd.Flags().Duration(flagName, time.Minute, "grace period")
+				return cmd
+			}()
+			if tt.flagValue != nil {
+				err := cmd.Flags().Set(flagName, *tt.flagValue)
+				if err != nil {
+					t.Error(err)
+				}
+			}
+
+			if got := FlagWithDefaultToDurationValue(params.Printer, cmd, flagName); got != tt.want {
+				t.Errorf("FlagWithDefaultToDurationValue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
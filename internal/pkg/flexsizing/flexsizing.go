@@ -0,0 +1,237 @@
+package flexsizing
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/tables"
+)
+
+const (
+	CPUFlag          = "cpu"
+	RAMFlag          = "ram"
+	StorageFlag      = "storage"
+	FlavorIdFlag     = "flavor-id"
+	StorageClassFlag = "storage-class"
+)
+
+// Option is a flavor of a Flex service, joined with the storages and versions it supports
+type Option struct {
+	FlavorId       string   `json:"flavorId"`
+	CPU            int64    `json:"cpu"`
+	RAM            int64    `json:"ram"`
+	NodeType       string   `json:"nodeType,omitempty"`
+	Description    string   `json:"description,omitempty"`
+	MinStorage     int64    `json:"minStorage"`
+	MaxStorage     int64    `json:"maxStorage"`
+	StorageClasses []string `json:"storageClasses"`
+	Versions       []string `json:"versions"`
+	Smallest       bool     `json:"smallest"`
+}
+
+// Query holds the sizing requirements and the planned flavor, as set by the flags configured by ConfigureFlags
+type Query struct {
+	CPU     *int64
+	RAM     *int64
+	Storage *int64
+
+	// If set, the planned flavor and storage are validated instead of recommending a flavor
+	FlavorId     *string
+	StorageClass *string
+}
+
+// ConfigureFlags adds the flags of the sizing commands to the command
+func ConfigureFlags(cmd *cobra.Command) {
+	cmd.Flags().Int64(CPUFlag, 0, "Minimum number of CPUs")
+	cmd.Flags().Int64(RAMFlag, 0, "Minimum amount of RAM (in GB)")
+	cmd.Flags().Int64(StorageFlag, 0, "Storage size (in GB)")
+	cmd.Flags().String(FlavorIdFlag, "", "ID of a planned flavor. If set, the planned flavor, storage size and storage class are validated instead of recommending a flavor")
+	cmd.Flags().String(StorageClassFlag, "", fmt.Sprintf("Planned storage class. Only relevant when --%s is passed", FlavorIdFlag))
+
+	cmd.MarkFlagsMutuallyExclusive(FlavorIdFlag, CPUFlag)
+	cmd.MarkFlagsMutuallyExclusive(FlavorIdFlag, RAMFlag)
+}
+
+// ParseFlags parses the flags configured by ConfigureFlags
+func ParseFlags(p *print.Printer, cmd *cobra.Command) (*Query, error) {
+	query := &Query{
+		CPU:          flags.FlagToInt64Pointer(p, cmd, CPUFlag),
+		RAM:          flags.FlagToInt64Pointer(p, cmd, RAMFlag),
+		Storage:      flags.FlagToInt64Pointer(p, cmd, StorageFlag),
+		FlavorId:     flags.FlagToStringPointer(p, cmd, FlavorIdFlag),
+		StorageClass: flags.FlagToStringPointer(p, cmd, StorageClassFlag),
+	}
+
+	for flag, value := range map[string]*int64{CPUFlag: query.CPU, RAMFlag: query.RAM, StorageFlag: query.Storage} {
+		if value != nil && *value < 1 {
+			return nil, &errors.FlagValidationError{
+				Flag:    flag,
+				Details: "must be greater than 0",
+			}
+		}
+	}
+	if query.StorageClass != nil && query.FlavorId == nil {
+		return nil, &errors.FlagValidationError{
+			Flag:    StorageClassFlag,
+			Details: fmt.Sprintf("can only be set together with --%s", FlavorIdFlag),
+		}
+	}
+	return query, nil
+}
+
+// Compare validates the planned flavor of the query, if set. Otherwise, it returns the options which fulfill
+// the requirements of the query, see Recommend.
+func Compare(service string, options []Option, query *Query) ([]Option, error) {
+	if query.FlavorId != nil {
+		option, err := ValidatePlan(service, options, *query.FlavorId, query.StorageClass, query.Storage)
+		if err != nil {
+			return nil, err
+		}
+		return []Option{*option}, nil
+	}
+	return Recommend(service, options, query)
+}
+
+// Recommend returns the options which provide at least the CPU and RAM of the query and support its storage size,
+// sorted by size. The smallest option is marked.
+func Recommend(service string, options []Option, query *Query) ([]Option, error) {
+	matching := []Option{}
+	for _, option := range options {
+		if query.CPU != nil && option.CPU < *query.CPU {
+			continue
+		}
+		if query.RAM != nil && option.RAM < *query.RAM {
+			continue
+		}
+		if query.Storage != nil && !supportsStorageSize(option, *query.Storage) {
+			continue
+		}
+		option.Smallest = false
+		matching = append(matching, option)
+	}
+
+	if len(matching) == 0 {
+		return nil, &errors.DatabaseInvalidFlavorError{
+			Service: service,
+			Details: fmt.Sprintf("No flavor provides %s.", describeQuery(query)),
+		}
+	}
+
+	slices.SortStableFunc(matching, func(a, b Option) int {
+		return cmp.Or(
+			cmp.Compare(a.CPU, b.CPU),
+			cmp.Compare(a.RAM, b.RAM),
+			strings.Compare(a.FlavorId, b.FlavorId),
+		)
+	})
+	matching[0].Smallest = true
+	return matching, nil
+}
+
+// ValidatePlan returns the option of the planned flavor, if the flavor exists and supports the storage class and size
+func ValidatePlan(service string, options []Option, flavorId string, storageClass *string, storageSize *int64) (*Option, error) {
+	idx := slices.IndexFunc(options, func(option Option) bool {
+		return strings.EqualFold(option.FlavorId, flavorId)
+	})
+	if idx == -1 {
+		return nil, &errors.DatabaseInvalidFlavorError{
+			Service: service,
+			Details: fmt.Sprintf("You provided flavor ID '%s', which is invalid.", flavorId),
+		}
+	}
+	option := options[idx]
+
+	if storageSize != nil && !supportsStorageSize(option, *storageSize) {
+		return nil, &errors.DatabaseInvalidStorageError{
+			Service:  service,
+			Details:  fmt.Sprintf("You provided storage size '%d', which is invalid. The valid range is %d-%d.", *storageSize, option.MinStorage, option.MaxStorage),
+			FlavorId: option.FlavorId,
+		}
+	}
+	if storageClass != nil && len(option.StorageClasses) > 0 && !slices.ContainsFunc(option.StorageClasses, func(sc string) bool {
+		return strings.EqualFold(sc, *storageClass)
+	}) {
+		return nil, &errors.DatabaseInvalidStorageError{
+			Service:  service,
+			Details:  fmt.Sprintf("You provided storage class '%s', which is invalid.", *storageClass),
+			FlavorId: option.FlavorId,
+		}
+	}
+	return &option, nil
+}
+
+// supportsStorageSize reports whether the storage size is in the range of the option.
+// Options without a known range support every size.
+func supportsStorageSize(option Option, size int64) bool {
+	if option.MaxStorage == 0 {
+		return true
+	}
+	return size >= option.MinStorage && size <= option.MaxStorage
+}
+
+func describeQuery(query *Query) string {
+	requirements := []string{}
+	if query.CPU != nil {
+		requirements = append(requirements, fmt.Sprintf("at least %d CPU", *query.CPU))
+	}
+	if query.RAM != nil {
+		requirements = append(requirements, fmt.Sprintf("at least %d GB RAM", *query.RAM))
+	}
+	if query.Storage != nil {
+		requirements = append(requirements, fmt.Sprintf("%d GB storage", *query.Storage))
+	}
+	if len(requirements) == 0 {
+		return "the requested resources"
+	}
+	return strings.Join(requirements, ", ")
+}
+
+// OutputResult prints the options in one table
+func OutputResult(p *print.Printer, outputFormat string, options []Option) error {
+	return p.OutputResult(outputFormat, options, func() error {
+		if len(options) == 0 {
+			p.Outputf("No flavors found\n")
+			return nil
+		}
+
+		table := tables.NewTable()
+		table.SetTitle("Flavors")
+		table.SetHeader("ID", "CPU", "RAM (GB)", "NODE TYPE", "STORAGE (GB)", "STORAGE CLASSES", "VERSIONS", "SMALLEST")
+		for _, option := range options {
+			storage := "-"
+			if option.MaxStorage != 0 {
+				storage = fmt.Sprintf("%d-%d", option.MinStorage, option.MaxStorage)
+			}
+			smallest := ""
+			if option.Smallest {
+				smallest = "yes"
+			}
+			table.AddRow(
+				option.FlavorId,
+				option.CPU,
+				option.RAM,
+				option.NodeType,
+				storage,
+				strings.Join(option.StorageClasses, "\n"),
+				strings.Join(option.Versions, ", "),
+				smallest,
+			)
+			table.AddSeparator()
+		}
+		// The versions are supported by every flavor
+		table.EnableAutoMergeOnColumns(7)
+
+		err := table.Display(p)
+		if err != nil {
+			return fmt.Errorf("render table: %w", err)
+		}
+		return nil
+	})
+}
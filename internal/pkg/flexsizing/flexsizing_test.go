@@ -0,0 +1,176 @@
+package flexsizing
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	cliErr "github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/testparams"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+)
+
+const testService = "postgresflex"
+
+func fixtureOptions() []Option {
+	return []Option{
+		{FlavorId: "large", CPU: 8, RAM: 32, MinStorage: 5, MaxStorage: 4000, StorageClasses: []string{"premium-perf2"}},
+		{FlavorId: "small", CPU: 2, RAM: 4, MinStorage: 5, MaxStorage: 100, StorageClasses: []string{"premium-perf2"}},
+		{FlavorId: "medium", CPU: 4, RAM: 16, MinStorage: 5, MaxStorage: 1000, StorageClasses: []string{"premium-perf2", "premium-perf6"}},
+		{FlavorId: "medium-ram", CPU: 4, RAM: 32, MinStorage: 5, MaxStorage: 1000},
+	}
+}
+
+func TestRecommend(t *testing.T) {
+	tests := []struct {
+		description string
+		query       *Query
+		isValid     bool
+		expectedIds []string
+	}{
+		{
+			description: "no requirements",
+			query:       &Query{},
+			isValid:     true,
+			expectedIds: []string{"small", "medium", "medium-ram", "large"},
+		},
+		{
+			description: "cpu and ram",
+			query:       &Query{CPU: utils.Ptr(int64(4)), RAM: utils.Ptr(int64(16))},
+			isValid:     true,
+			expectedIds: []string{"medium", "medium-ram", "large"},
+		},
+		{
+			description: "storage",
+			query:       &Query{CPU: utils.Ptr(int64(2)), Storage: utils.Ptr(int64(2000))},
+			isValid:     true,
+			expectedIds: []string{"large"},
+		},
+		{
+			description: "nothing matches",
+			query:       &Query{CPU: utils.Ptr(int64(16))},
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			options, err := Recommend(testService, fixtureOptions(), tt.query)
+			if !tt.isValid {
+				var flavorErr *cliErr.DatabaseInvalidFlavorError
+				if !errors.As(err, &flavorErr) {
+					t.Fatalf("expected DatabaseInvalidFlavorError, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("should not have failed: %v", err)
+			}
+
+			ids := []string{}
+			for i, option := range options {
+				ids = append(ids, option.FlavorId)
+				if option.Smallest != (i == 0) {
+					t.Fatalf("only the first option should be marked as smallest, option %q: %t", option.FlavorId, option.Smallest)
+				}
+			}
+			diff := cmp.Diff(ids, tt.expectedIds)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestValidatePlan(t *testing.T) {
+	tests := []struct {
+		description  string
+		flavorId     string
+		storageClass *string
+		storageSize  *int64
+		expectedErr  any
+	}{
+		{
+			description: "valid flavor",
+			flavorId:    "medium",
+		},
+		{
+			description:  "valid storage",
+			flavorId:     "MEDIUM",
+			storageClass: utils.Ptr("premium-perf6"),
+			storageSize:  utils.Ptr(int64(1000)),
+		},
+		{
+			description:  "unknown storage classes",
+			flavorId:     "medium-ram",
+			storageClass: utils.Ptr("premium-perf6"),
+		},
+		{
+			description: "invalid flavor",
+			flavorId:    "huge",
+			expectedErr: &cliErr.DatabaseInvalidFlavorError{},
+		},
+		{
+			description: "storage size too large",
+			flavorId:    "small",
+			storageSize: utils.Ptr(int64(101)),
+			expectedErr: &cliErr.DatabaseInvalidStorageError{},
+		},
+		{
+			description:  "invalid storage class",
+			flavorId:     "small",
+			storageClass: utils.Ptr("premium-perf6"),
+			expectedErr:  &cliErr.DatabaseInvalidStorageError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			option, err := ValidatePlan(testService, fixtureOptions(), tt.flavorId, tt.storageClass, tt.storageSize)
+			switch tt.expectedErr.(type) {
+			case nil:
+				if err != nil {
+					t.Fatalf("should not have failed: %v", err)
+				}
+				if option == nil {
+					t.Fatalf("option is nil")
+				}
+			case *cliErr.DatabaseInvalidFlavorError:
+				var flavorErr *cliErr.DatabaseInvalidFlavorError
+				if !errors.As(err, &flavorErr) {
+					t.Fatalf("expected DatabaseInvalidFlavorError, got %v", err)
+				}
+			case *cliErr.DatabaseInvalidStorageError:
+				var storageErr *cliErr.DatabaseInvalidStorageError
+				if !errors.As(err, &storageErr) {
+					t.Fatalf("expected DatabaseInvalidStorageError, got %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestOutputResult(t *testing.T) {
+	tests := []struct {
+		description string
+		options     []Option
+	}{
+		{
+			description: "empty",
+		},
+		{
+			description: "options",
+			options:     fixtureOptions(),
+		},
+	}
+	params := testparams.NewTestParams()
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := OutputResult(params.Printer, "", tt.options)
+			if err != nil {
+				t.Fatalf("should not have failed: %v", err)
+			}
+		})
+	}
+}
@@ -18,6 +18,8 @@ const (
 	ProjectIdFlag = "project-id"
 	RegionFlag    = "region"
 
+	IKnowWhatIAmDoingFlag = "i-know-what-i-am-doing"
+
 	DebugVerbosity   = string(print.DebugLevel)
 	InfoVerbosity    = string(print.InfoLevel)
 	WarningVerbosity = string(print.WarningLevel)
@@ -89,6 +91,13 @@ func Configure(flagSet *pflag.FlagSet) error {
 		return fmt.Errorf("bind --%s flag to config: %w", RegionFlag, err)
 	}
 
+	// Hidden, as it should only be used deliberately and is not persisted in the configuration
+	flagSet.Bool(IKnowWhatIAmDoingFlag, false, `If set, the "--assume-yes" flag also skips confirmation prompts in protected profiles and projects`)
+	err = flagSet.MarkHidden(IKnowWhatIAmDoingFlag)
+	if err != nil {
+		return fmt.Errorf("mark --%s flag as hidden: %w", IKnowWhatIAmDoingFlag, err)
+	}
+
 	return nil
 }
 
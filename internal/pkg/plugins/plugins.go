@@ -0,0 +1,176 @@
+package plugins
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/spf13/viper"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/auth"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/config"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+)
+
+const (
+	// ExecutablePrefix is the prefix of the executables which are considered STACKIT CLI plugins
+	ExecutablePrefix = "stackit-"
+
+	// AccessTokenEnvVar is the environment variable containing an access token for the active profile
+	AccessTokenEnvVar = "STACKIT_ACCESS_TOKEN"
+)
+
+type Plugin struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+}
+
+// List returns the plugins found in the directories of the PATH environment variable.
+// If multiple executables provide the same plugin, the first one found in PATH is used, like when running the plugin.
+func List() []Plugin {
+	plugins := []Plugin{}
+	found := map[string]bool{}
+	for _, dir := range filepath.SplitList(os.Getenv("PATH")) {
+		if dir == "" {
+			continue
+		}
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			// Directories in PATH which don't exist or can't be read are skipped, as done by the shell
+			continue
+		}
+		for _, entry := range entries {
+			name, ok := pluginName(entry.Name())
+			if !ok || found[name] || entry.IsDir() {
+				continue
+			}
+			path := filepath.Join(dir, entry.Name())
+			if !isExecutable(path) {
+				continue
+			}
+			found[name] = true
+			plugins = append(plugins, Plugin{
+				Name: name,
+				Path: path,
+			})
+		}
+	}
+	return plugins
+}
+
+// Find returns the path of the executable providing the plugin with the given name.
+// If the plugin is not found, it returns false.
+func Find(name string) (string, bool) {
+	if name == "" || strings.ContainsAny(name, `/\`) || strings.HasPrefix(name, "-") {
+		return "", false
+	}
+	path, err := exec.LookPath(ExecutablePrefix + name)
+	if err != nil {
+		return "", false
+	}
+	return path, true
+}
+
+// Env returns the environment for running a plugin: the current environment extended by the
+// active profile, project ID, region, output format and, if the user is authenticated, a valid access token.
+func Env(p *print.Printer) ([]string, error) {
+	profile, err := config.GetProfile()
+	if err != nil {
+		return nil, fmt.Errorf("get profile: %w", err)
+	}
+
+	vars := map[string]string{
+		config.ProfileEnvVar:                         profile,
+		config.GetEnvVarName(config.ProjectIdKey):    viper.GetString(config.ProjectIdKey),
+		config.GetEnvVarName(config.RegionKey):       viper.GetString(config.RegionKey),
+		config.GetEnvVarName(config.OutputFormatKey): viper.GetString(config.OutputFormatKey),
+	}
+
+	accessToken, err := getAccessToken(p)
+	if err != nil {
+		// Plugins which don't need authentication can still be used
+		p.Debug(print.DebugLevel, "no access token is passed to the plugin: %v", err)
+	} else {
+		vars[AccessTokenEnvVar] = accessToken
+	}
+
+	return buildEnv(os.Environ(), vars), nil
+}
+
+// Run runs the plugin executable with the given arguments and environment, connected to the printer's input and outputs.
+func Run(p *print.Printer, path string, args, env []string) error {
+	cmd := exec.Command(path, args...) //nolint:gosec // the plugin executable is chosen by the user
+	cmd.Env = env
+	cmd.Stdin = p.StdIn
+	cmd.Stdout = p.StdOut
+	cmd.Stderr = p.StdErr
+
+	p.Debug(print.DebugLevel, "running plugin %s with arguments: %s", path, print.BuildDebugStrFromSlice(args))
+	return cmd.Run()
+}
+
+func getAccessToken(p *print.Printer) (string, error) {
+	userSessionExpired, err := auth.UserSessionExpired()
+	if err != nil {
+		return "", fmt.Errorf("check if user session expired: %w", err)
+	}
+	if userSessionExpired {
+		return "", fmt.Errorf("session expired")
+	}
+
+	accessToken, err := auth.GetValidAccessToken(p)
+	if err != nil {
+		return "", fmt.Errorf("get valid access token: %w", err)
+	}
+	return accessToken, nil
+}
+
+// buildEnv sets the variables in the environment env, overwriting existing values. Empty variables are not set.
+func buildEnv(env []string, vars map[string]string) []string {
+	result := make([]string, 0, len(env)+len(vars))
+	for _, entry := range env {
+		key, _, _ := strings.Cut(entry, "=")
+		if _, ok := vars[key]; ok {
+			continue
+		}
+		result = append(result, entry)
+	}
+	for _, key := range utils.SortedKeys(vars) {
+		if vars[key] == "" {
+			continue
+		}
+		result = append(result, fmt.Sprintf("%s=%s", key, vars[key]))
+	}
+	return result
+}
+
+// pluginName returns the plugin name for an executable file name, or false if the file isn't a plugin
+func pluginName(fileName string) (string, bool) {
+	if runtime.GOOS == "windows" {
+		extension := filepath.Ext(fileName)
+		if !strings.EqualFold(extension, ".exe") {
+			return "", false
+		}
+		fileName = strings.TrimSuffix(fileName, extension)
+	}
+	name, ok := strings.CutPrefix(fileName, ExecutablePrefix)
+	if !ok || name == "" {
+		return "", false
+	}
+	return name, true
+}
+
+func isExecutable(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil || info.IsDir() {
+		return false
+	}
+	if runtime.GOOS == "windows" {
+		return true
+	}
+	return info.Mode().Perm()&0o111 != 0
+}
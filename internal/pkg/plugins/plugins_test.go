@@ -0,0 +1,147 @@
+package plugins
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func createExecutable(t *testing.T, dir, name string, perm os.FileMode) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	err := os.WriteFile(path, []byte("#!/bin/sh\n"), perm)
+	if err != nil {
+		t.Fatalf("create executable: %v", err)
+	}
+	return path
+}
+
+func TestList(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugin executables are detected by their extension on Windows")
+	}
+
+	dir1 := t.TempDir()
+	dir2 := t.TempDir()
+	cost := createExecutable(t, dir1, "stackit-cost", 0o700)
+	createExecutable(t, dir2, "stackit-cost", 0o700)
+	naming := createExecutable(t, dir2, "stackit-naming", 0o755)
+	createExecutable(t, dir1, "stackit-not-executable", 0o600)
+	createExecutable(t, dir1, "kubectl-plugin", 0o700)
+	createExecutable(t, dir1, "stackit-", 0o700)
+	err := os.Mkdir(filepath.Join(dir1, "stackit-dir"), 0o750)
+	if err != nil {
+		t.Fatalf("create dir: %v", err)
+	}
+
+	t.Setenv("PATH", dir1+string(os.PathListSeparator)+filepath.Join(dir1, "inexistent")+string(os.PathListSeparator)+dir2)
+
+	expected := []Plugin{
+		{Name: "cost", Path: cost},
+		{Name: "naming", Path: naming},
+	}
+	diff := cmp.Diff(List(), expected)
+	if diff != "" {
+		t.Fatalf("unexpected plugins: %s", diff)
+	}
+}
+
+func TestFind(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugin executables are detected by their extension on Windows")
+	}
+
+	dir := t.TempDir()
+	cost := createExecutable(t, dir, "stackit-cost", 0o700)
+	t.Setenv("PATH", dir)
+
+	tests := []struct {
+		description  string
+		name         string
+		expectedPath string
+		expectedOk   bool
+	}{
+		{
+			description:  "plugin exists",
+			name:         "cost",
+			expectedPath: cost,
+			expectedOk:   true,
+		},
+		{
+			description: "plugin does not exist",
+			name:        "naming",
+		},
+		{
+			description: "empty name",
+			name:        "",
+		},
+		{
+			description: "name with path separator",
+			name:        "../cost",
+		},
+		{
+			description: "flag",
+			name:        "--cost",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			path, ok := Find(tt.name)
+			if ok != tt.expectedOk {
+				t.Fatalf("expected found to be %t, got %t", tt.expectedOk, ok)
+			}
+			if path != tt.expectedPath {
+				t.Fatalf("expected path %q, got %q", tt.expectedPath, path)
+			}
+		})
+	}
+}
+
+func TestBuildEnv(t *testing.T) {
+	tests := []struct {
+		description string
+		env         []string
+		vars        map[string]string
+		expected    []string
+	}{
+		{
+			description: "base",
+			env:         []string{"HOME=/home/user", "PATH=/usr/bin"},
+			vars: map[string]string{
+				"STACKIT_PROJECT_ID": "xxx",
+				"STACKIT_REGION":     "eu01",
+			},
+			expected: []string{"HOME=/home/user", "PATH=/usr/bin", "STACKIT_PROJECT_ID=xxx", "STACKIT_REGION=eu01"},
+		},
+		{
+			description: "overwrite existing values",
+			env:         []string{"STACKIT_PROJECT_ID=yyy", "HOME=/home/user"},
+			vars: map[string]string{
+				"STACKIT_PROJECT_ID": "xxx",
+			},
+			expected: []string{"HOME=/home/user", "STACKIT_PROJECT_ID=xxx"},
+		},
+		{
+			description: "empty values are not set",
+			env:         []string{"STACKIT_OUTPUT_FORMAT=json"},
+			vars: map[string]string{
+				"STACKIT_OUTPUT_FORMAT": "",
+				"STACKIT_REGION":        "eu01",
+			},
+			expected: []string{"STACKIT_REGION=eu01"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			diff := cmp.Diff(buildEnv(tt.env, tt.vars), tt.expected)
+			if diff != "" {
+				t.Fatalf("unexpected env: %s", diff)
+			}
+		})
+	}
+}
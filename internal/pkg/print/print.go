@@ -46,6 +46,7 @@ var (
 	WhiteBold  = color.New(color.FgHiWhite, color.Bold).SprintFunc()
 	RedBold    = color.New(color.FgHiRed, color.Bold).SprintFunc()
 	YellowBold = color.New(color.FgHiYellow, color.Bold).SprintFunc()
+	GreenBold  = color.New(color.FgHiGreen, color.Bold).SprintFunc()
 )
 
 type Printer struct {
@@ -55,6 +56,19 @@ type Printer struct {
 	StdOut    io.Writer
 	StdErr    io.Writer
 	ErrPrefix string
+	// If set, the command is running in a protected context and confirmations are stricter
+	Protection *Protection
+}
+
+// Protection configures the confirmations in a protected context, e.g. a profile or project used for production.
+type Protection struct {
+	// Description of the protected context, shown in the banner, e.g. `project "my-project"`
+	Context string
+	// Returns the text the user has to type to confirm, e.g. the project name.
+	// It is only called when a confirmation is needed, so commands without confirmation don't need to make API calls.
+	ConfirmationText func() (string, error)
+	// If set, auto-confirming prompts via AssumeYes is allowed in the protected context
+	AllowAssumeYes bool
 }
 
 // NewPrinter creates a new printer, including setting up the default logger.
@@ -145,7 +159,13 @@ func (p *Printer) Error(msg string, args ...any) {
 //
 // Returns nil only if the user (explicitly) answers positive.
 // Returns ErrAborted if the user answers negative.
+//
+// In a protected context, the user has to type the confirmation text instead of answering "y",
+// and AssumeYes is ignored unless explicitly allowed.
 func (p *Printer) PromptForConfirmation(prompt string) error {
+	if p.Protection != nil {
+		return p.promptForProtectedConfirmation(prompt)
+	}
 	if p.AssumeYes {
 		p.Warn("Auto-confirming prompt: %q\n", prompt)
 		return nil
@@ -169,6 +189,59 @@ func (p *Printer) PromptForConfirmation(prompt string) error {
 	return fmt.Errorf("max number of wrong inputs")
 }
 
+func (p *Printer) promptForProtectedConfirmation(prompt string) error {
+	mustPrint(fmt.Fprintf(p.StdErr, "%s\n", RedBold(fmt.Sprintf("!!! You are operating in a protected context: %s !!!", p.Protection.Context))))
+	if p.AssumeYes {
+		if p.Protection.AllowAssumeYes {
+			p.Warn("Auto-confirming prompt in a protected context: %q\n", prompt)
+			return nil
+		}
+		p.Warn("Prompts can't be auto-confirmed in a protected context, unless the --i-know-what-i-am-doing flag is set\n")
+	}
+
+	confirmationText, err := p.Protection.ConfirmationText()
+	if err != nil {
+		return fmt.Errorf("get confirmation text: %w", err)
+	}
+	return p.promptForConfirmationText(prompt, confirmationText)
+}
+
+// Prompts the user for a strong confirmation, for operations which can't be undone, e.g. overwriting the data of an instance.
+//
+// The user has to type the confirmation text instead of answering "y".
+// In a protected context, the confirmation text of the context is used instead.
+func (p *Printer) PromptForStrongConfirmation(prompt, confirmationText string) error {
+	if p.Protection != nil {
+		return p.promptForProtectedConfirmation(prompt)
+	}
+	if p.AssumeYes {
+		p.Warn("Auto-confirming prompt: %q\n", prompt)
+		return nil
+	}
+	return p.promptForConfirmationText(prompt, confirmationText)
+}
+
+func (p *Printer) promptForConfirmationText(prompt, confirmationText string) error {
+	question := fmt.Sprintf("%s\nType %q to confirm: ", prompt, confirmationText)
+	reader := bufio.NewReader(p.StdIn)
+	for i := 0; i < 3; i++ {
+		mustPrint(fmt.Fprint(p.StdErr, question))
+		answer, err := reader.ReadString('\n')
+		if err != nil {
+			continue
+		}
+		answer = strings.TrimSpace(answer)
+		if answer == confirmationText {
+			return nil
+		}
+		if answer == "" {
+			return errAborted
+		}
+		mustPrint(fmt.Fprintf(p.StdErr, "The input doesn't match %q\n", confirmationText))
+	}
+	return fmt.Errorf("max number of wrong inputs")
+}
+
 // Prompts the user for confirmation by pressing Enter.
 //
 // Returns nil if the user presses Enter.
@@ -678,6 +678,210 @@ func TestPromptForConfirmation(t *testing.T) {
 	}
 }
 
+func TestPromptForProtectedConfirmation(t *testing.T) {
+	tests := []struct {
+		description     string
+		input           string
+		assumeYes       bool
+		allowAssumeYes  bool
+		confirmationErr bool
+		isValid         bool
+		isAborted       bool
+	}{
+		{
+			description: "confirmation text",
+			input:       "my-project\n",
+			isValid:     true,
+		},
+		{
+			description: "confirmation text with spaces",
+			input:       "  my-project \r\n",
+			isValid:     true,
+		},
+		{
+			description: "yes is not accepted",
+			input:       "y\n",
+			isValid:     false,
+		},
+		{
+			description: "different case is not accepted",
+			input:       "My-Project\n",
+			isValid:     false,
+		},
+		{
+			description: "wrong input and then confirmation text",
+			input:       "my-projct\nmy-project\n",
+			isValid:     true,
+		},
+		{
+			description: "no input",
+			input:       "\n",
+			isValid:     false,
+			isAborted:   true,
+		},
+		{
+			description: "assume yes is ignored",
+			input:       "",
+			assumeYes:   true,
+			isValid:     false,
+		},
+		{
+			description: "assume yes is ignored and confirmation text",
+			input:       "my-project\n",
+			assumeYes:   true,
+			isValid:     true,
+		},
+		{
+			description:    "assume yes is allowed",
+			input:          "",
+			assumeYes:      true,
+			allowAssumeYes: true,
+			isValid:        true,
+		},
+		{
+			description:     "confirmation text error",
+			input:           "my-project\n",
+			confirmationErr: true,
+			isValid:         false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			buffer := &bytes.Buffer{}
+			_, err := buffer.WriteString(tt.input)
+			if err != nil {
+				t.Fatalf("failed to initialize mock input: %v", err)
+			}
+
+			p := &Printer{
+				StdIn:     buffer,
+				StdOut:    io.Discard,
+				StdErr:    io.Discard,
+				Verbosity: DebugLevel,
+				AssumeYes: tt.assumeYes,
+				Protection: &Protection{
+					Context: `project "my-project"`,
+					ConfirmationText: func() (string, error) {
+						if tt.confirmationErr {
+							return "", fmt.Errorf("error")
+						}
+						return "my-project", nil
+					},
+					AllowAssumeYes: tt.allowAssumeYes,
+				},
+			}
+
+			err = p.PromptForConfirmation("")
+
+			if tt.isValid && err != nil {
+				t.Errorf("should not have failed: %v", err)
+			}
+			if !tt.isValid && err == nil {
+				t.Errorf("should have failed")
+			}
+			if tt.isAborted && !errors.Is(err, errAborted) {
+				t.Errorf("should have returned aborted error, instead returned: %v", err)
+			}
+			if !tt.isAborted && errors.Is(err, errAborted) {
+				t.Errorf("should not have returned aborted error")
+			}
+		})
+	}
+}
+
+func TestPromptForStrongConfirmation(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		assumeYes   bool
+		protected   bool
+		isValid     bool
+		isAborted   bool
+	}{
+		{
+			description: "confirmation text",
+			input:       "my-instance\n",
+			isValid:     true,
+		},
+		{
+			description: "yes is not accepted",
+			input:       "y\n",
+			isValid:     false,
+		},
+		{
+			description: "wrong input and then confirmation text",
+			input:       "my-instanc\nmy-instance\n",
+			isValid:     true,
+		},
+		{
+			description: "no input",
+			input:       "\n",
+			isValid:     false,
+			isAborted:   true,
+		},
+		{
+			description: "assume yes",
+			input:       "",
+			assumeYes:   true,
+			isValid:     true,
+		},
+		{
+			description: "protected context uses its confirmation text",
+			input:       "my-project\n",
+			protected:   true,
+			isValid:     true,
+		},
+		{
+			description: "protected context ignores the confirmation text",
+			input:       "my-instance\n",
+			protected:   true,
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			buffer := &bytes.Buffer{}
+			_, err := buffer.WriteString(tt.input)
+			if err != nil {
+				t.Fatalf("failed to initialize mock input: %v", err)
+			}
+
+			p := &Printer{
+				StdIn:     buffer,
+				StdOut:    io.Discard,
+				StdErr:    io.Discard,
+				Verbosity: DebugLevel,
+				AssumeYes: tt.assumeYes,
+			}
+			if tt.protected {
+				p.Protection = &Protection{
+					Context: `project "my-project"`,
+					ConfirmationText: func() (string, error) {
+						return "my-project", nil
+					},
+				}
+			}
+
+			err = p.PromptForStrongConfirmation("", "my-instance")
+
+			if tt.isValid && err != nil {
+				t.Errorf("should not have failed: %v", err)
+			}
+			if !tt.isValid && err == nil {
+				t.Errorf("should have failed")
+			}
+			if tt.isAborted && !errors.Is(err, errAborted) {
+				t.Errorf("should have returned aborted error, instead returned: %v", err)
+			}
+			if !tt.isAborted && errors.Is(err, errAborted) {
+				t.Errorf("should not have returned aborted error")
+			}
+		})
+	}
+}
+
 func TestIsVerbosityDebug(t *testing.T) {
 	tests := []struct {
 		description string
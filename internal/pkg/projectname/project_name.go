@@ -42,7 +42,7 @@ func GetProjectName(ctx context.Context, p *print.Printer, cliVersion string, cm
 
 	// If project ID is set in config, we store the project name in config
 	// (So next time we can just pull it from there)
-	if !isProjectIdSetInFlags(p, cmd) && !isProjectIdSetInEnvVar() {
+	if !isProjectIdSetInFlags(p, cmd) && !isProjectIdSetInEnvVar() && !config.IsSetInProjectConfig(config.ProjectIdKey) {
 		viper.Set(config.ProjectNameKey, projectName)
 		err = config.Write()
 		if err != nil {
@@ -60,9 +60,10 @@ func useProjectNameFromConfig(p *print.Printer, cmd *cobra.Command) bool {
 	// - Project name in the config file is not empty
 	projectIdSetInFlags := isProjectIdSetInFlags(p, cmd)
 	projectIdSetInEnv := isProjectIdSetInEnvVar()
+	projectIdSetInProjectConfig := config.IsSetInProjectConfig(config.ProjectIdKey)
 	projectName := viper.GetString(config.ProjectNameKey)
 	projectNameSet := projectName != ""
-	return !projectIdSetInFlags && !projectIdSetInEnv && projectNameSet
+	return !projectIdSetInFlags && !projectIdSetInEnv && !projectIdSetInProjectConfig && projectNameSet
 }
 
 func isProjectIdSetInFlags(p *print.Printer, cmd *cobra.Command) bool {
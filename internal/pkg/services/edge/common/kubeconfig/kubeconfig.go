@@ -8,6 +8,8 @@ import (
 	"path/filepath"
 
 	"k8s.io/client-go/tools/clientcmd"
+
+	skeUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/ske/utils"
 )
 
 // Validation constants taken from OpenApi spec.
@@ -26,6 +28,52 @@ const (
 	ExpirationFlag = "expiration"
 )
 
+// Exec plugin settings of login kubeconfigs, which obtain their credentials via "stackit beta edge-cloud kubeconfig login"
+const (
+	loginExecAPIVersion      = "client.authentication.k8s.io/v1"
+	loginExecCommand         = "stackit"
+	loginExecInteractiveMode = "Never"
+)
+
+// ToLoginKubeconfig returns a copy of the kubeconfig in which the credentials of all users are replaced
+// by an exec plugin calling "stackit beta edge-cloud kubeconfig login" for the given instance.
+// The returned kubeconfig therefore doesn't contain any credentials.
+func ToLoginKubeconfig(kubeconfig map[string]interface{}, projectId, region, instanceId string) (map[string]interface{}, error) {
+	users, ok := kubeconfig["users"].([]interface{})
+	if !ok || len(users) == 0 {
+		return nil, fmt.Errorf("no users found in kubeconfig")
+	}
+
+	exec := map[string]interface{}{
+		"apiVersion": loginExecAPIVersion,
+		"command":    loginExecCommand,
+		"args": []interface{}{
+			"beta", "edge-cloud", "kubeconfig", "login",
+			"--project-id", projectId,
+			"--region", region,
+			"--instance-id", instanceId,
+		},
+		"interactiveMode": loginExecInteractiveMode,
+	}
+
+	loginUsers := make([]interface{}, 0, len(users))
+	for _, u := range users {
+		user, ok := u.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("invalid user in kubeconfig")
+		}
+		loginUser := maps.Clone(user)
+		loginUser["user"] = map[string]interface{}{
+			"exec": exec,
+		}
+		loginUsers = append(loginUsers, loginUser)
+	}
+
+	loginKubeconfig := maps.Clone(kubeconfig)
+	loginKubeconfig["users"] = loginUsers
+	return loginKubeconfig, nil
+}
+
 func ValidateExpiration(expiration *uint64) error {
 	if expiration != nil {
 		// We're using utils.ConvertToSeconds to convert the user input string to seconds, which is using
@@ -123,35 +171,13 @@ func mergeKubeconfig(filePath *string, data string, switchContext bool) error {
 	}
 
 	// Load and validate the data into a kubeconfig object
-	newConfig, err := clientcmd.Load([]byte(data))
-	if err != nil {
-		return &LoadKubeconfigError{Err: err}
-	}
-
-	// If the destination kubeconfig does not exist, create a new one. IsNotExist will ignore other errors.
-	// Other errors are handled separately by the following clientcmd.LoadFromFile clientcmd.LoadFromFile
-	if _, err := os.Stat(path); os.IsNotExist(err) {
-		return writeKubeconfig(&path, data)
-	}
-
-	// If the file exists load and validate the existing kubeconfig into a config object
-	existingConfig, err := clientcmd.LoadFromFile(path)
-	if err != nil {
+	if _, err := clientcmd.Load([]byte(data)); err != nil {
 		return &LoadKubeconfigError{Err: err}
 	}
 
-	// Merge the new kubeconfig data into the existing config object
-	maps.Copy(existingConfig.AuthInfos, newConfig.AuthInfos)
-	maps.Copy(existingConfig.Clusters, newConfig.Clusters)
-	maps.Copy(existingConfig.Contexts, newConfig.Contexts)
-
-	// If no CurrentContext is set or switchContext is true, set the CurrentContext to the CurrentContext of the new kubeconfig
-	if newConfig.CurrentContext != "" && (switchContext || existingConfig.CurrentContext == "") {
-		existingConfig.CurrentContext = newConfig.CurrentContext
-	}
-
-	// Save the merged config to the file, creating missing directories as needed.
-	if err := clientcmd.WriteToFile(*existingConfig, path); err != nil {
+	// The merge itself is shared with SKE, so that the contexts of Edge Cloud instances and SKE clusters
+	// live side by side in the same kubeconfig file
+	if err := skeUtils.MergeKubeConfig(path, data, switchContext); err != nil {
 		return &WriteKubeconfigError{Err: err}
 	}
 
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"k8s.io/client-go/tools/clientcmd"
 
 	testUtils "github.com/stackitcloud/stackit-cli/internal/pkg/testutils"
@@ -175,6 +176,93 @@ func TestValidateExpiration(t *testing.T) {
 	}
 }
 
+func TestToLoginKubeconfig(t *testing.T) {
+	fixtureKubeconfig := func(mods ...func(kubeconfig map[string]interface{})) map[string]interface{} {
+		kubeconfig := map[string]interface{}{
+			"apiVersion":      "v1",
+			"kind":            "Config",
+			"current-context": "context-1",
+			"users": []interface{}{
+				map[string]interface{}{
+					"name": "user-1",
+					"user": map[string]interface{}{
+						"token": "token",
+					},
+				},
+			},
+		}
+		for _, mod := range mods {
+			mod(kubeconfig)
+		}
+		return kubeconfig
+	}
+
+	tests := []struct {
+		name       string
+		kubeconfig map[string]interface{}
+		want       map[string]interface{}
+		wantErr    bool
+	}{
+		{
+			name:       "replaces credentials",
+			kubeconfig: fixtureKubeconfig(),
+			want: fixtureKubeconfig(func(kubeconfig map[string]interface{}) {
+				kubeconfig["users"] = []interface{}{
+					map[string]interface{}{
+						"name": "user-1",
+						"user": map[string]interface{}{
+							"exec": map[string]interface{}{
+								"apiVersion": "client.authentication.k8s.io/v1",
+								"command":    "stackit",
+								"args": []interface{}{
+									"beta", "edge-cloud", "kubeconfig", "login",
+									"--project-id", "pid",
+									"--region", "eu01",
+									"--instance-id", "iid",
+								},
+								"interactiveMode": "Never",
+							},
+						},
+					},
+				}
+			}),
+		},
+		{
+			name: "no users",
+			kubeconfig: fixtureKubeconfig(func(kubeconfig map[string]interface{}) {
+				delete(kubeconfig, "users")
+			}),
+			wantErr: true,
+		},
+		{
+			name: "invalid user",
+			kubeconfig: fixtureKubeconfig(func(kubeconfig map[string]interface{}) {
+				kubeconfig["users"] = []interface{}{"user-1"}
+			}),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := fixtureKubeconfig()
+			got, err := ToLoginKubeconfig(tt.kubeconfig, "pid", "eu01", "iid")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ToLoginKubeconfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if diff := cmp.Diff(got, tt.want); diff != "" {
+				t.Errorf("ToLoginKubeconfig() mismatch (-got +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.kubeconfig, original); diff != "" {
+				t.Errorf("ToLoginKubeconfig() modified the input kubeconfig:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestErrors(t *testing.T) {
 	type args struct {
 		err error
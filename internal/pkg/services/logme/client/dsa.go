@@ -0,0 +1,20 @@
+package client
+
+import (
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dsa"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	logmeUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/logme/utils"
+)
+
+// DSAService describes LogMe for the commands shared by the Data Service Access services
+var DSAService = &dsa.Service{
+	Name: "LogMe",
+	Cmd:  "logme",
+	ConfigureClient: func(p *print.Printer, cliVersion string) (dsa.API, error) {
+		apiClient, err := ConfigureClient(p, cliVersion)
+		if err != nil {
+			return nil, err
+		}
+		return logmeUtils.NewDSAAPI(apiClient.DefaultAPI), nil
+	},
+}
@@ -0,0 +1,20 @@
+package client
+
+import (
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dsa"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	mariadbUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/mariadb/utils"
+)
+
+// DSAService describes MariaDB for the commands shared by the Data Service Access services
+var DSAService = &dsa.Service{
+	Name: "MariaDB",
+	Cmd:  "mariadb",
+	ConfigureClient: func(p *print.Printer, cliVersion string) (dsa.API, error) {
+		apiClient, err := ConfigureClient(p, cliVersion)
+		if err != nil {
+			return nil, err
+		}
+		return mariadbUtils.NewDSAAPI(apiClient.DefaultAPI), nil
+	},
+}
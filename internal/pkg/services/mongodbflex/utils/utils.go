@@ -6,12 +6,17 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"time"
 
 	"golang.org/x/mod/semver"
 
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flexsizing"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
 
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
 	mongodbflex "github.com/stackitcloud/stackit-sdk-go/services/mongodbflex/v2api"
+	mongodbflexWait "github.com/stackitcloud/stackit-sdk-go/services/mongodbflex/v2api/wait"
 )
 
 // The number of replicas is enforced by the API according to the instance type
@@ -21,6 +26,10 @@ var instanceTypeToReplicas = map[string]int32{
 	"Sharded": 9,
 }
 
+// The restore job isn't listed right after it was triggered.
+// If it isn't listed within this period, waiting for it fails.
+var restoreJobListedGracePeriod = 2 * time.Minute
+
 type MongoDBFlexClient interface {
 	ListVersions(ctx context.Context, projectId, region string) mongodbflex.ApiListVersionsRequest
 	GetInstance(ctx context.Context, projectId, instanceId, region string) mongodbflex.ApiGetInstanceRequest
@@ -123,6 +132,33 @@ func LoadFlavorId(cpu, ram int32, flavors *[]mongodbflex.InstanceFlavor) (*strin
 	}
 }
 
+// SizingOptions joins the flavors with their storages, keyed by flavor ID, and the supported versions
+func SizingOptions(flavors []mongodbflex.InstanceFlavor, storages map[string]*mongodbflex.ListStoragesResponse, versions []string) []flexsizing.Option {
+	options := []flexsizing.Option{}
+	for _, f := range flavors {
+		if f.Id == nil {
+			continue
+		}
+		option := flexsizing.Option{
+			FlavorId:    *f.Id,
+			CPU:         int64(utils.PtrValue(f.Cpu)),
+			RAM:         int64(utils.PtrValue(f.Memory)),
+			NodeType:    strings.Join(f.Categories, ", "),
+			Description: utils.PtrString(f.Description),
+			Versions:    versions,
+		}
+		if storage, ok := storages[*f.Id]; ok && storage != nil {
+			option.StorageClasses = storage.StorageClasses
+			if storage.StorageRange != nil {
+				option.MinStorage = utils.PtrValue(storage.StorageRange.Min)
+				option.MaxStorage = utils.PtrValue(storage.StorageRange.Max)
+			}
+		}
+		options = append(options, option)
+	}
+	return options
+}
+
 func GetLatestMongoDBVersion(ctx context.Context, apiClient MongoDBFlexClient, projectId, region string) (string, error) {
 	resp, err := apiClient.ListVersions(ctx, projectId, region).Execute()
 	if err != nil {
@@ -182,3 +218,52 @@ func GetRestoreStatus(backupId string, restoreJobs *mongodbflex.ListRestoreJobsR
 	}
 	return state
 }
+
+// GetRestoreJob returns the restore job with the given ID
+func GetRestoreJob(restoreJobId string, restoreJobs *mongodbflex.ListRestoreJobsResponse) (*mongodbflex.RestoreInstanceStatus, error) {
+	if restoreJobs == nil {
+		return nil, fmt.Errorf("nil restore jobs")
+	}
+	for i := range restoreJobs.Items {
+		if utils.PtrValue(restoreJobs.Items[i].Id) == restoreJobId {
+			return &restoreJobs.Items[i], nil
+		}
+	}
+	return nil, fmt.Errorf("restore job %q not found", restoreJobId)
+}
+
+// RestoreJobWaitHandler waits until the restore job with the given ID is finished.
+// Unlike the SDK handler, it tracks a single job, so earlier restores of the same backup are not mistaken for it
+func RestoreJobWaitHandler(ctx context.Context, apiClient MongoDBFlexClient, projectId, instanceId, restoreJobId, region string) *wait.AsyncActionHandler[mongodbflex.RestoreInstanceStatus] {
+	startedAt := time.Now()
+	seen := false
+	handler := wait.New(func() (waitFinished bool, response *mongodbflex.RestoreInstanceStatus, err error) {
+		resp, err := apiClient.ListRestoreJobs(ctx, projectId, instanceId, region).Execute()
+		if err != nil {
+			return false, nil, err
+		}
+		restoreJob, err := GetRestoreJob(restoreJobId, resp)
+		if err != nil {
+			switch {
+			case seen:
+				// The restore job isn't listed anymore once it was removed
+				return true, nil, nil
+			case time.Since(startedAt) < restoreJobListedGracePeriod:
+				return false, nil, nil
+			default:
+				return true, nil, fmt.Errorf("restore job %q wasn't listed within %s", restoreJobId, restoreJobListedGracePeriod)
+			}
+		}
+		seen = true
+		switch utils.PtrValue(restoreJob.Status) {
+		case mongodbflexWait.RestoreJobFinished:
+			return true, restoreJob, nil
+		case mongodbflexWait.RestoreJobBroken, mongodbflexWait.RestoreJobKilled:
+			return true, restoreJob, fmt.Errorf("restore job %q failed with status %s", restoreJobId, *restoreJob.Status)
+		default:
+			return false, restoreJob, nil
+		}
+	})
+	handler.SetTimeout(45 * time.Minute)
+	return handler
+}
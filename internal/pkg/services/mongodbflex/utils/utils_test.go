@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
 
@@ -37,6 +38,12 @@ func newAPIClientMock(m clientMockSettings) mongodbflex.DefaultAPI {
 			if m.listRestoreJobsFails {
 				return nil, fmt.Errorf("could not list versions")
 			}
+			if len(m.listRestoreJobsResps) > 0 {
+				// The last response is repeated
+				resp := m.listRestoreJobsResps[min(m.listRestoreJobsCallCount, len(m.listRestoreJobsResps)-1)]
+				m.listRestoreJobsCallCount++
+				return resp, nil
+			}
 			return m.listRestoreJobsResp, nil
 		}),
 		GetInstanceExecuteMock: utils.Ptr(func(_ mongodbflex.ApiGetInstanceRequest) (*mongodbflex.InstanceResponse, error) {
@@ -55,14 +62,16 @@ func newAPIClientMock(m clientMockSettings) mongodbflex.DefaultAPI {
 }
 
 type clientMockSettings struct {
-	listVersionsFails    bool
-	listVersionsResp     *mongodbflex.ListVersionsResponse
-	getInstanceFails     bool
-	getInstanceResp      *mongodbflex.InstanceResponse
-	getUserFails         bool
-	getUserResp          *mongodbflex.GetUserResponse
-	listRestoreJobsFails bool
-	listRestoreJobsResp  *mongodbflex.ListRestoreJobsResponse
+	listVersionsFails        bool
+	listVersionsResp         *mongodbflex.ListVersionsResponse
+	getInstanceFails         bool
+	getInstanceResp          *mongodbflex.InstanceResponse
+	getUserFails             bool
+	getUserResp              *mongodbflex.GetUserResponse
+	listRestoreJobsFails     bool
+	listRestoreJobsResp      *mongodbflex.ListRestoreJobsResponse
+	listRestoreJobsResps     []*mongodbflex.ListRestoreJobsResponse
+	listRestoreJobsCallCount int
 }
 
 func TestValidateStorage(t *testing.T) {
@@ -698,3 +707,155 @@ func TestGetInstanceType(t *testing.T) {
 		})
 	}
 }
+
+func TestGetRestoreJob(t *testing.T) {
+	restoreJobs := &mongodbflex.ListRestoreJobsResponse{
+		Items: []mongodbflex.RestoreInstanceStatus{
+			{Id: utils.Ptr("job-1"), Status: utils.Ptr("FINISHED")},
+			{Id: utils.Ptr("job-2"), Status: utils.Ptr("IN_PROGRESS")},
+		},
+	}
+
+	tests := []struct {
+		description    string
+		restoreJobId   string
+		restoreJobs    *mongodbflex.ListRestoreJobsResponse
+		isValid        bool
+		expectedOutput *mongodbflex.RestoreInstanceStatus
+	}{
+		{
+			description:    "base",
+			restoreJobId:   "job-2",
+			restoreJobs:    restoreJobs,
+			isValid:        true,
+			expectedOutput: &restoreJobs.Items[1],
+		},
+		{
+			description:  "not found",
+			restoreJobId: "job-3",
+			restoreJobs:  restoreJobs,
+			isValid:      false,
+		},
+		{
+			description:  "nil restore jobs",
+			restoreJobId: "job-1",
+			isValid:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := GetRestoreJob(tt.restoreJobId, tt.restoreJobs)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("did not fail on invalid input")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			diff := cmp.Diff(output, tt.expectedOutput)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRestoreJobWaitHandler(t *testing.T) {
+	fixtureResponse := func(status string) *mongodbflex.ListRestoreJobsResponse {
+		return &mongodbflex.ListRestoreJobsResponse{
+			Items: []mongodbflex.RestoreInstanceStatus{
+				{Id: utils.Ptr("other-job"), BackupID: utils.Ptr(testBackupId), Status: utils.Ptr("FINISHED")},
+				{Id: utils.Ptr("job"), BackupID: utils.Ptr(testBackupId), Status: utils.Ptr(status)},
+			},
+		}
+	}
+	notListedResponse := &mongodbflex.ListRestoreJobsResponse{}
+
+	tests := []struct {
+		description                 string
+		listRestoreJobsFail         bool
+		listRestoreJobsResps        []*mongodbflex.ListRestoreJobsResponse
+		restoreJobListedGracePeriod time.Duration
+		isValid                     bool
+		expectedOutput              bool
+	}{
+		{
+			description:          "finished",
+			listRestoreJobsResps: []*mongodbflex.ListRestoreJobsResponse{fixtureResponse("FINISHED")},
+			isValid:              true,
+			expectedOutput:       true,
+		},
+		{
+			description:                 "finished after being listed",
+			listRestoreJobsResps:        []*mongodbflex.ListRestoreJobsResponse{notListedResponse, fixtureResponse("RUNNING"), fixtureResponse("FINISHED")},
+			restoreJobListedGracePeriod: time.Hour,
+			isValid:                     true,
+			expectedOutput:              true,
+		},
+		{
+			description:                 "removed after being listed",
+			listRestoreJobsResps:        []*mongodbflex.ListRestoreJobsResponse{fixtureResponse("RUNNING"), notListedResponse},
+			restoreJobListedGracePeriod: time.Hour,
+			isValid:                     true,
+		},
+		{
+			description:                 "never listed",
+			listRestoreJobsResps:        []*mongodbflex.ListRestoreJobsResponse{notListedResponse},
+			restoreJobListedGracePeriod: 0,
+			isValid:                     false,
+		},
+		{
+			description:          "broken",
+			listRestoreJobsResps: []*mongodbflex.ListRestoreJobsResponse{fixtureResponse("BROKEN")},
+			isValid:              false,
+		},
+		{
+			description:          "killed",
+			listRestoreJobsResps: []*mongodbflex.ListRestoreJobsResponse{fixtureResponse("KILLED")},
+			isValid:              false,
+		},
+		{
+			description:         "list restore jobs fails",
+			listRestoreJobsFail: true,
+			isValid:             false,
+		},
+	}
+
+	defaultRestoreJobListedGracePeriod := restoreJobListedGracePeriod
+	t.Cleanup(func() {
+		restoreJobListedGracePeriod = defaultRestoreJobListedGracePeriod
+	})
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			settings := clientMockSettings{
+				listRestoreJobsFails: tt.listRestoreJobsFail,
+				listRestoreJobsResps: tt.listRestoreJobsResps,
+			}
+			restoreJobListedGracePeriod = tt.restoreJobListedGracePeriod
+
+			handler := RestoreJobWaitHandler(context.Background(), newAPIClientMock(settings), testProjectId, testInstanceId, "job", testRegion)
+			handler.SetSleepBeforeWait(0).SetThrottle(10 * time.Millisecond).SetTimeout(time.Second).SetTempErrRetryLimit(0)
+
+			output, err := handler.WaitWithContext(context.Background())
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("did not fail on invalid input")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			if !tt.expectedOutput {
+				return
+			}
+			if utils.PtrValue(output.Id) != "job" {
+				t.Fatalf("expected restore job %q, got %q", "job", utils.PtrValue(output.Id))
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package client
+
+import (
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dsa"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	opensearchUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/opensearch/utils"
+)
+
+// DSAService describes OpenSearch for the commands shared by the Data Service Access services
+var DSAService = &dsa.Service{
+	Name: "OpenSearch",
+	Cmd:  "opensearch",
+	ConfigureClient: func(p *print.Printer, cliVersion string) (dsa.API, error) {
+		apiClient, err := ConfigureClient(p, cliVersion)
+		if err != nil {
+			return nil, err
+		}
+		return opensearchUtils.NewDSAAPI(apiClient.DefaultAPI), nil
+	},
+}
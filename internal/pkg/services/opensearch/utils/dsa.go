@@ -0,0 +1,78 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	opensearch "github.com/stackitcloud/stackit-sdk-go/services/opensearch/v2api"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dsa"
+)
+
+type dsaAPI struct {
+	apiClient opensearch.DefaultAPI
+}
+
+// NewDSAAPI returns the OpenSearch API as the API shared by the Data Service Access services
+func NewDSAAPI(apiClient opensearch.DefaultAPI) dsa.API {
+	return &dsaAPI{apiClient: apiClient}
+}
+
+func (a *dsaAPI) GetInstanceName(ctx context.Context, projectId, region, instanceId string) (string, error) {
+	return GetInstanceName(ctx, a.apiClient, projectId, region, instanceId)
+}
+
+func (a *dsaAPI) ListBackups(ctx context.Context, projectId, region, instanceId string) ([]dsa.Backup, error) {
+	resp, err := a.apiClient.ListBackups(ctx, projectId, region, instanceId).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("list OpenSearch backups: %w", err)
+	}
+	if resp == nil {
+		return nil, fmt.Errorf("list OpenSearch backups: empty response")
+	}
+	return dsa.Convert[[]dsa.Backup](resp.InstanceBackups)
+}
+
+func (a *dsaAPI) CreateBackup(ctx context.Context, projectId, region, instanceId string) ([]dsa.CreatedBackup, error) {
+	resp, err := a.apiClient.CreateBackup(ctx, projectId, region, instanceId).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("create OpenSearch backup: %w", err)
+	}
+	return dsa.Convert[[]dsa.CreatedBackup](resp)
+}
+
+func (a *dsaAPI) TriggerRestore(ctx context.Context, projectId, region, instanceId string, backupId int32) (*dsa.TriggeredRestore, error) {
+	resp, err := a.apiClient.TriggerRestore(ctx, projectId, region, instanceId, backupId).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("trigger OpenSearch restore: %w", err)
+	}
+	return dsa.Convert[*dsa.TriggeredRestore](resp)
+}
+
+func (a *dsaAPI) ListRestores(ctx context.Context, projectId, region, instanceId string) ([]dsa.Restore, error) {
+	resp, err := a.apiClient.ListRestores(ctx, projectId, region, instanceId).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("list OpenSearch restores: %w", err)
+	}
+	if resp == nil {
+		return nil, fmt.Errorf("list OpenSearch restores: empty response")
+	}
+	return dsa.Convert[[]dsa.Restore](resp.InstanceRestores)
+}
+
+func (a *dsaAPI) DownloadBackup(ctx context.Context, projectId, region, instanceId string, backupId int32) (*os.File, error) {
+	file, err := a.apiClient.DownloadBackup(ctx, projectId, region, instanceId, backupId).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("download OpenSearch backup: %w", err)
+	}
+	return file, nil
+}
+
+func (a *dsaAPI) GetMetrics(ctx context.Context, projectId, region, instanceId string) (*dsa.Metrics, error) {
+	resp, err := a.apiClient.GetMetrics(ctx, projectId, region, instanceId).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get OpenSearch metrics: %w", err)
+	}
+	return dsa.Convert[*dsa.Metrics](resp)
+}
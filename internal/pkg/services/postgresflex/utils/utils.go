@@ -6,6 +6,7 @@ import (
 	"slices"
 
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flexsizing"
 
 	postgresflex "github.com/stackitcloud/stackit-sdk-go/services/postgresflex/v3api"
 	"golang.org/x/mod/semver"
@@ -47,6 +48,40 @@ func LoadFlavorId(cpu, ram int64, flavors []postgresflex.ListFlavors) (*string,
 	}
 }
 
+// SizingOptions joins the flavors with their storages and the supported versions
+func SizingOptions(flavors []postgresflex.ListFlavors, versions []postgresflex.Version) []flexsizing.Option {
+	versionNames := []string{}
+	for _, v := range versions {
+		versionNames = append(versionNames, v.Version)
+	}
+
+	options := []flexsizing.Option{}
+	for _, f := range flavors {
+		storageClasses := []string{}
+		for _, sc := range f.StorageClasses {
+			storageClasses = append(storageClasses, sc.Class)
+		}
+		options = append(options, flexsizing.Option{
+			FlavorId:       f.Id,
+			CPU:            f.Cpu,
+			RAM:            f.Memory,
+			NodeType:       f.NodeType,
+			Description:    f.Description,
+			MinStorage:     int64(f.MinGB),
+			MaxStorage:     int64(f.MaxGB),
+			StorageClasses: storageClasses,
+			Versions:       versionNames,
+		})
+	}
+	return options
+}
+
+// ValidatePlan validates the flavor, storage class and storage size of a planned instance
+func ValidatePlan(flavors []postgresflex.ListFlavors, flavorId string, storageClass *string, storageSize *int64) error {
+	_, err := flexsizing.ValidatePlan("postgresflex", SizingOptions(flavors, nil), flavorId, storageClass, storageSize)
+	return err
+}
+
 func GetLatestPostgreSQLVersion(ctx context.Context, apiClient postgresflex.DefaultAPI, projectId, region string) (string, error) {
 	resp, err := apiClient.ListVersions(ctx, projectId, region).Execute()
 	if err != nil {
@@ -76,6 +111,21 @@ func GetInstanceName(ctx context.Context, apiClient postgresflex.DefaultAPI, pro
 	return resp.Name, nil
 }
 
+// InstancePort is the port on which PostgreSQL Flex instances accept connections. It isn't returned by the API.
+const InstancePort = "5432"
+
+// GetInstanceAddress returns the host under which the users of the instance can connect to it
+func GetInstanceAddress(ctx context.Context, apiClient postgresflex.DefaultAPI, projectId, region, instanceId string) (string, error) {
+	resp, err := apiClient.GetInstance(ctx, projectId, region, instanceId).Execute()
+	if err != nil {
+		return "", fmt.Errorf("get PostgreSQL Flex instance: %w", err)
+	}
+	if resp == nil || resp.Network.InstanceAddress == nil || *resp.Network.InstanceAddress == "" {
+		return "", fmt.Errorf("the PostgreSQL Flex instance has no address")
+	}
+	return *resp.Network.InstanceAddress, nil
+}
+
 func GetUserName(ctx context.Context, apiClient postgresflex.DefaultAPI, projectId, region, instanceId string, userId int64) (string, error) {
 	resp, err := apiClient.GetUser(ctx, projectId, region, instanceId, userId).Execute()
 	if err != nil {
@@ -259,6 +259,68 @@ func TestGetInstanceName(t *testing.T) {
 	}
 }
 
+func TestGetInstanceAddress(t *testing.T) {
+	tests := []struct {
+		description        string
+		mockClientSettings mockSettings
+		isValid            bool
+		expectedOutput     string
+	}{
+		{
+			description: "base",
+			mockClientSettings: mockSettings{
+				getInstanceResp: &postgresflex.GetInstanceResponse{
+					Network: postgresflex.InstanceNetwork{
+						InstanceAddress: utils.Ptr("host"),
+					},
+				},
+			},
+			isValid:        true,
+			expectedOutput: "host",
+		},
+		{
+			description: "no address",
+			mockClientSettings: mockSettings{
+				getInstanceResp: &postgresflex.GetInstanceResponse{},
+			},
+			isValid: false,
+		},
+		{
+			description: "empty response",
+			mockClientSettings: mockSettings{
+				getInstanceResp: nil,
+			},
+			isValid: false,
+		},
+		{
+			description: "get instance fails",
+			mockClientSettings: mockSettings{
+				getInstanceFails: true,
+			},
+			isValid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := GetInstanceAddress(context.Background(), newAPIMockClient(tt.mockClientSettings), testProjectId, testRegion, testInstanceId)
+
+			if tt.isValid && err != nil {
+				t.Errorf("failed on valid input")
+			}
+			if !tt.isValid && err == nil {
+				t.Errorf("did not fail on invalid input")
+			}
+			if !tt.isValid {
+				return
+			}
+			if output != tt.expectedOutput {
+				t.Errorf("expected output to be %s, got %s", tt.expectedOutput, output)
+			}
+		})
+	}
+}
+
 func TestGetUserName(t *testing.T) {
 	tests := []struct {
 		description        string
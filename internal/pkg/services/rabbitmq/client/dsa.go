@@ -0,0 +1,20 @@
+package client
+
+import (
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dsa"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	rabbitmqUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/rabbitmq/utils"
+)
+
+// DSAService describes RabbitMQ for the commands shared by the Data Service Access services
+var DSAService = &dsa.Service{
+	Name: "RabbitMQ",
+	Cmd:  "rabbitmq",
+	ConfigureClient: func(p *print.Printer, cliVersion string) (dsa.API, error) {
+		apiClient, err := ConfigureClient(p, cliVersion)
+		if err != nil {
+			return nil, err
+		}
+		return rabbitmqUtils.NewDSAAPI(apiClient.DefaultAPI), nil
+	},
+}
@@ -0,0 +1,20 @@
+package client
+
+import (
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dsa"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+	redisUtils "github.com/stackitcloud/stackit-cli/internal/pkg/services/redis/utils"
+)
+
+// DSAService describes Redis for the commands shared by the Data Service Access services
+var DSAService = &dsa.Service{
+	Name: "Redis",
+	Cmd:  "redis",
+	ConfigureClient: func(p *print.Printer, cliVersion string) (dsa.API, error) {
+		apiClient, err := ConfigureClient(p, cliVersion)
+		if err != nil {
+			return nil, err
+		}
+		return redisUtils.NewDSAAPI(apiClient.DefaultAPI), nil
+	},
+}
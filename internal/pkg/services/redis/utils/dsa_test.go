@@ -0,0 +1,182 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	redis "github.com/stackitcloud/stackit-sdk-go/services/redis/v2api"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dsa"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+)
+
+type dsaMockSettings struct {
+	fails              bool
+	listBackupsResp    *redis.ListBackupsResponse
+	createBackupResp   []redis.CreateBackupResponseItem
+	triggerRestoreResp *redis.TriggerRestoreResponse
+	getMetricsResp     *redis.GetMetricsResponse
+}
+
+func newDSAAPIMock(settings *dsaMockSettings) redis.DefaultAPI {
+	return &redis.DefaultAPIServiceMock{
+		ListBackupsExecuteMock: utils.Ptr(func(_ redis.ApiListBackupsRequest) (*redis.ListBackupsResponse, error) {
+			if settings.fails {
+				return nil, fmt.Errorf("could not list backups")
+			}
+			return settings.listBackupsResp, nil
+		}),
+		CreateBackupExecuteMock: utils.Ptr(func(_ redis.ApiCreateBackupRequest) ([]redis.CreateBackupResponseItem, error) {
+			if settings.fails {
+				return nil, fmt.Errorf("could not create backup")
+			}
+			return settings.createBackupResp, nil
+		}),
+		TriggerRestoreExecuteMock: utils.Ptr(func(_ redis.ApiTriggerRestoreRequest) (*redis.TriggerRestoreResponse, error) {
+			if settings.fails {
+				return nil, fmt.Errorf("could not trigger restore")
+			}
+			return settings.triggerRestoreResp, nil
+		}),
+		GetMetricsExecuteMock: utils.Ptr(func(_ redis.ApiGetMetricsRequest) (*redis.GetMetricsResponse, error) {
+			if settings.fails {
+				return nil, fmt.Errorf("could not get metrics")
+			}
+			return settings.getMetricsResp, nil
+		}),
+	}
+}
+
+func TestDSAAPIListBackups(t *testing.T) {
+	tests := []struct {
+		description    string
+		settings       *dsaMockSettings
+		isValid        bool
+		expectedOutput []dsa.Backup
+	}{
+		{
+			description: "base",
+			settings: &dsaMockSettings{
+				listBackupsResp: &redis.ListBackupsResponse{
+					InstanceBackups: []redis.Backup{
+						{
+							Downloadable: utils.Ptr(true),
+							FinishedAt:   "2024-01-01T01:00:00Z",
+							Id:           1,
+							Size:         utils.Ptr(int32(42)),
+							Status:       "done",
+							TriggeredAt:  utils.Ptr("2024-01-01T00:00:00Z"),
+						},
+					},
+				},
+			},
+			isValid: true,
+			expectedOutput: []dsa.Backup{
+				{
+					Downloadable: utils.Ptr(true),
+					FinishedAt:   "2024-01-01T01:00:00Z",
+					Id:           1,
+					Size:         utils.Ptr(int32(42)),
+					Status:       "done",
+					TriggeredAt:  utils.Ptr("2024-01-01T00:00:00Z"),
+				},
+			},
+		},
+		{
+			description: "no backups",
+			settings: &dsaMockSettings{
+				listBackupsResp: &redis.ListBackupsResponse{},
+			},
+			isValid:        true,
+			expectedOutput: nil,
+		},
+		{
+			description: "empty response",
+			settings:    &dsaMockSettings{},
+			isValid:     false,
+		},
+		{
+			description: "list backups fails",
+			settings:    &dsaMockSettings{fails: true},
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			api := NewDSAAPI(newDSAAPIMock(tt.settings))
+
+			output, err := api.ListBackups(context.Background(), testProjectId, testRegion, testInstanceId)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("did not fail on invalid input")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			if diff := cmp.Diff(output, tt.expectedOutput); diff != "" {
+				t.Fatalf("unexpected output: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDSAAPICreateBackup(t *testing.T) {
+	api := NewDSAAPI(newDSAAPIMock(&dsaMockSettings{
+		createBackupResp: []redis.CreateBackupResponseItem{
+			{Id: 1, Message: "backup created"},
+		},
+	}))
+
+	output, err := api.CreateBackup(context.Background(), testProjectId, testRegion, testInstanceId)
+	if err != nil {
+		t.Fatalf("failed on valid input: %v", err)
+	}
+	expected := []dsa.CreatedBackup{{Id: 1, Message: "backup created"}}
+	if diff := cmp.Diff(output, expected); diff != "" {
+		t.Fatalf("unexpected output: %s", diff)
+	}
+}
+
+func TestDSAAPITriggerRestore(t *testing.T) {
+	api := NewDSAAPI(newDSAAPIMock(&dsaMockSettings{
+		triggerRestoreResp: &redis.TriggerRestoreResponse{Id: 2},
+	}))
+
+	output, err := api.TriggerRestore(context.Background(), testProjectId, testRegion, testInstanceId, 1)
+	if err != nil {
+		t.Fatalf("failed on valid input: %v", err)
+	}
+	if diff := cmp.Diff(output, &dsa.TriggeredRestore{Id: 2}); diff != "" {
+		t.Fatalf("unexpected output: %s", diff)
+	}
+}
+
+func TestDSAAPIGetMetrics(t *testing.T) {
+	api := NewDSAAPI(newDSAAPIMock(&dsaMockSettings{
+		getMetricsResp: &redis.GetMetricsResponse{
+			CpuIdleTime:    utils.Ptr(int64(10)),
+			CpuLoadPercent: 12.5,
+			MemoryTotal:    1024,
+			MemoryUsed:     512,
+		},
+	}))
+
+	output, err := api.GetMetrics(context.Background(), testProjectId, testRegion, testInstanceId)
+	if err != nil {
+		t.Fatalf("failed on valid input: %v", err)
+	}
+	expected := &dsa.Metrics{
+		CpuIdleTime:    utils.Ptr(int64(10)),
+		CpuLoadPercent: 12.5,
+		MemoryTotal:    1024,
+		MemoryUsed:     512,
+	}
+	if diff := cmp.Diff(output, expected); diff != "" {
+		t.Fatalf("unexpected output: %s", diff)
+	}
+}
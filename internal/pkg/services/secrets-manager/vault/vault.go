@@ -0,0 +1,125 @@
+package vault
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Secrets are read and written using the Vault API of the Secrets Manager,
+// which is not covered by the Secrets Manager API (and SDK)
+const defaultURLTemplate = "https://prod.sm.%s.stackit.cloud"
+
+// Client writes secrets to the key-value secrets engine of Secrets Manager instances
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	token      string
+}
+
+// DefaultURL returns the URL of the Vault API of the Secrets Manager in the given region
+func DefaultURL(region string) string {
+	return fmt.Sprintf(defaultURLTemplate, region)
+}
+
+// NewClient creates a client for the Vault API at the given URL
+func NewClient(baseURL string) *Client {
+	return &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: &http.Client{},
+	}
+}
+
+// Login authenticates the client with the credentials of a Secrets Manager user
+func (c *Client) Login(ctx context.Context, username, password string) error {
+	payload := map[string]string{"password": password}
+	respBody := struct {
+		Auth *struct {
+			ClientToken string `json:"client_token"`
+		} `json:"auth"`
+	}{}
+	err := c.do(ctx, http.MethodPost, fmt.Sprintf("/v1/auth/userpass/login/%s", url.PathEscape(username)), payload, &respBody)
+	if err != nil {
+		return fmt.Errorf("log in to Secrets Manager: %w", err)
+	}
+	if respBody.Auth == nil || respBody.Auth.ClientToken == "" {
+		return fmt.Errorf("log in to Secrets Manager: no token returned")
+	}
+	c.token = respBody.Auth.ClientToken
+	return nil
+}
+
+// WriteSecret creates a new version of the secret at the given path of the Secrets Manager instance
+func (c *Client) WriteSecret(ctx context.Context, instanceId, path string, data map[string]string) error {
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return fmt.Errorf("secret path is empty")
+	}
+	segments := strings.Split(path, "/")
+	for i := range segments {
+		segments[i] = url.PathEscape(segments[i])
+	}
+
+	payload := map[string]any{"data": data}
+	err := c.do(ctx, http.MethodPost, fmt.Sprintf("/v1/%s/data/%s", url.PathEscape(instanceId), strings.Join(segments, "/")), payload, nil)
+	if err != nil {
+		return fmt.Errorf("write secret %q: %w", path, err)
+	}
+	return nil
+}
+
+func (c *Client) do(ctx context.Context, method, path string, payload, respBody any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("marshal request body: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.token != "" {
+		req.Header.Set("X-Vault-Token", c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("call Vault API: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	respBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("read response body: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status %s: %s", resp.Status, parseErrors(respBytes))
+	}
+	if respBody == nil {
+		return nil
+	}
+	err = json.Unmarshal(respBytes, respBody)
+	if err != nil {
+		return fmt.Errorf("unmarshal response body: %w", err)
+	}
+	return nil
+}
+
+// parseErrors returns the errors of a Vault API error response, or the raw response if it can't be parsed
+func parseErrors(body []byte) string {
+	errResp := struct {
+		Errors []string `json:"errors"`
+	}{}
+	err := json.Unmarshal(body, &errResp)
+	if err != nil || len(errResp.Errors) == 0 {
+		return strings.TrimSpace(string(body))
+	}
+	return strings.Join(errResp.Errors, ", ")
+}
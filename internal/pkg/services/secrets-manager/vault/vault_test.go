@@ -0,0 +1,142 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const (
+	testUsername   = "user"
+	testPassword   = "pass"
+	testToken      = "token"
+	testInstanceId = "instance"
+)
+
+func newTestServer(t *testing.T, written map[string]map[string]string) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/auth/userpass/login/{username}", func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]string{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		if err != nil || r.PathValue("username") != testUsername || body["password"] != testPassword {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"errors":["invalid username or password"]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"auth":{"client_token":"token"}}`))
+	})
+	mux.HandleFunc("POST /v1/{instance}/data/{path...}", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != testToken {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+			return
+		}
+		body := struct {
+			Data map[string]string `json:"data"`
+		}{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		written[r.PathValue("instance")+"/"+r.PathValue("path")] = body.Data
+		_, _ = w.Write([]byte(`{"data":{"version":1}}`))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestWriteSecret(t *testing.T) {
+	tests := []struct {
+		description     string
+		username        string
+		password        string
+		skipLogin       bool
+		path            string
+		isValidLogin    bool
+		isValid         bool
+		expectedWritten map[string]map[string]string
+	}{
+		{
+			description:  "base",
+			username:     testUsername,
+			password:     testPassword,
+			path:         "/databases/app/",
+			isValidLogin: true,
+			isValid:      true,
+			expectedWritten: map[string]map[string]string{
+				"instance/databases/app": {"username": "johndoe", "password": "secret"},
+			},
+		},
+		{
+			description:  "invalid password",
+			username:     testUsername,
+			password:     "invalid",
+			isValidLogin: false,
+		},
+		{
+			description:  "not logged in",
+			skipLogin:    true,
+			path:         "databases/app",
+			isValidLogin: true,
+			isValid:      false,
+		},
+		{
+			description:  "empty path",
+			username:     testUsername,
+			password:     testPassword,
+			path:         "/",
+			isValidLogin: true,
+			isValid:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			written := map[string]map[string]string{}
+			server := newTestServer(t, written)
+			client := NewClient(server.URL + "/")
+
+			if !tt.skipLogin {
+				err := client.Login(context.Background(), tt.username, tt.password)
+				if !tt.isValidLogin {
+					if err == nil {
+						t.Fatalf("expected login to fail")
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("login failed: %v", err)
+				}
+			}
+
+			err := client.WriteSecret(context.Background(), testInstanceId, tt.path, map[string]string{"username": "johndoe", "password": "secret"})
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("expected write to fail")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("write failed: %v", err)
+			}
+			if diff := cmp.Diff(written, tt.expectedWritten); diff != "" {
+				t.Fatalf("unexpected written secrets: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDefaultURL(t *testing.T) {
+	got := DefaultURL("eu01")
+	want := "https://prod.sm.eu01.stackit.cloud"
+	if got != want {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+}
@@ -0,0 +1,272 @@
+package utils
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	ske "github.com/stackitcloud/stackit-sdk-go/services/ske/v2api"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+)
+
+// Statuses of a health check, ordered by severity
+const (
+	HealthStatusOK      = "ok"
+	HealthStatusWarning = "warning"
+	HealthStatusError   = "error"
+)
+
+// Categories of the health checks
+const (
+	HealthCategoryState       = "state"
+	HealthCategoryError       = "error"
+	HealthCategoryKubernetes  = "kubernetes"
+	HealthCategoryNodepool    = "nodepool"
+	HealthCategoryMaintenance = "maintenance"
+	HealthCategoryCredentials = "credentials"
+	HealthCategoryKubeconfig  = "kubeconfig"
+)
+
+const (
+	clusterStateHealthy    = "STATE_HEALTHY"
+	clusterStateHibernated = "STATE_HIBERNATED"
+	clusterStateUnhealthy  = "STATE_UNHEALTHY"
+
+	// kubeconfigExpiryWarning is the remaining lifetime of an admin kubeconfig below which a warning is shown
+	kubeconfigExpiryWarning = 24 * time.Hour
+)
+
+// HealthCheck is a single finding of the health report of a cluster
+type HealthCheck struct {
+	Category string `json:"category"`
+	Name     string `json:"name"`
+	Status   string `json:"status"`
+	Message  string `json:"message"`
+}
+
+// HealthReport summarizes the health of a cluster. Its status is the most severe status of its checks
+type HealthReport struct {
+	ClusterName string        `json:"clusterName"`
+	State       string        `json:"state"`
+	Status      string        `json:"status"`
+	Checks      []HealthCheck `json:"checks"`
+}
+
+// ClusterHealth creates the health report of a cluster. The version checks are skipped if the provider options are nil.
+// The entries are the contexts of the cluster in the local kubeconfig, whose expiration is checked
+func ClusterHealth(cluster *ske.Cluster, options *ske.ProviderOptions, entries []KubeconfigEntry, now time.Time) *HealthReport {
+	report := &HealthReport{
+		ClusterName: utils.PtrString(cluster.Name),
+		Checks:      []HealthCheck{},
+	}
+
+	if cluster.Status != nil {
+		report.State = utils.PtrString(cluster.Status.Aggregated)
+	}
+	report.Checks = append(report.Checks, stateCheck(report.State))
+	if cluster.Status != nil {
+		for _, clusterErr := range cluster.Status.GetErrors() {
+			report.Checks = append(report.Checks, HealthCheck{
+				Category: HealthCategoryError,
+				Name:     fmt.Sprint(clusterErr.GetCode()),
+				Status:   HealthStatusError,
+				Message:  clusterErr.GetMessage(),
+			})
+		}
+	}
+
+	if options != nil {
+		status := kubernetesVersionStatus(cluster.Kubernetes.Version, options.KubernetesVersions)
+		check := versionCheck(&status, now)
+		check.Category = HealthCategoryKubernetes
+		check.Name = "version"
+		check.Message = fmt.Sprintf("Kubernetes %s", check.Message)
+		report.Checks = append(report.Checks, check)
+	}
+	for i := range cluster.Nodepools {
+		report.Checks = append(report.Checks, nodepoolCheck(&cluster.Nodepools[i], options, now))
+	}
+
+	report.Checks = append(report.Checks, maintenanceCheck(cluster.Maintenance))
+	report.Checks = append(report.Checks, credentialsRotationCheck(cluster, now))
+	for i := range entries {
+		report.Checks = append(report.Checks, kubeconfigCheck(&entries[i], now))
+	}
+
+	report.Status = HealthStatusOK
+	for i := range report.Checks {
+		report.Status = worseHealthStatus(report.Status, report.Checks[i].Status)
+	}
+	return report
+}
+
+func worseHealthStatus(a, b string) string {
+	severity := []string{HealthStatusOK, HealthStatusWarning, HealthStatusError}
+	if slices.Index(severity, b) > slices.Index(severity, a) {
+		return b
+	}
+	return a
+}
+
+func stateCheck(state string) HealthCheck {
+	check := HealthCheck{
+		Category: HealthCategoryState,
+		Name:     "aggregated",
+		Message:  state,
+	}
+	switch state {
+	case clusterStateHealthy, clusterStateHibernated:
+		check.Status = HealthStatusOK
+	case "", clusterStateUnhealthy:
+		check.Status = HealthStatusError
+		if state == "" {
+			check.Message = "unknown"
+		}
+	default:
+		// An operation like a reconciliation or an update is in progress
+		check.Status = HealthStatusWarning
+		check.Message = fmt.Sprintf("%s, an operation is in progress", state)
+	}
+	return check
+}
+
+// versionCheck returns the status of a Kubernetes or machine image version, only the status and message are set
+func versionCheck(status *VersionStatus, now time.Time) HealthCheck {
+	switch status.State {
+	case "":
+		return HealthCheck{
+			Status:  HealthStatusWarning,
+			Message: fmt.Sprintf("%s is not offered anymore", status.Version),
+		}
+	case deprecatedState:
+		return HealthCheck{
+			Status:  HealthStatusWarning,
+			Message: fmt.Sprintf("%s is deprecated%s", status.Version, expirationHint(status.ExpirationDate, now)),
+		}
+	default:
+		return HealthCheck{
+			Status:  HealthStatusOK,
+			Message: fmt.Sprintf("%s is %s", status.Version, status.State),
+		}
+	}
+}
+
+// nodepoolCheck returns the status of the machine image version of the nodepool, which is only checked if the provider options are set
+func nodepoolCheck(nodepool *ske.Nodepool, options *ske.ProviderOptions, now time.Time) HealthCheck {
+	image := nodepool.Machine.Image
+	check := HealthCheck{
+		Status:  HealthStatusOK,
+		Message: fmt.Sprintf("machine image %s %s", image.Name, image.Version),
+	}
+	if options != nil {
+		status := machineImageVersionStatus(image.Name, image.Version, options.MachineImages)
+		check = versionCheck(&status, now)
+		check.Message = fmt.Sprintf("machine image %s %s", image.Name, check.Message)
+	}
+	check.Category = HealthCategoryNodepool
+	check.Name = nodepool.Name
+	check.Message = fmt.Sprintf("%s nodes of type %s in %s, %s",
+		nodeCount(nodepool.Minimum, nodepool.Maximum), nodepool.Machine.Type, strings.Join(nodepool.AvailabilityZones, ", "), check.Message)
+	return check
+}
+
+func nodeCount(minimum, maximum int32) string {
+	if minimum == maximum {
+		return fmt.Sprint(minimum)
+	}
+	return fmt.Sprintf("%d-%d", minimum, maximum)
+}
+
+func maintenanceCheck(maintenance *ske.Maintenance) HealthCheck {
+	check := HealthCheck{
+		Category: HealthCategoryMaintenance,
+		Name:     "window",
+	}
+	if maintenance == nil {
+		check.Status = HealthStatusWarning
+		check.Message = "no maintenance window set"
+		return check
+	}
+
+	autoUpdates := []string{}
+	if utils.PtrValue(maintenance.AutoUpdate.KubernetesVersion) {
+		autoUpdates = append(autoUpdates, "Kubernetes version")
+	}
+	if utils.PtrValue(maintenance.AutoUpdate.MachineImageVersion) {
+		autoUpdates = append(autoUpdates, "machine image version")
+	}
+	window := fmt.Sprintf("%s - %s", maintenance.TimeWindow.Start.Format("15:04Z07:00"), maintenance.TimeWindow.End.Format("15:04Z07:00"))
+	if len(autoUpdates) == 0 {
+		check.Status = HealthStatusWarning
+		check.Message = fmt.Sprintf("%s, automatic updates are disabled", window)
+		return check
+	}
+	check.Status = HealthStatusOK
+	check.Message = fmt.Sprintf("%s, automatic updates of %s", window, strings.Join(autoUpdates, " and "))
+	return check
+}
+
+func credentialsRotationCheck(cluster *ske.Cluster, now time.Time) HealthCheck {
+	var lastCompletion *time.Time
+	if cluster.Status != nil && cluster.Status.CredentialsRotation != nil {
+		lastCompletion = cluster.Status.CredentialsRotation.LastCompletionTime
+	}
+	return rotationCheck(CredentialsRotationPhase(cluster), lastCompletion, now)
+}
+
+func rotationCheck(phase string, lastCompletion *time.Time, now time.Time) HealthCheck {
+	check := HealthCheck{
+		Category: HealthCategoryCredentials,
+		Name:     "rotation",
+		Status:   HealthStatusOK,
+	}
+	switch phase {
+	case CredentialsRotationPhasePreparing, CredentialsRotationPhaseCompleting:
+		check.Status = HealthStatusWarning
+		check.Message = fmt.Sprintf("rotation in progress (%s)", phase)
+	case CredentialsRotationPhasePrepared:
+		check.Status = HealthStatusWarning
+		check.Message = `rotation started but not completed, both the old and new credentials are valid. Complete it with "stackit ske credentials rotate"`
+	default:
+		check.Message = "credentials were never rotated"
+		if lastCompletion != nil {
+			days := int(now.Sub(*lastCompletion).Hours() / 24)
+			check.Message = fmt.Sprintf("last rotated on %s (%d days ago)", lastCompletion.Format(time.DateOnly), max(days, 0))
+		}
+	}
+	return check
+}
+
+func kubeconfigCheck(entry *KubeconfigEntry, now time.Time) HealthCheck {
+	check := HealthCheck{
+		Category: HealthCategoryKubeconfig,
+		Name:     entry.Context,
+		Status:   HealthStatusOK,
+	}
+	switch {
+	case entry.Type != KubeconfigTypeAdmin:
+		check.Message = fmt.Sprintf("%s kubeconfig, credentials are obtained on demand", entry.Type)
+	case entry.Expired:
+		check.Status = HealthStatusError
+		check.Message = `client certificate expired, create a new kubeconfig with "stackit ske kubeconfig create"`
+	case entry.ExpiresAt == nil:
+		check.Message = "admin kubeconfig without client certificate"
+	default:
+		remaining := entry.ExpiresAt.Sub(now)
+		if remaining < kubeconfigExpiryWarning {
+			check.Status = HealthStatusWarning
+		}
+		check.Message = fmt.Sprintf("client certificate expires on %s (in %s)", entry.ExpiresAt.Format(time.RFC3339), formatDuration(remaining))
+	}
+	return check
+}
+
+// formatDuration returns the duration in days, or in hours and minutes if it is shorter than a day
+func formatDuration(d time.Duration) string {
+	if d >= 24*time.Hour {
+		return fmt.Sprintf("%d days", int(d.Hours()/24))
+	}
+	return d.Truncate(time.Minute).String()
+}
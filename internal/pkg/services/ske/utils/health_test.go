@@ -0,0 +1,202 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	ske "github.com/stackitcloud/stackit-sdk-go/services/ske/v2api"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+)
+
+func TestClusterHealth(t *testing.T) {
+	maintenance := &ske.Maintenance{
+		AutoUpdate: ske.MaintenanceAutoUpdate{
+			KubernetesVersion:   utils.Ptr(true),
+			MachineImageVersion: utils.Ptr(true),
+		},
+		TimeWindow: ske.TimeWindow{
+			Start: time.Date(0, 1, 1, 3, 0, 0, 0, time.UTC),
+			End:   time.Date(0, 1, 1, 5, 0, 0, 0, time.UTC),
+		},
+	}
+
+	tests := []struct {
+		description    string
+		cluster        *ske.Cluster
+		options        *ske.ProviderOptions
+		entries        []KubeconfigEntry
+		expectedReport *HealthReport
+	}{
+		{
+			description: "versions and kubeconfigs",
+			cluster: fixtureUpgradeCluster(func(cluster *ske.Cluster) {
+				cluster.Maintenance = maintenance
+				cluster.Nodepools = cluster.Nodepools[1:]
+				cluster.Nodepools[0].Machine.Type = "c2i.4"
+				cluster.Nodepools[0].Minimum = 1
+				cluster.Nodepools[0].Maximum = 3
+				cluster.Nodepools[0].AvailabilityZones = []string{"eu01-1", "eu01-2"}
+			}),
+			options: fixtureUpgradeOptions(),
+			entries: []KubeconfigEntry{
+				{Context: "admin", Type: KubeconfigTypeAdmin, ExpiresAt: utils.Ptr(testNow.Add(30 * 24 * time.Hour))},
+				{Context: "login", Type: KubeconfigTypeLogin},
+			},
+			expectedReport: &HealthReport{
+				ClusterName: testClusterName,
+				Status:      HealthStatusError,
+				Checks: []HealthCheck{
+					{Category: HealthCategoryState, Name: "aggregated", Status: HealthStatusError, Message: "unknown"},
+					{Category: HealthCategoryKubernetes, Name: "version", Status: HealthStatusWarning, Message: "Kubernetes 1.31.10 is deprecated and expires on 2026-11-01 (in 30 days), when it is upgraded automatically"},
+					{Category: HealthCategoryNodepool, Name: "pool-new", Status: HealthStatusOK, Message: "1-3 nodes of type c2i.4 in eu01-1, eu01-2, machine image flatcar 4230.2.3 is supported"},
+					{Category: HealthCategoryMaintenance, Name: "window", Status: HealthStatusOK, Message: "03:00Z - 05:00Z, automatic updates of Kubernetes version and machine image version"},
+					{Category: HealthCategoryCredentials, Name: "rotation", Status: HealthStatusOK, Message: "credentials were never rotated"},
+					{Category: HealthCategoryKubeconfig, Name: "admin", Status: HealthStatusOK, Message: "client certificate expires on 2026-10-31T12:00:00Z (in 30 days)"},
+					{Category: HealthCategoryKubeconfig, Name: "login", Status: HealthStatusOK, Message: "login kubeconfig, credentials are obtained on demand"},
+				},
+			},
+		},
+		{
+			description: "without provider options",
+			cluster: fixtureUpgradeCluster(func(cluster *ske.Cluster) {
+				cluster.Nodepools = cluster.Nodepools[:1]
+				cluster.Nodepools[0].Machine.Type = "c2i.4"
+				cluster.Nodepools[0].Minimum = 2
+				cluster.Nodepools[0].Maximum = 2
+				cluster.Nodepools[0].AvailabilityZones = []string{"eu01-1"}
+			}),
+			expectedReport: &HealthReport{
+				ClusterName: testClusterName,
+				Status:      HealthStatusError,
+				Checks: []HealthCheck{
+					{Category: HealthCategoryState, Name: "aggregated", Status: HealthStatusError, Message: "unknown"},
+					{Category: HealthCategoryNodepool, Name: "pool-old", Status: HealthStatusOK, Message: "2 nodes of type c2i.4 in eu01-1, machine image flatcar 4152.2.3"},
+					{Category: HealthCategoryMaintenance, Name: "window", Status: HealthStatusWarning, Message: "no maintenance window set"},
+					{Category: HealthCategoryCredentials, Name: "rotation", Status: HealthStatusOK, Message: "credentials were never rotated"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			report := ClusterHealth(tt.cluster, tt.options, tt.entries, testNow)
+			diff := cmp.Diff(report, tt.expectedReport)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStateCheck(t *testing.T) {
+	tests := []struct {
+		state          string
+		expectedStatus string
+	}{
+		{state: "STATE_HEALTHY", expectedStatus: HealthStatusOK},
+		{state: "STATE_HIBERNATED", expectedStatus: HealthStatusOK},
+		{state: "STATE_RECONCILING", expectedStatus: HealthStatusWarning},
+		{state: "STATE_UNHEALTHY", expectedStatus: HealthStatusError},
+		{state: "", expectedStatus: HealthStatusError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.state, func(t *testing.T) {
+			check := stateCheck(tt.state)
+			if check.Status != tt.expectedStatus {
+				t.Fatalf("expected status %q, got %q", tt.expectedStatus, check.Status)
+			}
+		})
+	}
+}
+
+func TestRotationCheck(t *testing.T) {
+	tests := []struct {
+		description     string
+		phase           string
+		lastCompletion  *time.Time
+		expectedStatus  string
+		expectedMessage string
+	}{
+		{
+			description:     "never rotated",
+			phase:           CredentialsRotationPhaseNever,
+			expectedStatus:  HealthStatusOK,
+			expectedMessage: "credentials were never rotated",
+		},
+		{
+			description:     "completed",
+			phase:           CredentialsRotationPhaseCompleted,
+			lastCompletion:  utils.Ptr(testNow.Add(-10 * 24 * time.Hour)),
+			expectedStatus:  HealthStatusOK,
+			expectedMessage: "last rotated on 2026-09-21 (10 days ago)",
+		},
+		{
+			description:     "preparing",
+			phase:           CredentialsRotationPhasePreparing,
+			expectedStatus:  HealthStatusWarning,
+			expectedMessage: "rotation in progress (PREPARING)",
+		},
+		{
+			description:     "prepared",
+			phase:           CredentialsRotationPhasePrepared,
+			expectedStatus:  HealthStatusWarning,
+			expectedMessage: `rotation started but not completed, both the old and new credentials are valid. Complete it with "stackit ske credentials rotate"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			check := rotationCheck(tt.phase, tt.lastCompletion, testNow)
+			if check.Status != tt.expectedStatus {
+				t.Fatalf("expected status %q, got %q", tt.expectedStatus, check.Status)
+			}
+			if check.Message != tt.expectedMessage {
+				t.Fatalf("expected message %q, got %q", tt.expectedMessage, check.Message)
+			}
+		})
+	}
+}
+
+func TestKubeconfigCheck(t *testing.T) {
+	tests := []struct {
+		description     string
+		entry           *KubeconfigEntry
+		expectedStatus  string
+		expectedMessage string
+	}{
+		{
+			description:     "expires soon",
+			entry:           &KubeconfigEntry{Type: KubeconfigTypeAdmin, ExpiresAt: utils.Ptr(testNow.Add(90 * time.Minute))},
+			expectedStatus:  HealthStatusWarning,
+			expectedMessage: "client certificate expires on 2026-10-01T13:30:00Z (in 1h30m0s)",
+		},
+		{
+			description:     "expired",
+			entry:           &KubeconfigEntry{Type: KubeconfigTypeAdmin, Expired: true},
+			expectedStatus:  HealthStatusError,
+			expectedMessage: `client certificate expired, create a new kubeconfig with "stackit ske kubeconfig create"`,
+		},
+		{
+			description:     "idp",
+			entry:           &KubeconfigEntry{Type: KubeconfigTypeIDP},
+			expectedStatus:  HealthStatusOK,
+			expectedMessage: "idp kubeconfig, credentials are obtained on demand",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			check := kubeconfigCheck(tt.entry, testNow)
+			if check.Status != tt.expectedStatus {
+				t.Fatalf("expected status %q, got %q", tt.expectedStatus, check.Status)
+			}
+			if check.Message != tt.expectedMessage {
+				t.Fatalf("expected message %q, got %q", tt.expectedMessage, check.Message)
+			}
+		})
+	}
+}
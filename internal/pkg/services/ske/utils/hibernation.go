@@ -0,0 +1,98 @@
+package utils
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	ske "github.com/stackitcloud/stackit-sdk-go/services/ske/v2api"
+)
+
+const (
+	// DefaultHibernationTimezone is the time zone of hibernation schedules which don't set one
+	DefaultHibernationTimezone = "UTC"
+	// HibernationWindowTimeFormat is the format of the start and end of hibernation windows, which includes the weekday
+	HibernationWindowTimeFormat = "Mon 2006-01-02 15:04 MST"
+)
+
+// cronParser parses cron expressions with the five standard fields, which is the format of hibernation schedules
+var cronParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+
+// HibernationWindow is a time range in which a cluster is hibernated
+type HibernationWindow struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// String returns the window in the time zone of its schedule
+func (w HibernationWindow) String() string {
+	return fmt.Sprintf("%s - %s", w.Start.Format(HibernationWindowTimeFormat), w.End.Format(HibernationWindowTimeFormat))
+}
+
+// ParseCron parses a cron expression with the five fields minute, hour, day of month, month and day of week
+func ParseCron(expression string) (cron.Schedule, error) {
+	if len(strings.Fields(expression)) != 5 {
+		return nil, fmt.Errorf("cron expression %q must have the five fields minute, hour, day of month, month and day of week", expression)
+	}
+	schedule, err := cronParser.Parse(expression)
+	if err != nil {
+		return nil, fmt.Errorf("parse cron expression %q: %w", expression, err)
+	}
+	return schedule, nil
+}
+
+// LoadHibernationTimezone returns the location of the time zone of a hibernation schedule, which is UTC if unset
+func LoadHibernationTimezone(timezone *string) (*time.Location, error) {
+	name := DefaultHibernationTimezone
+	if timezone != nil && *timezone != "" {
+		name = *timezone
+	}
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("load time zone %q: %w", name, err)
+	}
+	return location, nil
+}
+
+// NextHibernationWindows returns the next n hibernation windows of the schedules which start after the given time, sorted by their start
+func NextHibernationWindows(schedules []ske.HibernationSchedule, after time.Time, n int) ([]HibernationWindow, error) {
+	windows := []HibernationWindow{}
+	for i := range schedules {
+		start, err := ParseCron(schedules[i].Start)
+		if err != nil {
+			return nil, err
+		}
+		end, err := ParseCron(schedules[i].End)
+		if err != nil {
+			return nil, err
+		}
+		location, err := LoadHibernationTimezone(schedules[i].Timezone)
+		if err != nil {
+			return nil, err
+		}
+
+		t := after.In(location)
+		for range n {
+			windowStart := start.Next(t)
+			// the expression doesn't match any time, e.g. on the 31st of February
+			if windowStart.IsZero() {
+				break
+			}
+			windows = append(windows, HibernationWindow{
+				Start: windowStart,
+				End:   end.Next(windowStart),
+			})
+			t = windowStart
+		}
+	}
+
+	slices.SortFunc(windows, func(a, b HibernationWindow) int {
+		return a.Start.Compare(b.Start)
+	})
+	if len(windows) > n {
+		windows = windows[:n]
+	}
+	return windows, nil
+}
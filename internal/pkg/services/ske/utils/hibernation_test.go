@@ -0,0 +1,173 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	ske "github.com/stackitcloud/stackit-sdk-go/services/ske/v2api"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+)
+
+func TestParseCron(t *testing.T) {
+	tests := []struct {
+		description string
+		expression  string
+		isValid     bool
+	}{
+		{
+			description: "weekdays",
+			expression:  "0 18 * * 1-5",
+			isValid:     true,
+		},
+		{
+			description: "lists and steps",
+			expression:  "*/30 8,18 1-15 * MON-FRI",
+			isValid:     true,
+		},
+		{
+			description: "too few fields",
+			expression:  "0 18 * *",
+		},
+		{
+			description: "seconds field",
+			expression:  "0 0 18 * * 1-5",
+		},
+		{
+			description: "descriptor",
+			expression:  "@daily",
+		},
+		{
+			description: "hour out of range",
+			expression:  "0 24 * * *",
+		},
+		{
+			description: "empty",
+			expression:  "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			_, err := ParseCron(tt.expression)
+			if !tt.isValid && err == nil {
+				t.Fatalf("did not fail on invalid input")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+		})
+	}
+}
+
+func TestNextHibernationWindows(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+	// Thursday
+	after := time.Date(2025, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description     string
+		schedules       []ske.HibernationSchedule
+		n               int
+		isValid         bool
+		expectedWindows []HibernationWindow
+	}{
+		{
+			description: "nights and weekends",
+			schedules: []ske.HibernationSchedule{
+				{Start: "0 18 * * 1-5", End: "0 8 * * 1-5", Timezone: utils.Ptr("Europe/Berlin")},
+			},
+			n:       3,
+			isValid: true,
+			expectedWindows: []HibernationWindow{
+				{Start: time.Date(2025, 10, 16, 18, 0, 0, 0, berlin), End: time.Date(2025, 10, 17, 8, 0, 0, 0, berlin)},
+				{Start: time.Date(2025, 10, 17, 18, 0, 0, 0, berlin), End: time.Date(2025, 10, 20, 8, 0, 0, 0, berlin)},
+				{Start: time.Date(2025, 10, 20, 18, 0, 0, 0, berlin), End: time.Date(2025, 10, 21, 8, 0, 0, 0, berlin)},
+			},
+		},
+		{
+			description: "multiple schedules are sorted",
+			schedules: []ske.HibernationSchedule{
+				{Start: "0 0 * * 6", End: "0 0 * * 1"},
+				{Start: "0 20 * * *", End: "0 6 * * *"},
+			},
+			n:       3,
+			isValid: true,
+			expectedWindows: []HibernationWindow{
+				{Start: time.Date(2025, 10, 16, 20, 0, 0, 0, time.UTC), End: time.Date(2025, 10, 17, 6, 0, 0, 0, time.UTC)},
+				{Start: time.Date(2025, 10, 17, 20, 0, 0, 0, time.UTC), End: time.Date(2025, 10, 18, 6, 0, 0, 0, time.UTC)},
+				{Start: time.Date(2025, 10, 18, 0, 0, 0, 0, time.UTC), End: time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			description:     "no schedules",
+			n:               3,
+			isValid:         true,
+			expectedWindows: []HibernationWindow{},
+		},
+		{
+			description: "never matches",
+			schedules: []ske.HibernationSchedule{
+				{Start: "0 0 31 2 *", End: "0 0 1 3 *"},
+			},
+			n:               3,
+			isValid:         true,
+			expectedWindows: []HibernationWindow{},
+		},
+		{
+			description: "invalid cron expression",
+			schedules: []ske.HibernationSchedule{
+				{Start: "0 18 * *", End: "0 8 * * *"},
+			},
+			n: 3,
+		},
+		{
+			description: "invalid time zone",
+			schedules: []ske.HibernationSchedule{
+				{Start: "0 18 * * *", End: "0 8 * * *", Timezone: utils.Ptr("Europe/Nowhere")},
+			},
+			n: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			windows, err := NextHibernationWindows(tt.schedules, after, tt.n)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("did not fail on invalid input")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			diff := cmp.Diff(windows, tt.expectedWindows, cmp.Comparer(func(a, b time.Time) bool {
+				return a.Equal(b)
+			}))
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestHibernationWindowString(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+	window := HibernationWindow{
+		Start: time.Date(2025, 10, 17, 18, 0, 0, 0, berlin),
+		End:   time.Date(2025, 10, 20, 8, 0, 0, 0, berlin),
+	}
+
+	expected := "Fri 2025-10-17 18:00 CEST - Mon 2025-10-20 08:00 CEST"
+	if window.String() != expected {
+		t.Fatalf("expected %q, got %q", expected, window.String())
+	}
+}
@@ -0,0 +1,333 @@
+package utils
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	ske "github.com/stackitcloud/stackit-sdk-go/services/ske/v2api"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	// execExtensionName is the cluster extension of login and IDP kubeconfigs, which is passed to "stackit ske kubeconfig login"
+	execExtensionName = "client.authentication.k8s.io/exec"
+
+	skeServerDomain = ".onstackit.cloud"
+
+	KubeconfigTypeAdmin = "admin"
+	KubeconfigTypeLogin = "login"
+	KubeconfigTypeIDP   = "idp"
+)
+
+// KubeconfigEntry is a context of a kubeconfig file, which points to a SKE cluster
+type KubeconfigEntry struct {
+	Context     string `json:"context"`
+	Cluster     string `json:"cluster"`
+	User        string `json:"user"`
+	ClusterName string `json:"clusterName"`
+	// ProjectId and Region are only known for login and IDP kubeconfigs
+	ProjectId string `json:"projectId,omitempty"`
+	Region    string `json:"region,omitempty"`
+	Type      string `json:"type"`
+	Current   bool   `json:"current"`
+	// ExpiresAt is the expiration of the client certificate of admin kubeconfigs
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	Expired   bool       `json:"expired"`
+	// ClusterExists is nil if the existence of the cluster couldn't be checked
+	ClusterExists *bool `json:"clusterExists,omitempty"`
+}
+
+// IsStale returns true if the credentials of the entry expired or the cluster was deleted
+func (e *KubeconfigEntry) IsStale() bool {
+	return e.Expired || (e.ClusterExists != nil && !*e.ClusterExists)
+}
+
+// StaleReason returns why the entry is stale, or an empty string if it isn't
+func (e *KubeconfigEntry) StaleReason() string {
+	switch {
+	case e.ClusterExists != nil && !*e.ClusterExists:
+		return "cluster deleted"
+	case e.Expired:
+		return "certificate expired"
+	default:
+		return ""
+	}
+}
+
+// execClusterConfig is the config of the exec extension, see the login command
+type execClusterConfig struct {
+	STACKITProjectID string `json:"stackitProjectID"`
+	ClusterName      string `json:"clusterName"`
+	Region           string `json:"region"`
+}
+
+// CheckKubeconfigExpiry returns false if the client certificate is invalid or expired, otherwise true and its expiration
+func CheckKubeconfigExpiry(certData []byte) (bool, time.Time) {
+	certificate, err := parseCertificate(certData)
+	if err != nil {
+		return false, time.Time{}
+	}
+
+	// cert is expired
+	if time.Now().After(certificate.NotAfter.UTC()) {
+		return false, time.Time{}
+	}
+	return true, certificate.NotAfter.UTC()
+}
+
+func parseCertificate(certData []byte) (*x509.Certificate, error) {
+	certPem, _ := pem.Decode(certData)
+	if certPem == nil {
+		return nil, fmt.Errorf("decoded pem is nil")
+	}
+
+	certificate, err := x509.ParseCertificate(certPem.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parse certificate: %w", err)
+	}
+	return certificate, nil
+}
+
+// ListKubeconfigEntries returns the contexts of the kubeconfig which point to SKE clusters, sorted by name.
+// These are identified by the exec extension of login and IDP kubeconfigs, or else by the domain of the API server
+func ListKubeconfigEntries(config *clientcmdapi.Config) []KubeconfigEntry {
+	entries := []KubeconfigEntry{}
+	for name, kubeContext := range config.Contexts {
+		cluster, ok := config.Clusters[kubeContext.Cluster]
+		if !ok {
+			continue
+		}
+		entry := KubeconfigEntry{
+			Context: name,
+			Cluster: kubeContext.Cluster,
+			User:    kubeContext.AuthInfo,
+			Type:    KubeconfigTypeAdmin,
+			Current: name == config.CurrentContext,
+		}
+
+		execConfig := getExecClusterConfig(cluster)
+		switch {
+		case execConfig != nil:
+			entry.ClusterName = execConfig.ClusterName
+			entry.ProjectId = execConfig.STACKITProjectID
+			entry.Region = execConfig.Region
+		case isSKEServer(cluster.Server):
+			// Admin kubeconfigs only contain the cluster name in the address of its API server
+			entry.ClusterName = serverClusterName(cluster.Server)
+		default:
+			continue
+		}
+
+		if user, ok := config.AuthInfos[kubeContext.AuthInfo]; ok {
+			switch {
+			case user.Exec != nil && slices.Contains(user.Exec.Args, "--idp"):
+				entry.Type = KubeconfigTypeIDP
+			case user.Exec != nil:
+				entry.Type = KubeconfigTypeLogin
+			case len(user.ClientCertificateData) > 0:
+				isValid, notAfter := CheckKubeconfigExpiry(user.ClientCertificateData)
+				entry.Expired = !isValid
+				if isValid {
+					entry.ExpiresAt = &notAfter
+				}
+			}
+		}
+		entries = append(entries, entry)
+	}
+	slices.SortFunc(entries, func(a, b KubeconfigEntry) int {
+		return strings.Compare(a.Context, b.Context)
+	})
+	return entries
+}
+
+// CheckKubeconfigClusters sets whether the clusters of the entries still exist. It stays unset, i.e. unknown, if the clusters of the project can't be listed.
+// Admin kubeconfigs don't contain the project, so their clusters are looked up in the default project. As they may belong to another project,
+// they are only marked as existing if they are found there and stay unknown otherwise, as well as if no default project is set.
+// Entries without a region, which was the case for older login kubeconfigs, are checked in the default region
+func CheckKubeconfigClusters(ctx context.Context, apiClient ske.DefaultAPI, entries []KubeconfigEntry, defaultProjectId, defaultRegion string) {
+	for i := range entries {
+		entry := &entries[i]
+		projectId := entry.ProjectId
+		if projectId == "" {
+			projectId = defaultProjectId
+		}
+		if projectId == "" {
+			continue
+		}
+		region := entry.Region
+		if region == "" {
+			region = defaultRegion
+		}
+		exists, err := ClusterExists(ctx, apiClient, projectId, region, entry.ClusterName)
+		if err != nil {
+			continue
+		}
+		if !exists && entry.ProjectId == "" {
+			continue
+		}
+		entry.ClusterExists = &exists
+	}
+}
+
+// RemoveKubeconfigContexts removes the contexts from the kubeconfig, as well as their clusters and users,
+// if they aren't used by other contexts. If the current context is removed, it is unset
+func RemoveKubeconfigContexts(config *clientcmdapi.Config, contexts []string) {
+	for _, name := range contexts {
+		kubeContext, ok := config.Contexts[name]
+		if !ok {
+			continue
+		}
+		delete(config.Contexts, name)
+		if config.CurrentContext == name {
+			config.CurrentContext = ""
+		}
+
+		clusterUsed, userUsed := false, false
+		for _, other := range config.Contexts {
+			clusterUsed = clusterUsed || other.Cluster == kubeContext.Cluster
+			userUsed = userUsed || other.AuthInfo == kubeContext.AuthInfo
+		}
+		if !clusterUsed {
+			delete(config.Clusters, kubeContext.Cluster)
+		}
+		if !userUsed {
+			delete(config.AuthInfos, kubeContext.AuthInfo)
+		}
+	}
+}
+
+// KubeconfigContextName returns the name of the context of a SKE cluster, which is unique across projects
+func KubeconfigContextName(projectId, clusterName string) string {
+	return fmt.Sprintf("%s/%s", projectId, clusterName)
+}
+
+// RenameKubeconfig loads a kubeconfig with a single context, as returned by the API,
+// and renames its context, cluster and user to the given name
+func RenameKubeconfig(content, name string) (*clientcmdapi.Config, error) {
+	config, err := clientcmd.Load([]byte(content))
+	if err != nil {
+		return nil, fmt.Errorf("load kubeconfig: %w", err)
+	}
+	if len(config.Contexts) != 1 {
+		return nil, fmt.Errorf("expected kubeconfig with 1 context, found %d", len(config.Contexts))
+	}
+
+	renamed := clientcmdapi.NewConfig()
+	for _, kubeContext := range config.Contexts {
+		cluster, ok := config.Clusters[kubeContext.Cluster]
+		if !ok {
+			return nil, fmt.Errorf("cluster %q of kubeconfig not found", kubeContext.Cluster)
+		}
+		user, ok := config.AuthInfos[kubeContext.AuthInfo]
+		if !ok {
+			return nil, fmt.Errorf("user %q of kubeconfig not found", kubeContext.AuthInfo)
+		}
+		renamed.Clusters[name] = cluster
+		renamed.AuthInfos[name] = user
+		kubeContext.Cluster = name
+		kubeContext.AuthInfo = name
+		renamed.Contexts[name] = kubeContext
+	}
+	return renamed, nil
+}
+
+// KubeconfigServer returns the API server of a kubeconfig with a single context, as returned by the API
+func KubeconfigServer(content string) (string, error) {
+	config, err := RenameKubeconfig(content, "cluster")
+	if err != nil {
+		return "", err
+	}
+	return config.Clusters["cluster"].Server, nil
+}
+
+// FilterKubeconfigEntriesByCluster returns the entries of a cluster. Login and IDP kubeconfigs are identified by their project and cluster name,
+// admin kubeconfigs, which don't contain the project, by the API server of the cluster. If the server is unknown, i.e. empty,
+// admin kubeconfigs are identified by the cluster name in the domain of their API server instead
+func FilterKubeconfigEntriesByCluster(config *clientcmdapi.Config, entries []KubeconfigEntry, projectId, clusterName, server string) []KubeconfigEntry {
+	filtered := []KubeconfigEntry{}
+	for i := range entries {
+		entry := &entries[i]
+		switch entry.Type {
+		case KubeconfigTypeAdmin:
+			cluster, ok := config.Clusters[entry.Cluster]
+			if !ok {
+				continue
+			}
+			if (server != "" && cluster.Server == server) || (server == "" && entry.ClusterName == clusterName) {
+				filtered = append(filtered, *entry)
+			}
+		default:
+			if entry.ProjectId == projectId && entry.ClusterName == clusterName {
+				filtered = append(filtered, *entry)
+			}
+		}
+	}
+	return filtered
+}
+
+// ReplaceKubeconfigCredentials replaces the cluster and user of the context with the ones of a kubeconfig with a single context,
+// as returned by the API, keeping their names. This updates the certificates of the context after the credentials of the cluster were rotated
+func ReplaceKubeconfigCredentials(config *clientcmdapi.Config, contextName, content string) error {
+	kubeContext, ok := config.Contexts[contextName]
+	if !ok {
+		return fmt.Errorf("context %q not found in kubeconfig", contextName)
+	}
+	newConfig, err := RenameKubeconfig(content, contextName)
+	if err != nil {
+		return err
+	}
+	config.Clusters[kubeContext.Cluster] = newConfig.Clusters[contextName]
+	config.AuthInfos[kubeContext.AuthInfo] = newConfig.AuthInfos[contextName]
+	return nil
+}
+
+func getExecClusterConfig(cluster *clientcmdapi.Cluster) *execClusterConfig {
+	extension, ok := cluster.Extensions[execExtensionName]
+	if !ok {
+		return nil
+	}
+	unknown, ok := extension.(*runtime.Unknown)
+	if !ok {
+		return nil
+	}
+	config := &execClusterConfig{}
+	err := json.Unmarshal(unknown.Raw, config)
+	if err != nil || config.ClusterName == "" {
+		return nil
+	}
+	return config
+}
+
+func isSKEServer(server string) bool {
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return false
+	}
+	host := serverURL.Hostname()
+	return strings.HasSuffix(host, skeServerDomain) && strings.Contains(host, ".ske.")
+}
+
+// serverClusterName returns the cluster name of the API server of a SKE cluster, e.g. "my-cluster" for https://api.my-cluster.abc1234.s.ske.eu01.onstackit.cloud
+func serverClusterName(server string) string {
+	if !isSKEServer(server) {
+		return ""
+	}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return ""
+	}
+	labels := strings.Split(serverURL.Hostname(), ".")
+	if len(labels) < 2 || labels[0] != "api" {
+		return ""
+	}
+	return labels[1]
+}
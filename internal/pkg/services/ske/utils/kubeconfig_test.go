@@ -0,0 +1,645 @@
+package utils
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	ske "github.com/stackitcloud/stackit-sdk-go/services/ske/v2api"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+)
+
+func fixtureCertificate(t *testing.T, notAfter time.Time) []byte {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    notAfter.Add(-48 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func fixtureKubeconfig(validCert, expiredCert []byte) string {
+	return fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- name: valid-cluster
+  cluster:
+    server: https://api.valid-cluster.abc1234.s.ske.eu01.onstackit.cloud
+- name: expired-cluster
+  cluster:
+    server: https://api.expired-cluster.abc1234.s.ske.eu01.onstackit.cloud
+- name: login-cluster
+  cluster:
+    server: https://api.login-cluster.abc1234.s.ske.eu01.onstackit.cloud
+    extensions:
+    - name: client.authentication.k8s.io/exec
+      extension:
+        clusterName: login-cluster
+        stackitProjectID: project-id
+        region: eu01
+- name: idp-cluster
+  cluster:
+    server: https://10.0.0.1:6443
+    extensions:
+    - name: client.authentication.k8s.io/exec
+      extension:
+        clusterName: idp-cluster
+        stackitProjectID: project-id
+        region: eu02
+- name: other-cluster
+  cluster:
+    server: https://127.0.0.1:6443
+contexts:
+- name: valid-cluster
+  context:
+    cluster: valid-cluster
+    user: valid-cluster
+- name: expired-cluster
+  context:
+    cluster: expired-cluster
+    user: expired-cluster
+- name: login-cluster
+  context:
+    cluster: login-cluster
+    user: login-cluster
+- name: idp-cluster
+  context:
+    cluster: idp-cluster
+    user: idp-cluster
+- name: other-cluster
+  context:
+    cluster: other-cluster
+    user: expired-cluster
+current-context: expired-cluster
+users:
+- name: valid-cluster
+  user:
+    client-certificate-data: %s
+- name: expired-cluster
+  user:
+    client-certificate-data: %s
+- name: login-cluster
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1
+      command: stackit
+      args: [ske, kubeconfig, login]
+      provideClusterInfo: true
+- name: idp-cluster
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1
+      command: stackit
+      args: [ske, kubeconfig, login, --idp]
+      provideClusterInfo: true
+`, base64.StdEncoding.EncodeToString(validCert), base64.StdEncoding.EncodeToString(expiredCert))
+}
+
+func TestCheckKubeconfigExpiry(t *testing.T) {
+	notAfter := time.Now().Add(time.Hour).Truncate(time.Second).UTC()
+
+	tests := []struct {
+		description      string
+		certData         []byte
+		expectedValid    bool
+		expectedNotAfter time.Time
+	}{
+		{
+			description:      "valid",
+			certData:         fixtureCertificate(t, notAfter),
+			expectedValid:    true,
+			expectedNotAfter: notAfter,
+		},
+		{
+			description:   "expired",
+			certData:      fixtureCertificate(t, time.Now().Add(-time.Hour)),
+			expectedValid: false,
+		},
+		{
+			description:   "invalid",
+			certData:      []byte("invalid"),
+			expectedValid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			isValid, expiry := CheckKubeconfigExpiry(tt.certData)
+			if isValid != tt.expectedValid {
+				t.Fatalf("expected valid to be %t, got %t", tt.expectedValid, isValid)
+			}
+			if !expiry.Equal(tt.expectedNotAfter) {
+				t.Fatalf("expected expiry %s, got %s", tt.expectedNotAfter, expiry)
+			}
+		})
+	}
+}
+
+func TestListKubeconfigEntries(t *testing.T) {
+	notAfter := time.Now().Add(time.Hour).Truncate(time.Second).UTC()
+	config, err := clientcmd.Load([]byte(fixtureKubeconfig(fixtureCertificate(t, notAfter), fixtureCertificate(t, time.Now().Add(-time.Hour)))))
+	if err != nil {
+		t.Fatalf("load kubeconfig: %v", err)
+	}
+
+	expected := []KubeconfigEntry{
+		{
+			Context:     "expired-cluster",
+			Cluster:     "expired-cluster",
+			User:        "expired-cluster",
+			ClusterName: "expired-cluster",
+			Type:        KubeconfigTypeAdmin,
+			Current:     true,
+			Expired:     true,
+		},
+		{
+			Context:     "idp-cluster",
+			Cluster:     "idp-cluster",
+			User:        "idp-cluster",
+			ClusterName: "idp-cluster",
+			ProjectId:   "project-id",
+			Region:      "eu02",
+			Type:        KubeconfigTypeIDP,
+		},
+		{
+			Context:     "login-cluster",
+			Cluster:     "login-cluster",
+			User:        "login-cluster",
+			ClusterName: "login-cluster",
+			ProjectId:   "project-id",
+			Region:      "eu01",
+			Type:        KubeconfigTypeLogin,
+		},
+		{
+			Context:     "valid-cluster",
+			Cluster:     "valid-cluster",
+			User:        "valid-cluster",
+			ClusterName: "valid-cluster",
+			Type:        KubeconfigTypeAdmin,
+			ExpiresAt:   utils.Ptr(notAfter),
+		},
+	}
+
+	entries := ListKubeconfigEntries(config)
+	diff := cmp.Diff(entries, expected)
+	if diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+}
+
+func TestListKubeconfigEntriesRenamedAdminCluster(t *testing.T) {
+	config, err := clientcmd.Load([]byte(`apiVersion: v1
+kind: Config
+clusters:
+- name: production
+  cluster:
+    server: https://api.my-cluster.abc1234.s.ske.eu01.onstackit.cloud
+contexts:
+- name: production
+  context:
+    cluster: production
+    user: production
+users:
+- name: production
+  user:
+    token: token
+`))
+	if err != nil {
+		t.Fatalf("load kubeconfig: %v", err)
+	}
+
+	entries := ListKubeconfigEntries(config)
+	if len(entries) != 1 {
+		t.Fatalf("expected 1 entry, got %d", len(entries))
+	}
+	if entries[0].ClusterName != "my-cluster" {
+		t.Fatalf("expected cluster name %q, got %q", "my-cluster", entries[0].ClusterName)
+	}
+
+	filtered := FilterKubeconfigEntriesByCluster(config, entries, testProjectId, "my-cluster", "")
+	if len(filtered) != 1 {
+		t.Fatalf("expected the entry to match its cluster, got %d entries", len(filtered))
+	}
+}
+
+func TestCheckKubeconfigClusters(t *testing.T) {
+	tests := []struct {
+		description      string
+		entry            KubeconfigEntry
+		defaultProjectId string
+		listClustersFail bool
+		expectedExists   *bool
+	}{
+		{
+			description:    "login cluster exists",
+			entry:          KubeconfigEntry{ClusterName: testClusterName, ProjectId: testProjectId, Region: testRegion},
+			expectedExists: utils.Ptr(true),
+		},
+		{
+			description:    "login cluster deleted",
+			entry:          KubeconfigEntry{ClusterName: "deleted-cluster", ProjectId: testProjectId, Region: testRegion},
+			expectedExists: utils.Ptr(false),
+		},
+		{
+			description:      "admin cluster found in default project",
+			entry:            KubeconfigEntry{ClusterName: testClusterName},
+			defaultProjectId: testProjectId,
+			expectedExists:   utils.Ptr(true),
+		},
+		{
+			description:      "admin cluster not found in default project",
+			entry:            KubeconfigEntry{ClusterName: "other-cluster"},
+			defaultProjectId: testProjectId,
+		},
+		{
+			description: "admin cluster without default project",
+			entry:       KubeconfigEntry{ClusterName: testClusterName},
+		},
+		{
+			description:      "list clusters fails",
+			entry:            KubeconfigEntry{ClusterName: testClusterName, ProjectId: testProjectId},
+			listClustersFail: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			client := &ske.DefaultAPIServiceMock{
+				ListClustersExecuteMock: utils.Ptr(func(_ ske.ApiListClustersRequest) (*ske.ListClustersResponse, error) {
+					if tt.listClustersFail {
+						return nil, fmt.Errorf("could not list clusters")
+					}
+					return &ske.ListClustersResponse{Items: []ske.Cluster{{Name: utils.Ptr(testClusterName)}}}, nil
+				}),
+			}
+			entries := []KubeconfigEntry{tt.entry}
+			CheckKubeconfigClusters(context.Background(), client, entries, tt.defaultProjectId, testRegion)
+			diff := cmp.Diff(entries[0].ClusterExists, tt.expectedExists)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestKubeconfigEntryIsStale(t *testing.T) {
+	tests := []struct {
+		description    string
+		entry          KubeconfigEntry
+		expectedStale  bool
+		expectedReason string
+	}{
+		{
+			description: "valid",
+			entry:       KubeconfigEntry{ClusterExists: utils.Ptr(true)},
+		},
+		{
+			description: "unknown existence",
+			entry:       KubeconfigEntry{},
+		},
+		{
+			description:    "expired",
+			entry:          KubeconfigEntry{Expired: true, ClusterExists: utils.Ptr(true)},
+			expectedStale:  true,
+			expectedReason: "certificate expired",
+		},
+		{
+			description:    "cluster deleted",
+			entry:          KubeconfigEntry{Expired: true, ClusterExists: utils.Ptr(false)},
+			expectedStale:  true,
+			expectedReason: "cluster deleted",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			if tt.entry.IsStale() != tt.expectedStale {
+				t.Fatalf("expected stale to be %t", tt.expectedStale)
+			}
+			if reason := tt.entry.StaleReason(); reason != tt.expectedReason {
+				t.Fatalf("expected reason %q, got %q", tt.expectedReason, reason)
+			}
+		})
+	}
+}
+
+func TestRemoveKubeconfigContexts(t *testing.T) {
+	config, err := clientcmd.Load([]byte(fixtureKubeconfig(fixtureCertificate(t, time.Now().Add(time.Hour)), fixtureCertificate(t, time.Now().Add(-time.Hour)))))
+	if err != nil {
+		t.Fatalf("load kubeconfig: %v", err)
+	}
+
+	RemoveKubeconfigContexts(config, []string{"expired-cluster", "login-cluster", "unknown"})
+
+	if config.CurrentContext != "" {
+		t.Errorf("expected current context to be unset, got %q", config.CurrentContext)
+	}
+	for _, name := range []string{"expired-cluster", "login-cluster"} {
+		if _, ok := config.Contexts[name]; ok {
+			t.Errorf("context %q was not removed", name)
+		}
+		if _, ok := config.Clusters[name]; ok {
+			t.Errorf("cluster %q was not removed", name)
+		}
+	}
+	if _, ok := config.AuthInfos["login-cluster"]; ok {
+		t.Errorf("user %q was not removed", "login-cluster")
+	}
+	// The user is still used by the context "other-cluster"
+	if _, ok := config.AuthInfos["expired-cluster"]; !ok {
+		t.Errorf("user %q was removed, but is still used", "expired-cluster")
+	}
+	for _, name := range []string{"valid-cluster", "idp-cluster", "other-cluster"} {
+		if _, ok := config.Contexts[name]; !ok {
+			t.Errorf("context %q was removed", name)
+		}
+	}
+}
+
+func TestRenameKubeconfig(t *testing.T) {
+	const kubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: my-cluster
+  cluster:
+    server: https://api.my-cluster.abc1234.s.ske.eu01.onstackit.cloud
+contexts:
+- name: my-cluster
+  context:
+    cluster: my-cluster
+    user: my-cluster
+current-context: my-cluster
+users:
+- name: my-cluster
+  user:
+    token: token
+`
+
+	tests := []struct {
+		description string
+		content     string
+		isValid     bool
+	}{
+		{
+			description: "base",
+			content:     kubeconfig,
+			isValid:     true,
+		},
+		{
+			description: "no context",
+			content:     "apiVersion: v1\nkind: Config\n",
+			isValid:     false,
+		},
+		{
+			description: "missing user",
+			content:     strings.Replace(kubeconfig, "    user: my-cluster", "    user: other", 1),
+			isValid:     false,
+		},
+		{
+			description: "invalid",
+			content:     "invalid",
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			name := KubeconfigContextName("project-id", "my-cluster")
+			config, err := RenameKubeconfig(tt.content, name)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("did not fail on invalid input")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			if name != "project-id/my-cluster" {
+				t.Fatalf("unexpected context name %q", name)
+			}
+			kubeContext, ok := config.Contexts[name]
+			if !ok {
+				t.Fatalf("context %q not found", name)
+			}
+			if kubeContext.Cluster != name || kubeContext.AuthInfo != name {
+				t.Fatalf("context references cluster %q and user %q", kubeContext.Cluster, kubeContext.AuthInfo)
+			}
+			if config.Clusters[name].Server != "https://api.my-cluster.abc1234.s.ske.eu01.onstackit.cloud" {
+				t.Fatalf("unexpected server %q", config.Clusters[name].Server)
+			}
+			if config.AuthInfos[name].Token != "token" {
+				t.Fatalf("unexpected token %q", config.AuthInfos[name].Token)
+			}
+			if config.CurrentContext != "" {
+				t.Fatalf("expected current context to be unset, got %q", config.CurrentContext)
+			}
+		})
+	}
+}
+
+func TestReplaceKubeconfigCredentials(t *testing.T) {
+	const newKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: valid-cluster
+  cluster:
+    server: https://api.valid-cluster.abc1234.s.ske.eu01.onstackit.cloud
+    certificate-authority-data: bmV3LWNh
+contexts:
+- name: valid-cluster
+  context:
+    cluster: valid-cluster
+    user: valid-cluster
+current-context: valid-cluster
+users:
+- name: valid-cluster
+  user:
+    token: new-token
+`
+
+	tests := []struct {
+		description string
+		context     string
+		content     string
+		isValid     bool
+	}{
+		{
+			description: "base",
+			context:     "valid-cluster",
+			content:     newKubeconfig,
+			isValid:     true,
+		},
+		{
+			description: "context not found",
+			context:     "unknown",
+			content:     newKubeconfig,
+			isValid:     false,
+		},
+		{
+			description: "invalid kubeconfig",
+			context:     "valid-cluster",
+			content:     "invalid",
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			now := time.Now()
+			config, err := clientcmd.Load([]byte(fixtureKubeconfig(fixtureCertificate(t, now.Add(time.Hour)), fixtureCertificate(t, now.Add(-time.Hour)))))
+			if err != nil {
+				t.Fatalf("load kubeconfig: %v", err)
+			}
+
+			err = ReplaceKubeconfigCredentials(config, tt.context, tt.content)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("did not fail on invalid input")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			if string(config.Clusters["valid-cluster"].CertificateAuthorityData) != "new-ca" {
+				t.Fatalf("certificate authority was not replaced")
+			}
+			if config.AuthInfos["valid-cluster"].Token != "new-token" {
+				t.Fatalf("user was not replaced")
+			}
+			if config.Contexts["valid-cluster"].Cluster != "valid-cluster" || config.Contexts["valid-cluster"].AuthInfo != "valid-cluster" {
+				t.Fatalf("context was renamed")
+			}
+			if len(config.Contexts) != 5 || config.CurrentContext != "expired-cluster" {
+				t.Fatalf("other contexts were changed")
+			}
+		})
+	}
+}
+
+func TestKubeconfigServer(t *testing.T) {
+	const kubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: my-cluster
+  cluster:
+    server: https://api.my-cluster.abc1234.s.ske.eu01.onstackit.cloud
+contexts:
+- name: my-cluster
+  context:
+    cluster: my-cluster
+    user: my-cluster
+users:
+- name: my-cluster
+  user:
+    token: token
+`
+
+	server, err := KubeconfigServer(kubeconfig)
+	if err != nil {
+		t.Fatalf("failed on valid input: %v", err)
+	}
+	if server != "https://api.my-cluster.abc1234.s.ske.eu01.onstackit.cloud" {
+		t.Fatalf("unexpected server %q", server)
+	}
+
+	_, err = KubeconfigServer("invalid")
+	if err == nil {
+		t.Fatalf("did not fail on invalid input")
+	}
+}
+
+func TestFilterKubeconfigEntriesByCluster(t *testing.T) {
+	now := time.Now()
+	config, err := clientcmd.Load([]byte(fixtureKubeconfig(fixtureCertificate(t, now.Add(time.Hour)), fixtureCertificate(t, now.Add(-time.Hour)))))
+	if err != nil {
+		t.Fatalf("load kubeconfig: %v", err)
+	}
+	entries := ListKubeconfigEntries(config)
+
+	tests := []struct {
+		description      string
+		projectId        string
+		clusterName      string
+		server           string
+		expectedContexts []string
+	}{
+		{
+			description:      "admin kubeconfig",
+			projectId:        "project-id",
+			clusterName:      "valid-cluster",
+			expectedContexts: []string{"valid-cluster"},
+		},
+		{
+			description:      "admin kubeconfig by server",
+			projectId:        "project-id",
+			clusterName:      "valid-cluster",
+			server:           "https://api.valid-cluster.abc1234.s.ske.eu01.onstackit.cloud",
+			expectedContexts: []string{"valid-cluster"},
+		},
+		{
+			description:      "admin kubeconfig of other server",
+			projectId:        "project-id",
+			clusterName:      "valid-cluster",
+			server:           "https://api.valid-cluster.xyz9876.s.ske.eu01.onstackit.cloud",
+			expectedContexts: []string{},
+		},
+		{
+			description:      "login kubeconfig",
+			projectId:        "project-id",
+			clusterName:      "login-cluster",
+			expectedContexts: []string{"login-cluster"},
+		},
+		{
+			description:      "idp kubeconfig",
+			projectId:        "project-id",
+			clusterName:      "idp-cluster",
+			expectedContexts: []string{"idp-cluster"},
+		},
+		{
+			description:      "other project",
+			projectId:        "other-project-id",
+			clusterName:      "login-cluster",
+			expectedContexts: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			filtered := FilterKubeconfigEntriesByCluster(config, entries, tt.projectId, tt.clusterName, tt.server)
+
+			contexts := []string{}
+			for i := range filtered {
+				contexts = append(contexts, filtered[i].Context)
+			}
+			diff := cmp.Diff(contexts, tt.expectedContexts)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
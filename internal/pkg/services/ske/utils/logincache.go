@@ -0,0 +1,103 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/auth"
+)
+
+// LoginCachePrefix is the prefix of the cache identifiers of the credentials cached by "stackit ske kubeconfig login"
+const LoginCachePrefix = "ske-login-"
+
+// LoginCacheObject is the cached credentials of a cluster.
+// Older versions only cached the credentials, so the cluster of such objects is unknown
+type LoginCacheObject struct {
+	ProjectId   string `json:"projectId"`
+	Region      string `json:"region"`
+	ClusterName string `json:"clusterName"`
+	// Type is KubeconfigTypeLogin for a kubeconfig with a client certificate, or KubeconfigTypeIDP for a token
+	Type        string `json:"type"`
+	Credentials string `json:"credentials"`
+}
+
+// LoginCacheEntry describes a cached object of the login command
+type LoginCacheEntry struct {
+	Key         string     `json:"key"`
+	ProjectId   string     `json:"projectId,omitempty"`
+	Region      string     `json:"region,omitempty"`
+	ClusterName string     `json:"clusterName,omitempty"`
+	Type        string     `json:"type"`
+	ExpiresAt   *time.Time `json:"expiresAt,omitempty"`
+	Expired     bool       `json:"expired"`
+}
+
+// ParseLoginCacheObject parses a cached object, which may also consist of only the credentials
+func ParseLoginCacheObject(data []byte) *LoginCacheObject {
+	object := &LoginCacheObject{}
+	err := json.Unmarshal(data, object)
+	if err == nil && object.Credentials != "" {
+		return object
+	}
+
+	object = &LoginCacheObject{
+		Type:        KubeconfigTypeLogin,
+		Credentials: string(data),
+	}
+	if _, err := auth.TokenExpirationTime(object.Credentials); err == nil {
+		object.Type = KubeconfigTypeIDP
+	}
+	return object
+}
+
+// ExpiresAt returns the expiration of the client certificate or token
+func (o *LoginCacheObject) ExpiresAt() (time.Time, error) {
+	if o.Type == KubeconfigTypeIDP {
+		return auth.TokenExpirationTime(o.Credentials)
+	}
+
+	config, err := clientcmd.RESTConfigFromKubeConfig([]byte(o.Credentials))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse kubeconfig: %w", err)
+	}
+	certificate, err := parseCertificate(config.CertData)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return certificate.NotAfter.UTC(), nil
+}
+
+// NewLoginCacheEntry describes the cached object with the given key.
+// Objects whose expiration can't be determined are considered expired
+func NewLoginCacheEntry(key string, object *LoginCacheObject, now time.Time) LoginCacheEntry {
+	entry := LoginCacheEntry{
+		Key:         key,
+		ProjectId:   object.ProjectId,
+		Region:      object.Region,
+		ClusterName: object.ClusterName,
+		Type:        object.Type,
+	}
+	expiresAt, err := object.ExpiresAt()
+	if err != nil || expiresAt.IsZero() {
+		entry.Expired = true
+		return entry
+	}
+	entry.ExpiresAt = &expiresAt
+	entry.Expired = !now.Before(expiresAt)
+	return entry
+}
+
+// LoginCacheObjectExpired returns true if the credentials of the cached object are expired or invalid
+func LoginCacheObjectExpired(data []byte, now time.Time) bool {
+	return NewLoginCacheEntry("", ParseLoginCacheObject(data), now).Expired
+}
+
+// LoginCacheObjectOfCluster returns true if the cached object holds credentials of the cluster.
+// The cluster of objects of older versions is unknown, so they never match
+func LoginCacheObjectOfCluster(data []byte, projectId, region, clusterName string) bool {
+	object := ParseLoginCacheObject(data)
+	return object.ProjectId == projectId && object.Region == region && object.ClusterName == clusterName
+}
@@ -0,0 +1,277 @@
+package utils
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+)
+
+// testLoginCertificate is valid from 2024-01-01T00:00:00Z to 2024-01-01T01:00:00Z
+const testLoginCertificate = `-----BEGIN CERTIFICATE-----
+MIIBhTCCASugAwIBAgIIF8+zRM8UalAwCgYIKoZIzj0EAwIwGDEWMBQGA1UEAxMN
+Y2EtY2xpZW50LXh5ejAeFw0yNDAxMDEwMDAwMDBaFw0yNDAxMDEwMTAwMDBaMC8x
+FzAVBgNVBAoTDnN5c3RlbTptYXN0ZXJzMRQwEgYDVQQDEwtza2U6Y2x1c3RlcjBZ
+MBMGByqGSM49AgEGCCqGSM49AwEHA0IABJaxZ8G4wEZ1xf44hMV1pQWsti5SL6PH
+QF0bRniQEJHSOcZMwc0OrVIfuSV1qSMyvYIaFtBj1j9f2v8oPux7V02jSDBGMA4G
+A1UdDwEB/wQEAwIFoDATBgNVHSUEDDAKBggrBgEFBQcDAjAfBgNVHSMEGDAWgBQt
+Pn1pNgfb8xcdRVxVnHDIvb8abzAKBggqhkjOPQQDAgNIADBFAiEA8gG2l0schbMu
+zbRjZmli7cnenEnfnNoFIGbgkbjGXRUCIC5zFtWXFK7kA+B2vDxD0DlLcQodNwi4
+2JKP8gT9ol16
+-----END CERTIFICATE-----`
+
+var testLoginKubeconfig = fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- name: cluster
+  cluster:
+    server: https://api.cluster.abc1234.s.ske.eu01.onstackit.cloud
+contexts:
+- name: cluster
+  context:
+    cluster: cluster
+    user: cluster
+current-context: cluster
+users:
+- name: cluster
+  user:
+    client-certificate-data: %s
+    client-key-data: %s
+`, base64.StdEncoding.EncodeToString([]byte(testLoginCertificate)), base64.StdEncoding.EncodeToString([]byte("key")))
+
+var testLoginCertificateExpiration = time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC)
+
+func fixtureLoginToken(t *testing.T, expiresAt time.Time) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.RegisteredClaims{
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+	}).SigningString()
+	if err != nil {
+		t.Fatalf("token generation failed: %v", err)
+	}
+	return token + ".signatureAAA"
+}
+
+func TestParseLoginCacheObject(t *testing.T) {
+	token := fixtureLoginToken(t, testLoginCertificateExpiration)
+	object := &LoginCacheObject{
+		ProjectId:   testProjectId,
+		Region:      "eu01",
+		ClusterName: testClusterName,
+		Type:        KubeconfigTypeIDP,
+		Credentials: token,
+	}
+	data, err := json.Marshal(object)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	tests := []struct {
+		description    string
+		data           []byte
+		expectedObject *LoginCacheObject
+	}{
+		{
+			description:    "object",
+			data:           data,
+			expectedObject: object,
+		},
+		{
+			description: "only kubeconfig",
+			data:        []byte(testLoginKubeconfig),
+			expectedObject: &LoginCacheObject{
+				Type:        KubeconfigTypeLogin,
+				Credentials: testLoginKubeconfig,
+			},
+		},
+		{
+			description: "only token",
+			data:        []byte(token),
+			expectedObject: &LoginCacheObject{
+				Type:        KubeconfigTypeIDP,
+				Credentials: token,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			object := ParseLoginCacheObject(tt.data)
+			diff := cmp.Diff(object, tt.expectedObject)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewLoginCacheEntry(t *testing.T) {
+	tokenExpiration := time.Date(2024, 1, 1, 0, 30, 0, 0, time.UTC)
+	now := time.Date(2024, 1, 1, 0, 45, 0, 0, time.UTC)
+
+	tests := []struct {
+		description   string
+		object        *LoginCacheObject
+		expectedEntry LoginCacheEntry
+	}{
+		{
+			description: "valid kubeconfig",
+			object: &LoginCacheObject{
+				ProjectId:   testProjectId,
+				Region:      "eu01",
+				ClusterName: testClusterName,
+				Type:        KubeconfigTypeLogin,
+				Credentials: testLoginKubeconfig,
+			},
+			expectedEntry: LoginCacheEntry{
+				Key:         "key",
+				ProjectId:   testProjectId,
+				Region:      "eu01",
+				ClusterName: testClusterName,
+				Type:        KubeconfigTypeLogin,
+				ExpiresAt:   utils.Ptr(testLoginCertificateExpiration),
+			},
+		},
+		{
+			description: "expired token",
+			object: &LoginCacheObject{
+				Type:        KubeconfigTypeIDP,
+				Credentials: fixtureLoginToken(t, tokenExpiration),
+			},
+			expectedEntry: LoginCacheEntry{
+				Key:       "key",
+				Type:      KubeconfigTypeIDP,
+				ExpiresAt: utils.Ptr(tokenExpiration),
+				Expired:   true,
+			},
+		},
+		{
+			description: "invalid credentials",
+			object: &LoginCacheObject{
+				Type:        KubeconfigTypeLogin,
+				Credentials: "invalid",
+			},
+			expectedEntry: LoginCacheEntry{
+				Key:     "key",
+				Type:    KubeconfigTypeLogin,
+				Expired: true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			entry := NewLoginCacheEntry("key", tt.object, now)
+			diff := cmp.Diff(entry, tt.expectedEntry)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLoginCacheObjectExpired(t *testing.T) {
+	tests := []struct {
+		description string
+		now         time.Time
+		expected    bool
+	}{
+		{
+			description: "valid",
+			now:         testLoginCertificateExpiration.Add(-time.Minute),
+			expected:    false,
+		},
+		{
+			description: "expired",
+			now:         testLoginCertificateExpiration,
+			expected:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			expired := LoginCacheObjectExpired([]byte(testLoginKubeconfig), tt.now)
+			if expired != tt.expected {
+				t.Fatalf("expected %t, got %t", tt.expected, expired)
+			}
+		})
+	}
+}
+
+func TestLoginCacheObjectOfCluster(t *testing.T) {
+	data, err := json.Marshal(&LoginCacheObject{
+		ProjectId:   testProjectId,
+		Region:      "eu01",
+		ClusterName: testClusterName,
+		Type:        KubeconfigTypeLogin,
+		Credentials: testLoginKubeconfig,
+	})
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	tests := []struct {
+		description string
+		data        []byte
+		projectId   string
+		region      string
+		clusterName string
+		expected    bool
+	}{
+		{
+			description: "same cluster",
+			data:        data,
+			projectId:   testProjectId,
+			region:      "eu01",
+			clusterName: testClusterName,
+			expected:    true,
+		},
+		{
+			description: "other project",
+			data:        data,
+			projectId:   "other-project",
+			region:      "eu01",
+			clusterName: testClusterName,
+			expected:    false,
+		},
+		{
+			description: "other region",
+			data:        data,
+			projectId:   testProjectId,
+			region:      "eu02",
+			clusterName: testClusterName,
+			expected:    false,
+		},
+		{
+			description: "other cluster",
+			data:        data,
+			projectId:   testProjectId,
+			region:      "eu01",
+			clusterName: "other-cluster",
+			expected:    false,
+		},
+		{
+			description: "only kubeconfig",
+			data:        []byte(testLoginKubeconfig),
+			projectId:   testProjectId,
+			region:      "eu01",
+			clusterName: testClusterName,
+			expected:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			matches := LoginCacheObjectOfCluster(tt.data, tt.projectId, tt.region, tt.clusterName)
+			if matches != tt.expected {
+				t.Fatalf("expected %t, got %t", tt.expected, matches)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package utils
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	ske "github.com/stackitcloud/stackit-sdk-go/services/ske/v2api"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+)
+
+const (
+	NodepoolMachineTypeFlag       = "machine-type"
+	NodepoolMinimumFlag           = "min"
+	NodepoolMaximumFlag           = "max"
+	NodepoolMaxSurgeFlag          = "max-surge"
+	NodepoolMaxUnavailableFlag    = "max-unavailable"
+	NodepoolAvailabilityZonesFlag = "availability-zones"
+	NodepoolImageNameFlag         = "image-name"
+	NodepoolImageVersionFlag      = "image-version"
+	NodepoolCRIFlag               = "cri"
+	NodepoolVolumeTypeFlag        = "volume-type"
+	NodepoolVolumeSizeFlag        = "volume-size"
+	NodepoolLabelsFlag            = "labels"
+	NodepoolTaintsFlag            = "taints"
+)
+
+// taintEffects are the effects a taint can have, see https://kubernetes.io/docs/concepts/scheduling-eviction/taint-and-toleration/
+var taintEffects = []string{"NoSchedule", "PreferNoSchedule", "NoExecute"}
+
+// ConfigureNodepoolFlags adds the flags which change the spec of a single nodepool to the command
+func ConfigureNodepoolFlags(cmd *cobra.Command) {
+	cmd.Flags().String(NodepoolMachineTypeFlag, "", "Machine type of the nodes")
+	cmd.Flags().Int32(NodepoolMinimumFlag, 0, "Minimum number of nodes")
+	cmd.Flags().Int32(NodepoolMaximumFlag, 0, "Maximum number of nodes")
+	cmd.Flags().Int32(NodepoolMaxSurgeFlag, 0, "Maximum number of additional nodes during an update")
+	cmd.Flags().Int32(NodepoolMaxUnavailableFlag, 0, "Maximum number of unavailable nodes during an update")
+	cmd.Flags().StringSlice(NodepoolAvailabilityZonesFlag, nil, "Availability zones of the nodes")
+	cmd.Flags().String(NodepoolImageNameFlag, "", `Name of the OS image of the nodes (example: "flatcar"). If the image is changed, its version must be set too`)
+	cmd.Flags().String(NodepoolImageVersionFlag, "", "Version of the OS image of the nodes")
+	cmd.Flags().String(NodepoolCRIFlag, "", `Container runtime of the nodes (example: "containerd")`)
+	cmd.Flags().String(NodepoolVolumeTypeFlag, "", "Type of the volumes of the nodes")
+	cmd.Flags().Int32(NodepoolVolumeSizeFlag, 0, "Size of the volumes of the nodes in GB")
+	cmd.Flags().StringToString(NodepoolLabelsFlag, nil, `Kubernetes labels of the nodes, in the format "KEY=VALUE[,KEY=VALUE...]". Replaces the current labels`)
+	cmd.Flags().StringSlice(NodepoolTaintsFlag, nil, `Kubernetes taints of the nodes, in the format "KEY[=VALUE]:EFFECT[,...]", where the effect is one of NoSchedule, PreferNoSchedule and NoExecute. Replaces the current taints, set to "" to remove them`)
+}
+
+// ParseNodepoolFlags parses the flags configured by ConfigureNodepoolFlags
+func ParseNodepoolFlags(p *print.Printer, cmd *cobra.Command, name string) (*NodepoolOptions, error) {
+	opts := &NodepoolOptions{
+		Name:              name,
+		MachineType:       flags.FlagToStringPointer(p, cmd, NodepoolMachineTypeFlag),
+		Minimum:           flags.FlagToInt32Pointer(p, cmd, NodepoolMinimumFlag),
+		Maximum:           flags.FlagToInt32Pointer(p, cmd, NodepoolMaximumFlag),
+		MaxSurge:          flags.FlagToInt32Pointer(p, cmd, NodepoolMaxSurgeFlag),
+		MaxUnavailable:    flags.FlagToInt32Pointer(p, cmd, NodepoolMaxUnavailableFlag),
+		AvailabilityZones: flags.FlagToStringSliceValue(p, cmd, NodepoolAvailabilityZonesFlag),
+		ImageName:         flags.FlagToStringPointer(p, cmd, NodepoolImageNameFlag),
+		ImageVersion:      flags.FlagToStringPointer(p, cmd, NodepoolImageVersionFlag),
+		VolumeType:        flags.FlagToStringPointer(p, cmd, NodepoolVolumeTypeFlag),
+		VolumeSize:        flags.FlagToInt32Pointer(p, cmd, NodepoolVolumeSizeFlag),
+		Labels:            flags.FlagToStringToStringPointer(p, cmd, NodepoolLabelsFlag),
+	}
+
+	for flag, value := range map[string]*int32{
+		NodepoolMinimumFlag:        opts.Minimum,
+		NodepoolMaximumFlag:        opts.Maximum,
+		NodepoolMaxSurgeFlag:       opts.MaxSurge,
+		NodepoolMaxUnavailableFlag: opts.MaxUnavailable,
+		NodepoolVolumeSizeFlag:     opts.VolumeSize,
+	} {
+		if value != nil && *value < 0 {
+			return nil, &errors.FlagValidationError{
+				Flag:    flag,
+				Details: "must not be negative",
+			}
+		}
+	}
+
+	cri := flags.FlagToStringPointer(p, cmd, NodepoolCRIFlag)
+	if cri != nil {
+		criName, err := ske.NewNameOfTheCRILibraryFromValue(*cri)
+		if err != nil {
+			return nil, &errors.FlagValidationError{
+				Flag:    NodepoolCRIFlag,
+				Details: err.Error(),
+			}
+		}
+		opts.CRI = criName
+	}
+
+	taintValues := flags.FlagToStringSlicePointer(p, cmd, NodepoolTaintsFlag)
+	if taintValues != nil {
+		taints, err := ParseTaints(*taintValues)
+		if err != nil {
+			return nil, &errors.FlagValidationError{
+				Flag:    NodepoolTaintsFlag,
+				Details: err.Error(),
+			}
+		}
+		opts.Taints = &taints
+	}
+	return opts, nil
+}
+
+// IsEmpty returns true if no changes to the nodepool are set
+func (o *NodepoolOptions) IsEmpty() bool {
+	return o.MachineType == nil && o.Minimum == nil && o.Maximum == nil && o.MaxSurge == nil && o.MaxUnavailable == nil &&
+		len(o.AvailabilityZones) == 0 && o.ImageName == nil && o.ImageVersion == nil && o.CRI == nil &&
+		o.VolumeType == nil && o.VolumeSize == nil && o.Labels == nil && o.Taints == nil
+}
+
+// ParseTaints parses taints in the format "KEY[=VALUE]:EFFECT", like kubectl does. Empty values are ignored
+func ParseTaints(values []string) ([]ske.Taint, error) {
+	taints := []ske.Taint{}
+	for _, value := range values {
+		if strings.TrimSpace(value) == "" {
+			continue
+		}
+		keyValue, effect, found := strings.Cut(strings.TrimSpace(value), ":")
+		if !found {
+			return nil, fmt.Errorf("taint %q is not in the format KEY[=VALUE]:EFFECT", value)
+		}
+		if !slices.Contains(taintEffects, effect) {
+			return nil, fmt.Errorf("effect %q of taint %q is not one of: %s", effect, value, strings.Join(taintEffects, ", "))
+		}
+		key, taintValue, hasValue := strings.Cut(keyValue, "=")
+		if key == "" {
+			return nil, fmt.Errorf("key of taint %q is empty", value)
+		}
+
+		taint := ske.Taint{
+			Key:    key,
+			Effect: ske.TaintEffect(effect),
+		}
+		if hasValue {
+			taint.Value = &taintValue
+		}
+		taints = append(taints, taint)
+	}
+	return taints, nil
+}
+
+// FormatTaints returns the taints in the format "KEY[=VALUE]:EFFECT"
+func FormatTaints(taints []ske.Taint) []string {
+	formatted := []string{}
+	for i := range taints {
+		keyValue := taints[i].Key
+		if taints[i].Value != nil {
+			keyValue = fmt.Sprintf("%s=%s", keyValue, *taints[i].Value)
+		}
+		formatted = append(formatted, fmt.Sprintf("%s:%s", keyValue, taints[i].Effect))
+	}
+	return formatted
+}
+
+// FormatLabels returns the labels in the format "KEY=VALUE", sorted by key
+func FormatLabels(labels map[string]string) []string {
+	formatted := []string{}
+	for key, value := range labels {
+		formatted = append(formatted, fmt.Sprintf("%s=%s", key, value))
+	}
+	slices.Sort(formatted)
+	return formatted
+}
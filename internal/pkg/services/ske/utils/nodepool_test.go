@@ -0,0 +1,109 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	ske "github.com/stackitcloud/stackit-sdk-go/services/ske/v2api"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+)
+
+func TestParseTaints(t *testing.T) {
+	tests := []struct {
+		description string
+		values      []string
+		isValid     bool
+		expected    []ske.Taint
+	}{
+		{
+			description: "no taints",
+			values:      []string{},
+			isValid:     true,
+			expected:    []ske.Taint{},
+		},
+		{
+			description: "empty value",
+			values:      []string{""},
+			isValid:     true,
+			expected:    []ske.Taint{},
+		},
+		{
+			description: "taints",
+			values:      []string{"dedicated=gpu:NoSchedule", " maintenance:NoExecute"},
+			isValid:     true,
+			expected: []ske.Taint{
+				{Key: "dedicated", Value: utils.Ptr("gpu"), Effect: ske.TaintEffect("NoSchedule")},
+				{Key: "maintenance", Effect: ske.TaintEffect("NoExecute")},
+			},
+		},
+		{
+			description: "empty taint value",
+			values:      []string{"dedicated=:PreferNoSchedule"},
+			isValid:     true,
+			expected: []ske.Taint{
+				{Key: "dedicated", Value: utils.Ptr(""), Effect: ske.TaintEffect("PreferNoSchedule")},
+			},
+		},
+		{
+			description: "effect missing",
+			values:      []string{"dedicated=gpu"},
+			isValid:     false,
+		},
+		{
+			description: "invalid effect",
+			values:      []string{"dedicated=gpu:NoWay"},
+			isValid:     false,
+		},
+		{
+			description: "key missing",
+			values:      []string{"=gpu:NoSchedule"},
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			taints, err := ParseTaints(tt.values)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("did not fail on invalid input")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			diff := cmp.Diff(taints, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFormatTaints(t *testing.T) {
+	taints := []ske.Taint{
+		{Key: "dedicated", Value: utils.Ptr("gpu"), Effect: ske.TaintEffect("NoSchedule")},
+		{Key: "maintenance", Effect: ske.TaintEffect("NoExecute")},
+	}
+	expected := []string{"dedicated=gpu:NoSchedule", "maintenance:NoExecute"}
+
+	diff := cmp.Diff(FormatTaints(taints), expected)
+	if diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+}
+
+func TestFormatLabels(t *testing.T) {
+	labels := map[string]string{"team": "a", "env": "prod"}
+	expected := []string{"env=prod", "team=a"}
+
+	diff := cmp.Diff(FormatLabels(labels), expected)
+	if diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+	if formatted := FormatLabels(nil); len(formatted) != 0 {
+		t.Fatalf("expected no labels, got %v", formatted)
+	}
+}
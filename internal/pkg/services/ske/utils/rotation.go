@@ -0,0 +1,62 @@
+package utils
+
+import (
+	"fmt"
+
+	ske "github.com/stackitcloud/stackit-sdk-go/services/ske/v2api"
+)
+
+// Phases of the credentials rotation of a cluster
+const (
+	CredentialsRotationPhaseNever      = "NEVER"
+	CredentialsRotationPhasePreparing  = "PREPARING"
+	CredentialsRotationPhasePrepared   = "PREPARED"
+	CredentialsRotationPhaseCompleting = "COMPLETING"
+	CredentialsRotationPhaseCompleted  = "COMPLETED"
+)
+
+// CredentialsRotationPlan are the remaining steps to rotate the credentials of a cluster, depending on the phase of the rotation
+type CredentialsRotationPlan struct {
+	Phase string `json:"phase"`
+	// Start the rotation, which is followed by waiting for the cluster to be prepared
+	Start bool `json:"start"`
+	// WaitForPrepared waits until the new credentials were added to the cluster
+	WaitForPrepared bool `json:"waitForPrepared"`
+	// UpdateKubeconfigs replaces the credentials of the local kubeconfigs and runs the verification
+	UpdateKubeconfigs bool `json:"updateKubeconfigs"`
+	// Complete the rotation, which removes the old credentials from the cluster
+	Complete bool `json:"complete"`
+}
+
+// CredentialsRotationPhase returns the phase of the credentials rotation of the cluster, which is NEVER if unknown
+func CredentialsRotationPhase(cluster *ske.Cluster) string {
+	if cluster == nil || cluster.Status == nil || cluster.Status.CredentialsRotation == nil || cluster.Status.CredentialsRotation.Phase == nil {
+		return CredentialsRotationPhaseNever
+	}
+	return string(*cluster.Status.CredentialsRotation.Phase)
+}
+
+// PlanCredentialsRotation determines the steps to rotate the credentials of a cluster in the given phase.
+// A rotation which was interrupted is resumed, a completed rotation is followed by a new one
+func PlanCredentialsRotation(phase string) (*CredentialsRotationPlan, error) {
+	plan := &CredentialsRotationPlan{Phase: phase}
+	switch phase {
+	case "", CredentialsRotationPhaseNever, CredentialsRotationPhaseCompleted:
+		plan.Start = true
+		plan.WaitForPrepared = true
+		plan.UpdateKubeconfigs = true
+		plan.Complete = true
+	case CredentialsRotationPhasePreparing:
+		plan.WaitForPrepared = true
+		plan.UpdateKubeconfigs = true
+		plan.Complete = true
+	case CredentialsRotationPhasePrepared:
+		plan.UpdateKubeconfigs = true
+		plan.Complete = true
+	case CredentialsRotationPhaseCompleting:
+		// only the completion has to be awaited
+	default:
+		return nil, fmt.Errorf("unknown phase %q of the credentials rotation", phase)
+	}
+	return plan, nil
+}
@@ -0,0 +1,94 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPlanCredentialsRotation(t *testing.T) {
+	tests := []struct {
+		description  string
+		phase        string
+		isValid      bool
+		expectedPlan *CredentialsRotationPlan
+	}{
+		{
+			description: "never rotated",
+			phase:       CredentialsRotationPhaseNever,
+			isValid:     true,
+			expectedPlan: &CredentialsRotationPlan{
+				Phase:             CredentialsRotationPhaseNever,
+				Start:             true,
+				WaitForPrepared:   true,
+				UpdateKubeconfigs: true,
+				Complete:          true,
+			},
+		},
+		{
+			description: "previous rotation completed",
+			phase:       CredentialsRotationPhaseCompleted,
+			isValid:     true,
+			expectedPlan: &CredentialsRotationPlan{
+				Phase:             CredentialsRotationPhaseCompleted,
+				Start:             true,
+				WaitForPrepared:   true,
+				UpdateKubeconfigs: true,
+				Complete:          true,
+			},
+		},
+		{
+			description: "resume preparing",
+			phase:       CredentialsRotationPhasePreparing,
+			isValid:     true,
+			expectedPlan: &CredentialsRotationPlan{
+				Phase:             CredentialsRotationPhasePreparing,
+				WaitForPrepared:   true,
+				UpdateKubeconfigs: true,
+				Complete:          true,
+			},
+		},
+		{
+			description: "resume prepared",
+			phase:       CredentialsRotationPhasePrepared,
+			isValid:     true,
+			expectedPlan: &CredentialsRotationPlan{
+				Phase:             CredentialsRotationPhasePrepared,
+				UpdateKubeconfigs: true,
+				Complete:          true,
+			},
+		},
+		{
+			description: "resume completing",
+			phase:       CredentialsRotationPhaseCompleting,
+			isValid:     true,
+			expectedPlan: &CredentialsRotationPlan{
+				Phase: CredentialsRotationPhaseCompleting,
+			},
+		},
+		{
+			description: "unknown phase",
+			phase:       "unknown",
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			plan, err := PlanCredentialsRotation(tt.phase)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("did not fail on invalid input")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			diff := cmp.Diff(plan, tt.expectedPlan)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,459 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	ske "github.com/stackitcloud/stackit-sdk-go/services/ske/v2api"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+)
+
+const (
+	KubernetesVersionFlag       = "kubernetes-version"
+	NodepoolFlag                = "nodepool"
+	RemoveNodepoolFlag          = "remove-nodepool"
+	MaintenanceWindowStartFlag  = "maintenance-window-start"
+	MaintenanceWindowEndFlag    = "maintenance-window-end"
+	HibernationStartFlag        = "hibernation-start"
+	HibernationEndFlag          = "hibernation-end"
+	HibernationTimezoneFlag     = "hibernation-timezone"
+	ACLFlag                     = "acl"
+	DisableACLFlag              = "disable-acl"
+	ObservabilityInstanceIdFlag = "observability-instance-id"
+	DisableObservabilityFlag    = "disable-observability"
+	DNSZonesFlag                = "dns-zones"
+	DisableDNSFlag              = "disable-dns"
+
+	nodepoolNameKey        = "name"
+	nodepoolMachineTypeKey = "machine-type"
+	nodepoolMinimumKey     = "min"
+	nodepoolMaximumKey     = "max"
+	nodepoolZonesKey       = "zones"
+)
+
+// maintenanceWindowLayouts are the accepted formats of the maintenance window flags, without a time zone UTC is used
+var maintenanceWindowLayouts = []string{"15:04Z07:00", "15:04:05Z07:00", "15:04", "15:04:05"}
+
+// NodepoolOptions are the changes to a nodepool, set with the --nodepool flag or the flags configured by ConfigureNodepoolFlags
+type NodepoolOptions struct {
+	Name              string
+	MachineType       *string
+	Minimum           *int32
+	Maximum           *int32
+	MaxSurge          *int32
+	MaxUnavailable    *int32
+	AvailabilityZones []string
+	ImageName         *string
+	ImageVersion      *string
+	CRI               *ske.NameOfTheCRILibrary
+	VolumeType        *string
+	VolumeSize        *int32
+	Labels            *map[string]string
+	Taints            *[]ske.Taint
+}
+
+// ClusterSpecOptions are the changes to the spec of a cluster, set with the flags configured by ConfigureClusterSpecFlags
+type ClusterSpecOptions struct {
+	KubernetesVersion       *string
+	Nodepools               []NodepoolOptions
+	RemoveNodepools         []string
+	MaintenanceWindowStart  *time.Time
+	MaintenanceWindowEnd    *time.Time
+	HibernationStart        *string
+	HibernationEnd          *string
+	HibernationTimezone     *string
+	ACL                     *[]string
+	DisableACL              bool
+	ObservabilityInstanceId *string
+	DisableObservability    bool
+	DNSZones                *[]string
+	DisableDNS              bool
+}
+
+// IsEmpty returns true if no changes to the cluster spec are set
+func (o *ClusterSpecOptions) IsEmpty() bool {
+	return o.KubernetesVersion == nil && len(o.Nodepools) == 0 && len(o.RemoveNodepools) == 0 &&
+		o.MaintenanceWindowStart == nil && o.MaintenanceWindowEnd == nil &&
+		o.HibernationStart == nil && o.HibernationEnd == nil && o.HibernationTimezone == nil &&
+		o.ACL == nil && !o.DisableACL &&
+		o.ObservabilityInstanceId == nil && !o.DisableObservability &&
+		o.DNSZones == nil && !o.DisableDNS
+}
+
+// ConfigureClusterSpecFlags adds the flags which change the spec of a cluster to the command
+func ConfigureClusterSpecFlags(cmd *cobra.Command) {
+	cmd.Flags().String(KubernetesVersionFlag, "", "Kubernetes version of the cluster")
+	cmd.Flags().StringArray(NodepoolFlag, nil, fmt.Sprintf("Nodepool to add or change, in the format %q. Only the name is required for existing nodepools, new nodepools also need a machine type and are based on the default nodepool. Can be repeated", "name=NAME,machine-type=TYPE,min=N,max=N,zones=ZONE[,ZONE...]"))
+	cmd.Flags().StringSlice(RemoveNodepoolFlag, nil, "Names of the nodepools to remove")
+	cmd.Flags().String(MaintenanceWindowStartFlag, "", `Start of the daily maintenance window, in the format "HH:MM" with an optional UTC offset (example: "03:00+02:00"). Defaults to UTC`)
+	cmd.Flags().String(MaintenanceWindowEndFlag, "", `End of the daily maintenance window, in the format "HH:MM" with an optional UTC offset (example: "05:00+02:00"). Defaults to UTC`)
+	cmd.Flags().String(HibernationStartFlag, "", `Cron expression of the start of the hibernation (example: "0 18 * * 1-5"). Replaces all hibernation schedules of the cluster`)
+	cmd.Flags().String(HibernationEndFlag, "", `Cron expression of the end of the hibernation (example: "0 8 * * 1-5")`)
+	cmd.Flags().String(HibernationTimezoneFlag, "", `Time zone of the hibernation schedule (example: "Europe/Berlin")`)
+	cmd.Flags().Var(flags.CIDRSliceFlag(), ACLFlag, "List of IP networks in CIDR notation which are allowed to access the Kubernetes API. Enables the ACL extension")
+	cmd.Flags().Bool(DisableACLFlag, false, "If set, disables the ACL extension")
+	cmd.Flags().Var(flags.UUIDFlag(), ObservabilityInstanceIdFlag, "ID of the Observability instance to send the metrics to. Enables the observability extension")
+	cmd.Flags().Bool(DisableObservabilityFlag, false, "If set, disables the observability extension")
+	cmd.Flags().StringSlice(DNSZonesFlag, nil, "List of DNS zones which the cluster may manage records in. Enables the DNS extension")
+	cmd.Flags().Bool(DisableDNSFlag, false, "If set, disables the DNS extension")
+
+	cmd.MarkFlagsRequiredTogether(HibernationStartFlag, HibernationEndFlag)
+	cmd.MarkFlagsMutuallyExclusive(ACLFlag, DisableACLFlag)
+	cmd.MarkFlagsMutuallyExclusive(ObservabilityInstanceIdFlag, DisableObservabilityFlag)
+	cmd.MarkFlagsMutuallyExclusive(DNSZonesFlag, DisableDNSFlag)
+}
+
+// ParseClusterSpecFlags parses the flags configured by ConfigureClusterSpecFlags
+func ParseClusterSpecFlags(p *print.Printer, cmd *cobra.Command) (*ClusterSpecOptions, error) {
+	var nodepools []NodepoolOptions
+	for _, value := range flags.FlagToStringArrayValue(p, cmd, NodepoolFlag) {
+		nodepool, err := ParseNodepool(value)
+		if err != nil {
+			return nil, &errors.FlagValidationError{
+				Flag:    NodepoolFlag,
+				Details: err.Error(),
+			}
+		}
+		nodepools = append(nodepools, *nodepool)
+	}
+
+	maintenanceWindowStart, err := parseMaintenanceWindow(p, cmd, MaintenanceWindowStartFlag)
+	if err != nil {
+		return nil, err
+	}
+	maintenanceWindowEnd, err := parseMaintenanceWindow(p, cmd, MaintenanceWindowEndFlag)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &ClusterSpecOptions{
+		KubernetesVersion:       flags.FlagToStringPointer(p, cmd, KubernetesVersionFlag),
+		Nodepools:               nodepools,
+		RemoveNodepools:         flags.FlagToStringSliceValue(p, cmd, RemoveNodepoolFlag),
+		MaintenanceWindowStart:  maintenanceWindowStart,
+		MaintenanceWindowEnd:    maintenanceWindowEnd,
+		HibernationStart:        flags.FlagToStringPointer(p, cmd, HibernationStartFlag),
+		HibernationEnd:          flags.FlagToStringPointer(p, cmd, HibernationEndFlag),
+		HibernationTimezone:     flags.FlagToStringPointer(p, cmd, HibernationTimezoneFlag),
+		ACL:                     flags.FlagToStringSlicePointer(p, cmd, ACLFlag),
+		DisableACL:              flags.FlagToBoolValue(p, cmd, DisableACLFlag),
+		ObservabilityInstanceId: flags.FlagToStringPointer(p, cmd, ObservabilityInstanceIdFlag),
+		DisableObservability:    flags.FlagToBoolValue(p, cmd, DisableObservabilityFlag),
+		DNSZones:                flags.FlagToStringSlicePointer(p, cmd, DNSZonesFlag),
+		DisableDNS:              flags.FlagToBoolValue(p, cmd, DisableDNSFlag),
+	}
+	if opts.HibernationTimezone != nil && opts.HibernationStart == nil {
+		return nil, &errors.FlagValidationError{
+			Flag:    HibernationTimezoneFlag,
+			Details: fmt.Sprintf("must be set together with --%s and --%s", HibernationStartFlag, HibernationEndFlag),
+		}
+	}
+	for flag, expression := range map[string]*string{
+		HibernationStartFlag: opts.HibernationStart,
+		HibernationEndFlag:   opts.HibernationEnd,
+	} {
+		if expression == nil {
+			continue
+		}
+		if _, err := ParseCron(*expression); err != nil {
+			return nil, &errors.FlagValidationError{
+				Flag:    flag,
+				Details: err.Error(),
+			}
+		}
+	}
+	if opts.HibernationTimezone != nil {
+		if _, err := LoadHibernationTimezone(opts.HibernationTimezone); err != nil {
+			return nil, &errors.FlagValidationError{
+				Flag:    HibernationTimezoneFlag,
+				Details: err.Error(),
+			}
+		}
+	}
+	return opts, nil
+}
+
+func parseMaintenanceWindow(p *print.Printer, cmd *cobra.Command, flag string) (*time.Time, error) {
+	value := flags.FlagToStringPointer(p, cmd, flag)
+	if value == nil {
+		return nil, nil
+	}
+	for _, layout := range maintenanceWindowLayouts {
+		t, err := time.Parse(layout, *value)
+		if err == nil {
+			return &t, nil
+		}
+	}
+	return nil, &errors.FlagValidationError{
+		Flag:    flag,
+		Details: fmt.Sprintf(`%q is not a time in the format "HH:MM" with an optional UTC offset`, *value),
+	}
+}
+
+// ParseNodepool parses the value of the --nodepool flag.
+// Values without a key continue the list of the previous key, so multiple zones can be given as "zones=eu01-1,eu01-2"
+func ParseNodepool(value string) (*NodepoolOptions, error) {
+	nodepool := &NodepoolOptions{}
+	lastKey := ""
+	for _, part := range strings.Split(value, ",") {
+		key, v, found := strings.Cut(strings.TrimSpace(part), "=")
+		if !found {
+			if lastKey != nodepoolZonesKey {
+				return nil, fmt.Errorf("%q is not in the format key=value", part)
+			}
+			key, v = lastKey, key
+		}
+		if v == "" {
+			return nil, fmt.Errorf("value of %q is empty", key)
+		}
+		switch key {
+		case nodepoolNameKey:
+			nodepool.Name = v
+		case nodepoolMachineTypeKey:
+			nodepool.MachineType = &v
+		case nodepoolMinimumKey, nodepoolMaximumKey:
+			n, err := strconv.ParseInt(v, 10, 32)
+			if err != nil || n < 0 {
+				return nil, fmt.Errorf("value of %q must be a non-negative number", key)
+			}
+			size := int32(n)
+			if key == nodepoolMinimumKey {
+				nodepool.Minimum = &size
+			} else {
+				nodepool.Maximum = &size
+			}
+		case nodepoolZonesKey:
+			nodepool.AvailabilityZones = append(nodepool.AvailabilityZones, v)
+		default:
+			return nil, fmt.Errorf("unknown key %q, the available keys are: %s", key,
+				strings.Join([]string{nodepoolNameKey, nodepoolMachineTypeKey, nodepoolMinimumKey, nodepoolMaximumKey, nodepoolZonesKey}, ", "))
+		}
+		lastKey = key
+	}
+	if nodepool.Name == "" {
+		return nil, fmt.Errorf("the nodepool name must be set with %q", nodepoolNameKey+"=NAME")
+	}
+	return nodepool, nil
+}
+
+// PayloadFromCluster returns the payload which recreates the current spec of the cluster
+func PayloadFromCluster(cluster *ske.Cluster) *ske.CreateOrUpdateClusterPayload {
+	return &ske.CreateOrUpdateClusterPayload{
+		Access:      cluster.Access,
+		Extensions:  cluster.Extensions,
+		Hibernation: cluster.Hibernation,
+		Kubernetes:  cluster.Kubernetes,
+		Maintenance: cluster.Maintenance,
+		Network:     cluster.Network,
+		Nodepools:   cluster.Nodepools,
+		Status:      cluster.Status,
+	}
+}
+
+// GetDefaultNodepool returns the nodepool of the default payload, which new nodepools are based on
+func GetDefaultNodepool(ctx context.Context, apiClient ske.DefaultAPI, region string) (*ske.Nodepool, error) {
+	resp, err := apiClient.ListProviderOptions(ctx, region).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get SKE provider options: %w", err)
+	}
+	return getDefaultPayloadNodepool(resp)
+}
+
+// AddsNodepool returns true if the options add a nodepool which isn't in the payload yet
+func AddsNodepool(payload *ske.CreateOrUpdateClusterPayload, opts *ClusterSpecOptions) bool {
+	for _, nodepool := range opts.Nodepools {
+		if findNodepool(payload.Nodepools, nodepool.Name) == -1 {
+			return true
+		}
+	}
+	return false
+}
+
+// ApplyClusterSpec merges the options into the payload.
+// New nodepools are based on the default nodepool, which is only needed if AddsNodepool returns true
+func ApplyClusterSpec(payload *ske.CreateOrUpdateClusterPayload, opts *ClusterSpecOptions, defaultNodepool *ske.Nodepool) error {
+	if opts.KubernetesVersion != nil {
+		payload.Kubernetes.Version = *opts.KubernetesVersion
+	}
+
+	for _, name := range opts.RemoveNodepools {
+		i := findNodepool(payload.Nodepools, name)
+		if i == -1 {
+			return fmt.Errorf("nodepool %q to remove doesn't exist", name)
+		}
+		payload.Nodepools = slices.Delete(payload.Nodepools, i, i+1)
+	}
+	for _, nodepoolOpts := range opts.Nodepools {
+		err := applyNodepool(payload, &nodepoolOpts, defaultNodepool)
+		if err != nil {
+			return err
+		}
+	}
+	if len(payload.Nodepools) == 0 {
+		return fmt.Errorf("the cluster needs at least one nodepool")
+	}
+
+	if opts.MaintenanceWindowStart != nil || opts.MaintenanceWindowEnd != nil {
+		if payload.Maintenance == nil {
+			if opts.MaintenanceWindowStart == nil || opts.MaintenanceWindowEnd == nil {
+				return fmt.Errorf("the cluster has no maintenance window yet, both --%s and --%s must be set", MaintenanceWindowStartFlag, MaintenanceWindowEndFlag)
+			}
+			payload.Maintenance = &ske.Maintenance{}
+		}
+		if opts.MaintenanceWindowStart != nil {
+			payload.Maintenance.TimeWindow.Start = *opts.MaintenanceWindowStart
+		}
+		if opts.MaintenanceWindowEnd != nil {
+			payload.Maintenance.TimeWindow.End = *opts.MaintenanceWindowEnd
+		}
+	}
+
+	if opts.HibernationStart != nil && opts.HibernationEnd != nil {
+		payload.Hibernation = &ske.Hibernation{
+			Schedules: []ske.HibernationSchedule{
+				{
+					Start:    *opts.HibernationStart,
+					End:      *opts.HibernationEnd,
+					Timezone: opts.HibernationTimezone,
+				},
+			},
+		}
+	}
+
+	applyExtensions(payload, opts)
+	return nil
+}
+
+func applyNodepool(payload *ske.CreateOrUpdateClusterPayload, opts *NodepoolOptions, defaultNodepool *ske.Nodepool) error {
+	i := findNodepool(payload.Nodepools, opts.Name)
+	if i == -1 {
+		if opts.MachineType == nil {
+			return fmt.Errorf("nodepool %q doesn't exist, the machine type must be set to add it", opts.Name)
+		}
+		if defaultNodepool == nil {
+			return fmt.Errorf("nodepool %q doesn't exist and there is no default nodepool to base it on", opts.Name)
+		}
+		nodepool := *defaultNodepool
+		nodepool.Name = opts.Name
+		if len(opts.AvailabilityZones) > 0 {
+			// there must be as many nodes as availability zones are given
+			nodepool.Maximum = int32(len(opts.AvailabilityZones)) //nolint:gosec // the number of zones is small
+			nodepool.MaxSurge = new(nodepool.Maximum)
+		}
+		payload.Nodepools = append(payload.Nodepools, nodepool)
+		i = len(payload.Nodepools) - 1
+	}
+
+	nodepool := &payload.Nodepools[i]
+	if opts.MachineType != nil {
+		nodepool.Machine.Type = *opts.MachineType
+	}
+	if len(opts.AvailabilityZones) > 0 {
+		nodepool.AvailabilityZones = opts.AvailabilityZones
+	}
+	if opts.Minimum != nil {
+		nodepool.Minimum = *opts.Minimum
+	}
+	if opts.Maximum != nil {
+		nodepool.Maximum = *opts.Maximum
+	}
+	if opts.MaxSurge != nil {
+		nodepool.MaxSurge = opts.MaxSurge
+	}
+	if opts.MaxUnavailable != nil {
+		nodepool.MaxUnavailable = opts.MaxUnavailable
+	}
+	if opts.ImageName != nil && *opts.ImageName != nodepool.Machine.Image.Name {
+		if opts.ImageVersion == nil {
+			return fmt.Errorf("the image version must be set to change the image of nodepool %q", opts.Name)
+		}
+		nodepool.Machine.Image.Name = *opts.ImageName
+	}
+	if opts.ImageVersion != nil {
+		nodepool.Machine.Image.Version = *opts.ImageVersion
+	}
+	if opts.CRI != nil {
+		nodepool.Cri = &ske.CRI{
+			Name: opts.CRI,
+		}
+	}
+	if opts.VolumeType != nil {
+		nodepool.Volume.Type = opts.VolumeType
+	}
+	if opts.VolumeSize != nil {
+		nodepool.Volume.Size = *opts.VolumeSize
+	}
+	if opts.Labels != nil {
+		nodepool.Labels = opts.Labels
+	}
+	if opts.Taints != nil {
+		nodepool.Taints = *opts.Taints
+	}
+	if nodepool.Minimum > nodepool.Maximum {
+		return fmt.Errorf("the minimum %d of nodepool %q is larger than its maximum %d", nodepool.Minimum, opts.Name, nodepool.Maximum)
+	}
+	return nil
+}
+
+func applyExtensions(payload *ske.CreateOrUpdateClusterPayload, opts *ClusterSpecOptions) {
+	if opts.ACL == nil && !opts.DisableACL && opts.ObservabilityInstanceId == nil && !opts.DisableObservability && opts.DNSZones == nil && !opts.DisableDNS {
+		return
+	}
+	if payload.Extensions == nil {
+		payload.Extensions = &ske.Extension{}
+	}
+	extensions := payload.Extensions
+
+	if opts.ACL != nil {
+		extensions.Acl = &ske.ACL{
+			Enabled:      true,
+			AllowedCidrs: *opts.ACL,
+		}
+	}
+	if opts.DisableACL && extensions.Acl != nil {
+		extensions.Acl.Enabled = false
+	}
+
+	if opts.ObservabilityInstanceId != nil {
+		extensions.Observability = &ske.Observability{
+			Enabled:    true,
+			InstanceId: *opts.ObservabilityInstanceId,
+		}
+	}
+	if opts.DisableObservability && extensions.Observability != nil {
+		extensions.Observability.Enabled = false
+	}
+
+	if opts.DNSZones != nil {
+		extensions.Dns = &ske.DNS{
+			Enabled: true,
+			Zones:   *opts.DNSZones,
+		}
+	}
+	if opts.DisableDNS && extensions.Dns != nil {
+		extensions.Dns.Enabled = false
+	}
+}
+
+// GetNodepool returns the nodepool of the cluster with the given name
+func GetNodepool(cluster *ske.Cluster, name string) (*ske.Nodepool, error) {
+	i := findNodepool(cluster.Nodepools, name)
+	if i == -1 {
+		return nil, fmt.Errorf("nodepool %q doesn't exist in cluster %q", name, cluster.GetName())
+	}
+	return &cluster.Nodepools[i], nil
+}
+
+func findNodepool(nodepools []ske.Nodepool, name string) int {
+	return slices.IndexFunc(nodepools, func(nodepool ske.Nodepool) bool {
+		return nodepool.Name == name
+	})
+}
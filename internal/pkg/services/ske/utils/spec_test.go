@@ -0,0 +1,431 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	ske "github.com/stackitcloud/stackit-sdk-go/services/ske/v2api"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+)
+
+func fixtureSpecPayload(mods ...func(payload *ske.CreateOrUpdateClusterPayload)) *ske.CreateOrUpdateClusterPayload {
+	payload := &ske.CreateOrUpdateClusterPayload{
+		Kubernetes: ske.Kubernetes{
+			Version: "1.32.8",
+		},
+		Nodepools: []ske.Nodepool{
+			{
+				Name:              "pool-default",
+				AvailabilityZones: []string{"eu01-1"},
+				Machine: ske.Machine{
+					Type: "c2i.2",
+				},
+				Minimum: 1,
+				Maximum: 2,
+			},
+		},
+	}
+	for _, mod := range mods {
+		mod(payload)
+	}
+	return payload
+}
+
+func fixtureDefaultNodepool() *ske.Nodepool {
+	return &ske.Nodepool{
+		Name:              defaultNodepoolName,
+		AvailabilityZones: []string{"eu01-1", "eu01-2", "eu01-3"},
+		Machine: ske.Machine{
+			Type: "b1.2",
+			Image: ske.Image{
+				Name:    defaultNodepoolMachineImageName,
+				Version: "4230.2.3",
+			},
+		},
+		MaxSurge: utils.Ptr(int32(3)),
+		Minimum:  1,
+		Maximum:  3,
+	}
+}
+
+func TestParseNodepool(t *testing.T) {
+	tests := []struct {
+		description string
+		value       string
+		isValid     bool
+		expected    *NodepoolOptions
+	}{
+		{
+			description: "name only",
+			value:       "name=pool",
+			isValid:     true,
+			expected:    &NodepoolOptions{Name: "pool"},
+		},
+		{
+			description: "all keys",
+			value:       "name=pool,machine-type=c2i.4,min=1,max=3,zones=eu01-1",
+			isValid:     true,
+			expected: &NodepoolOptions{
+				Name:              "pool",
+				MachineType:       utils.Ptr("c2i.4"),
+				Minimum:           utils.Ptr(int32(1)),
+				Maximum:           utils.Ptr(int32(3)),
+				AvailabilityZones: []string{"eu01-1"},
+			},
+		},
+		{
+			description: "multiple zones",
+			value:       "zones=eu01-1,eu01-2, eu01-3,name=pool,max=3",
+			isValid:     true,
+			expected: &NodepoolOptions{
+				Name:              "pool",
+				Maximum:           utils.Ptr(int32(3)),
+				AvailabilityZones: []string{"eu01-1", "eu01-2", "eu01-3"},
+			},
+		},
+		{
+			description: "name missing",
+			value:       "max=3",
+			isValid:     false,
+		},
+		{
+			description: "unknown key",
+			value:       "name=pool,size=3",
+			isValid:     false,
+		},
+		{
+			description: "value without key",
+			value:       "name=pool,max=3,4",
+			isValid:     false,
+		},
+		{
+			description: "empty value",
+			value:       "name=pool,machine-type=",
+			isValid:     false,
+		},
+		{
+			description: "invalid size",
+			value:       "name=pool,min=-1",
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			nodepool, err := ParseNodepool(tt.value)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("did not fail on invalid input")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			diff := cmp.Diff(nodepool, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAddsNodepool(t *testing.T) {
+	tests := []struct {
+		description string
+		opts        *ClusterSpecOptions
+		expected    bool
+	}{
+		{
+			description: "no nodepools",
+			opts:        &ClusterSpecOptions{},
+			expected:    false,
+		},
+		{
+			description: "existing nodepool",
+			opts:        &ClusterSpecOptions{Nodepools: []NodepoolOptions{{Name: "pool-default"}}},
+			expected:    false,
+		},
+		{
+			description: "new nodepool",
+			opts:        &ClusterSpecOptions{Nodepools: []NodepoolOptions{{Name: "pool-default"}, {Name: "workers"}}},
+			expected:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			adds := AddsNodepool(fixtureSpecPayload(), tt.opts)
+			if adds != tt.expected {
+				t.Fatalf("expected %t, got %t", tt.expected, adds)
+			}
+		})
+	}
+}
+
+func TestApplyClusterSpec(t *testing.T) {
+	windowStart := time.Date(0, 1, 1, 3, 0, 0, 0, time.UTC)
+	windowEnd := time.Date(0, 1, 1, 5, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description     string
+		payload         *ske.CreateOrUpdateClusterPayload
+		opts            *ClusterSpecOptions
+		defaultNodepool *ske.Nodepool
+		isValid         bool
+		expected        *ske.CreateOrUpdateClusterPayload
+	}{
+		{
+			description: "no changes",
+			payload:     fixtureSpecPayload(),
+			opts:        &ClusterSpecOptions{},
+			isValid:     true,
+			expected:    fixtureSpecPayload(),
+		},
+		{
+			description: "kubernetes version",
+			payload:     fixtureSpecPayload(),
+			opts:        &ClusterSpecOptions{KubernetesVersion: utils.Ptr("1.33.5")},
+			isValid:     true,
+			expected: fixtureSpecPayload(func(payload *ske.CreateOrUpdateClusterPayload) {
+				payload.Kubernetes.Version = "1.33.5"
+			}),
+		},
+		{
+			description: "resize nodepool",
+			payload:     fixtureSpecPayload(),
+			opts: &ClusterSpecOptions{
+				Nodepools: []NodepoolOptions{{Name: "pool-default", Maximum: utils.Ptr(int32(10))}},
+			},
+			isValid: true,
+			expected: fixtureSpecPayload(func(payload *ske.CreateOrUpdateClusterPayload) {
+				payload.Nodepools[0].Maximum = 10
+			}),
+		},
+		{
+			description: "resize nodepool below minimum",
+			payload:     fixtureSpecPayload(),
+			opts: &ClusterSpecOptions{
+				Nodepools: []NodepoolOptions{{Name: "pool-default", Maximum: utils.Ptr(int32(0))}},
+			},
+			isValid: false,
+		},
+		{
+			description: "add nodepool",
+			payload:     fixtureSpecPayload(),
+			opts: &ClusterSpecOptions{
+				Nodepools: []NodepoolOptions{{
+					Name:              "workers",
+					MachineType:       utils.Ptr("c2i.4"),
+					AvailabilityZones: []string{"eu01-1", "eu01-2"},
+				}},
+			},
+			defaultNodepool: fixtureDefaultNodepool(),
+			isValid:         true,
+			expected: fixtureSpecPayload(func(payload *ske.CreateOrUpdateClusterPayload) {
+				nodepool := *fixtureDefaultNodepool()
+				nodepool.Name = "workers"
+				nodepool.Machine.Type = "c2i.4"
+				nodepool.AvailabilityZones = []string{"eu01-1", "eu01-2"}
+				nodepool.Maximum = 2
+				nodepool.MaxSurge = utils.Ptr(int32(2))
+				payload.Nodepools = append(payload.Nodepools, nodepool)
+			}),
+		},
+		{
+			description: "update nodepool",
+			payload:     fixtureSpecPayload(),
+			opts: &ClusterSpecOptions{
+				Nodepools: []NodepoolOptions{{
+					Name:           "pool-default",
+					MaxSurge:       utils.Ptr(int32(2)),
+					MaxUnavailable: utils.Ptr(int32(1)),
+					ImageName:      utils.Ptr("ubuntu"),
+					ImageVersion:   utils.Ptr("2204.20250620.0"),
+					CRI:            ske.NAMEOFTHECRILIBRARY_CONTAINERD.Ptr(),
+					VolumeType:     utils.Ptr("storage_premium_perf2"),
+					VolumeSize:     utils.Ptr(int32(100)),
+					Labels:         &map[string]string{"team": "a"},
+					Taints:         &[]ske.Taint{{Key: "dedicated", Value: utils.Ptr("a"), Effect: ske.TaintEffect("NoSchedule")}},
+				}},
+			},
+			isValid: true,
+			expected: fixtureSpecPayload(func(payload *ske.CreateOrUpdateClusterPayload) {
+				nodepool := &payload.Nodepools[0]
+				nodepool.MaxSurge = utils.Ptr(int32(2))
+				nodepool.MaxUnavailable = utils.Ptr(int32(1))
+				nodepool.Machine.Image = ske.Image{Name: "ubuntu", Version: "2204.20250620.0"}
+				nodepool.Cri = &ske.CRI{Name: ske.NAMEOFTHECRILIBRARY_CONTAINERD.Ptr()}
+				nodepool.Volume = ske.Volume{Type: utils.Ptr("storage_premium_perf2"), Size: 100}
+				nodepool.Labels = &map[string]string{"team": "a"}
+				nodepool.Taints = []ske.Taint{{Key: "dedicated", Value: utils.Ptr("a"), Effect: ske.TaintEffect("NoSchedule")}}
+			}),
+		},
+		{
+			description: "change image without version",
+			payload:     fixtureSpecPayload(),
+			opts: &ClusterSpecOptions{
+				Nodepools: []NodepoolOptions{{Name: "pool-default", ImageName: utils.Ptr("ubuntu")}},
+			},
+			isValid: false,
+		},
+		{
+			description: "add nodepool without machine type",
+			payload:     fixtureSpecPayload(),
+			opts: &ClusterSpecOptions{
+				Nodepools: []NodepoolOptions{{Name: "workers"}},
+			},
+			defaultNodepool: fixtureDefaultNodepool(),
+			isValid:         false,
+		},
+		{
+			description: "add nodepool without default nodepool",
+			payload:     fixtureSpecPayload(),
+			opts: &ClusterSpecOptions{
+				Nodepools: []NodepoolOptions{{Name: "workers", MachineType: utils.Ptr("c2i.4")}},
+			},
+			isValid: false,
+		},
+		{
+			description: "replace nodepool",
+			payload:     fixtureSpecPayload(),
+			opts: &ClusterSpecOptions{
+				RemoveNodepools: []string{"pool-default"},
+				Nodepools:       []NodepoolOptions{{Name: "workers", MachineType: utils.Ptr("c2i.4")}},
+			},
+			defaultNodepool: fixtureDefaultNodepool(),
+			isValid:         true,
+			expected: fixtureSpecPayload(func(payload *ske.CreateOrUpdateClusterPayload) {
+				nodepool := *fixtureDefaultNodepool()
+				nodepool.Name = "workers"
+				nodepool.Machine.Type = "c2i.4"
+				payload.Nodepools = []ske.Nodepool{nodepool}
+			}),
+		},
+		{
+			description: "remove unknown nodepool",
+			payload:     fixtureSpecPayload(),
+			opts:        &ClusterSpecOptions{RemoveNodepools: []string{"workers"}},
+			isValid:     false,
+		},
+		{
+			description: "remove last nodepool",
+			payload:     fixtureSpecPayload(),
+			opts:        &ClusterSpecOptions{RemoveNodepools: []string{"pool-default"}},
+			isValid:     false,
+		},
+		{
+			description: "maintenance window",
+			payload:     fixtureSpecPayload(),
+			opts: &ClusterSpecOptions{
+				MaintenanceWindowStart: &windowStart,
+				MaintenanceWindowEnd:   &windowEnd,
+			},
+			isValid: true,
+			expected: fixtureSpecPayload(func(payload *ske.CreateOrUpdateClusterPayload) {
+				payload.Maintenance = &ske.Maintenance{
+					TimeWindow: ske.TimeWindow{
+						Start: windowStart,
+						End:   windowEnd,
+					},
+				}
+			}),
+		},
+		{
+			description: "maintenance window start only, without maintenance",
+			payload:     fixtureSpecPayload(),
+			opts:        &ClusterSpecOptions{MaintenanceWindowStart: &windowStart},
+			isValid:     false,
+		},
+		{
+			description: "maintenance window start only",
+			payload: fixtureSpecPayload(func(payload *ske.CreateOrUpdateClusterPayload) {
+				payload.Maintenance = &ske.Maintenance{
+					AutoUpdate: ske.MaintenanceAutoUpdate{KubernetesVersion: utils.Ptr(true)},
+					TimeWindow: ske.TimeWindow{End: windowEnd},
+				}
+			}),
+			opts:    &ClusterSpecOptions{MaintenanceWindowStart: &windowStart},
+			isValid: true,
+			expected: fixtureSpecPayload(func(payload *ske.CreateOrUpdateClusterPayload) {
+				payload.Maintenance = &ske.Maintenance{
+					AutoUpdate: ske.MaintenanceAutoUpdate{KubernetesVersion: utils.Ptr(true)},
+					TimeWindow: ske.TimeWindow{Start: windowStart, End: windowEnd},
+				}
+			}),
+		},
+		{
+			description: "hibernation",
+			payload:     fixtureSpecPayload(),
+			opts: &ClusterSpecOptions{
+				HibernationStart:    utils.Ptr("0 18 * * 1-5"),
+				HibernationEnd:      utils.Ptr("0 8 * * 1-5"),
+				HibernationTimezone: utils.Ptr("Europe/Berlin"),
+			},
+			isValid: true,
+			expected: fixtureSpecPayload(func(payload *ske.CreateOrUpdateClusterPayload) {
+				payload.Hibernation = &ske.Hibernation{
+					Schedules: []ske.HibernationSchedule{
+						{Start: "0 18 * * 1-5", End: "0 8 * * 1-5", Timezone: utils.Ptr("Europe/Berlin")},
+					},
+				}
+			}),
+		},
+		{
+			description: "enable extensions",
+			payload:     fixtureSpecPayload(),
+			opts: &ClusterSpecOptions{
+				ACL:                     &[]string{"198.51.100.0/24"},
+				ObservabilityInstanceId: utils.Ptr("instance-id"),
+				DNSZones:                &[]string{"example.runs.onstackit.cloud"},
+			},
+			isValid: true,
+			expected: fixtureSpecPayload(func(payload *ske.CreateOrUpdateClusterPayload) {
+				payload.Extensions = &ske.Extension{
+					Acl:           &ske.ACL{Enabled: true, AllowedCidrs: []string{"198.51.100.0/24"}},
+					Observability: &ske.Observability{Enabled: true, InstanceId: "instance-id"},
+					Dns:           &ske.DNS{Enabled: true, Zones: []string{"example.runs.onstackit.cloud"}},
+				}
+			}),
+		},
+		{
+			description: "disable extensions",
+			payload: fixtureSpecPayload(func(payload *ske.CreateOrUpdateClusterPayload) {
+				payload.Extensions = &ske.Extension{
+					Acl: &ske.ACL{Enabled: true, AllowedCidrs: []string{"198.51.100.0/24"}},
+				}
+			}),
+			opts: &ClusterSpecOptions{
+				DisableACL:           true,
+				DisableObservability: true,
+			},
+			isValid: true,
+			expected: fixtureSpecPayload(func(payload *ske.CreateOrUpdateClusterPayload) {
+				payload.Extensions = &ske.Extension{
+					Acl: &ske.ACL{Enabled: false, AllowedCidrs: []string{"198.51.100.0/24"}},
+				}
+			}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := ApplyClusterSpec(tt.payload, tt.opts, tt.defaultNodepool)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("did not fail on invalid input")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			diff := cmp.Diff(tt.payload, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
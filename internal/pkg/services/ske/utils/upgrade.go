@@ -0,0 +1,306 @@
+package utils
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	ske "github.com/stackitcloud/stackit-sdk-go/services/ske/v2api"
+	"golang.org/x/mod/semver"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+)
+
+const (
+	deprecatedState = "deprecated"
+	previewState    = "preview"
+)
+
+// VersionStatus is the state of a Kubernetes or machine image version in the provider options
+type VersionStatus struct {
+	Version        string     `json:"version"`
+	State          string     `json:"state"`
+	ExpirationDate *time.Time `json:"expirationDate,omitempty"`
+}
+
+// NodepoolUpgrade is the machine image upgrade of a nodepool
+type NodepoolUpgrade struct {
+	Name          string        `json:"name"`
+	Image         string        `json:"image"`
+	Current       VersionStatus `json:"current"`
+	TargetVersion string        `json:"targetVersion,omitempty"`
+}
+
+// UpgradeStep is a single update of the cluster. Kubernetes can only be upgraded by one minor version per step
+type UpgradeStep struct {
+	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
+	// MachineImageVersions are the target image versions by nodepool name
+	MachineImageVersions map[string]string `json:"machineImageVersions,omitempty"`
+}
+
+func (s *UpgradeStep) String() string {
+	parts := []string{}
+	if s.KubernetesVersion != "" {
+		parts = append(parts, fmt.Sprintf("Kubernetes version %s", s.KubernetesVersion))
+	}
+	if len(s.MachineImageVersions) > 0 {
+		parts = append(parts, fmt.Sprintf("machine images of %d nodepool(s)", len(s.MachineImageVersions)))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// UpgradePlan are the steps to upgrade a cluster to a Kubernetes version and the latest supported machine images
+type UpgradePlan struct {
+	ClusterName string            `json:"clusterName"`
+	Kubernetes  VersionStatus     `json:"kubernetes"`
+	Nodepools   []NodepoolUpgrade `json:"nodepools"`
+	Steps       []UpgradeStep     `json:"steps"`
+}
+
+// TargetKubernetesVersion returns the Kubernetes version of the cluster after the upgrade
+func (p *UpgradePlan) TargetKubernetesVersion() string {
+	version := p.Kubernetes.Version
+	for i := range p.Steps {
+		if p.Steps[i].KubernetesVersion != "" {
+			version = p.Steps[i].KubernetesVersion
+		}
+	}
+	return version
+}
+
+// PlanUpgrade determines the steps to upgrade the cluster to the target Kubernetes version.
+// If the target version is empty, the latest supported version is used.
+// Intermediate minor versions are upgraded to their latest available patch version, expired and preview versions are skipped.
+// The machine images of the nodepools are upgraded to their latest supported version in a final step
+func PlanUpgrade(cluster *ske.Cluster, options *ske.ProviderOptions, targetVersion string, now time.Time) (*UpgradePlan, error) {
+	currentVersion := cluster.Kubernetes.Version
+	plan := &UpgradePlan{
+		ClusterName: utils.PtrString(cluster.Name),
+		Kubernetes:  kubernetesVersionStatus(currentVersion, options.KubernetesVersions),
+		Nodepools:   []NodepoolUpgrade{},
+		Steps:       []UpgradeStep{},
+	}
+
+	if targetVersion == "" {
+		targetVersion = latestKubernetesVersion(options.KubernetesVersions)
+		if targetVersion == "" {
+			return nil, fmt.Errorf("no supported Kubernetes version found")
+		}
+	}
+	kubernetesSteps, err := kubernetesUpgradePath(currentVersion, targetVersion, options.KubernetesVersions, now)
+	if err != nil {
+		return nil, err
+	}
+	for _, version := range kubernetesSteps {
+		plan.Steps = append(plan.Steps, UpgradeStep{KubernetesVersion: version})
+	}
+
+	imageVersions := map[string]string{}
+	for i := range cluster.Nodepools {
+		nodepool := &cluster.Nodepools[i]
+		upgrade := NodepoolUpgrade{
+			Name:    nodepool.Name,
+			Image:   nodepool.Machine.Image.Name,
+			Current: machineImageVersionStatus(nodepool.Machine.Image.Name, nodepool.Machine.Image.Version, options.MachineImages),
+		}
+		latest := latestMachineImageVersion(nodepool, options.MachineImages)
+		if latest != "" && compareVersions(latest, nodepool.Machine.Image.Version) > 0 {
+			upgrade.TargetVersion = latest
+			imageVersions[nodepool.Name] = latest
+		}
+		plan.Nodepools = append(plan.Nodepools, upgrade)
+	}
+	if len(imageVersions) > 0 {
+		plan.Steps = append(plan.Steps, UpgradeStep{MachineImageVersions: imageVersions})
+	}
+	return plan, nil
+}
+
+// ApplyUpgradeStep sets the versions of the step in the payload.
+// The nodepools are copied, as they are usually shared with the cluster the payload is based on
+func ApplyUpgradeStep(payload *ske.CreateOrUpdateClusterPayload, step *UpgradeStep) {
+	if step.KubernetesVersion != "" {
+		payload.Kubernetes.Version = step.KubernetesVersion
+	}
+	payload.Nodepools = slices.Clone(payload.Nodepools)
+	for i := range payload.Nodepools {
+		if version, ok := step.MachineImageVersions[payload.Nodepools[i].Name]; ok {
+			payload.Nodepools[i].Machine.Image.Version = version
+		}
+	}
+}
+
+// VersionWarnings returns warnings for deprecated Kubernetes and machine image versions of the cluster,
+// which are upgraded automatically once they expire
+func VersionWarnings(cluster *ske.Cluster, options *ske.ProviderOptions, now time.Time) []string {
+	warnings := []string{}
+	status := kubernetesVersionStatus(cluster.Kubernetes.Version, options.KubernetesVersions)
+	if status.State == deprecatedState {
+		warnings = append(warnings, fmt.Sprintf("Kubernetes version %s is deprecated%s", status.Version, expirationHint(status.ExpirationDate, now)))
+	}
+	for i := range cluster.Nodepools {
+		image := cluster.Nodepools[i].Machine.Image
+		imageStatus := machineImageVersionStatus(image.Name, image.Version, options.MachineImages)
+		if imageStatus.State == deprecatedState {
+			warnings = append(warnings, fmt.Sprintf("Machine image %s %s of nodepool %q is deprecated%s", image.Name, imageStatus.Version, cluster.Nodepools[i].Name, expirationHint(imageStatus.ExpirationDate, now)))
+		}
+	}
+	return warnings
+}
+
+func expirationHint(expirationDate *time.Time, now time.Time) string {
+	if expirationDate == nil {
+		return ""
+	}
+	days := int(expirationDate.Sub(now).Hours() / 24)
+	return fmt.Sprintf(" and expires on %s (in %d days), when it is upgraded automatically", expirationDate.Format(time.DateOnly), max(days, 0))
+}
+
+func kubernetesVersionStatus(version string, versions []ske.KubernetesVersion) VersionStatus {
+	status := VersionStatus{Version: version}
+	for i := range versions {
+		if versions[i].GetVersion() == version {
+			status.State = versions[i].GetState()
+			status.ExpirationDate = versions[i].ExpirationDate
+		}
+	}
+	return status
+}
+
+func machineImageVersionStatus(name, version string, images []ske.MachineImage) VersionStatus {
+	status := VersionStatus{Version: version}
+	for i := range images {
+		if images[i].GetName() != name {
+			continue
+		}
+		for j := range images[i].Versions {
+			if images[i].Versions[j].GetVersion() == version {
+				status.State = images[i].Versions[j].GetState()
+				status.ExpirationDate = images[i].Versions[j].ExpirationDate
+			}
+		}
+	}
+	return status
+}
+
+func latestKubernetesVersion(versions []ske.KubernetesVersion) string {
+	latest := ""
+	for i := range versions {
+		if versions[i].GetState() != supportedState {
+			continue
+		}
+		if latest == "" || compareVersions(versions[i].GetVersion(), latest) > 0 {
+			latest = versions[i].GetVersion()
+		}
+	}
+	return latest
+}
+
+// latestMachineImageVersion returns the latest supported version of the image of the nodepool, which supports its CRI
+func latestMachineImageVersion(nodepool *ske.Nodepool, images []ske.MachineImage) string {
+	latest := ""
+	for i := range images {
+		if images[i].GetName() != nodepool.Machine.Image.Name {
+			continue
+		}
+		for j := range images[i].Versions {
+			version := &images[i].Versions[j]
+			if version.GetState() != supportedState || !supportsCRI(version, nodepool.Cri) {
+				continue
+			}
+			if latest == "" || compareVersions(version.GetVersion(), latest) > 0 {
+				latest = version.GetVersion()
+			}
+		}
+	}
+	return latest
+}
+
+func supportsCRI(version *ske.MachineImageVersion, cri *ske.CRI) bool {
+	if cri == nil || cri.Name == nil {
+		return true
+	}
+	for i := range version.Cri {
+		if version.Cri[i].Name != nil && *version.Cri[i].Name == *cri.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// kubernetesUpgradePath returns the versions to upgrade to, one per minor version
+func kubernetesUpgradePath(currentVersion, targetVersion string, versions []ske.KubernetesVersion, now time.Time) ([]string, error) {
+	comparison := compareVersions(targetVersion, currentVersion)
+	if comparison == 0 {
+		return []string{}, nil
+	}
+	if comparison < 0 {
+		return nil, fmt.Errorf("Kubernetes version %s is older than the current version %s, downgrades aren't possible", targetVersion, currentVersion)
+	}
+	if !isAvailable(targetVersion, versions, now) {
+		return nil, fmt.Errorf("Kubernetes version %s is not available", targetVersion)
+	}
+
+	currentMinor, err := minorVersion(currentVersion)
+	if err != nil {
+		return nil, err
+	}
+	targetMinor, err := minorVersion(targetVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	path := []string{}
+	for minor := currentMinor + 1; minor < targetMinor; minor++ {
+		version := latestPatchVersion(semver.Major("v"+targetVersion), minor, versions, now)
+		if version == "" {
+			return nil, fmt.Errorf("no available Kubernetes version with minor version %d found, which is needed to upgrade to %s", minor, targetVersion)
+		}
+		path = append(path, version)
+	}
+	return append(path, targetVersion), nil
+}
+
+func latestPatchVersion(major string, minor int, versions []ske.KubernetesVersion, now time.Time) string {
+	prefix := fmt.Sprintf("%s.%d", major, minor)
+	latest := ""
+	for i := range versions {
+		version := versions[i].GetVersion()
+		if semver.MajorMinor("v"+version) != prefix || !isAvailable(version, versions, now) {
+			continue
+		}
+		if latest == "" || compareVersions(version, latest) > 0 {
+			latest = version
+		}
+	}
+	return latest
+}
+
+// isAvailable returns true if the version is listed, not a preview and not expired
+func isAvailable(version string, versions []ske.KubernetesVersion, now time.Time) bool {
+	for i := range versions {
+		if versions[i].GetVersion() != version {
+			continue
+		}
+		if versions[i].GetState() == previewState {
+			return false
+		}
+		return versions[i].ExpirationDate == nil || versions[i].ExpirationDate.After(now)
+	}
+	return false
+}
+
+func minorVersion(version string) (int, error) {
+	majorMinor := semver.MajorMinor("v" + version)
+	if majorMinor == "" {
+		return 0, fmt.Errorf("invalid version %q", version)
+	}
+	_, minor, _ := strings.Cut(majorMinor, ".")
+	return strconv.Atoi(minor)
+}
+
+func compareVersions(a, b string) int {
+	return semver.Compare("v"+a, "v"+b)
+}
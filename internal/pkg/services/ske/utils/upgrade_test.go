@@ -0,0 +1,265 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	ske "github.com/stackitcloud/stackit-sdk-go/services/ske/v2api"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
+)
+
+var (
+	testNow        = time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	testExpiration = time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+	testExpired    = time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func fixtureUpgradeOptions() *ske.ProviderOptions {
+	return &ske.ProviderOptions{
+		KubernetesVersions: []ske.KubernetesVersion{
+			{Version: utils.Ptr("1.31.10"), State: utils.Ptr(deprecatedState), ExpirationDate: utils.Ptr(testExpiration)},
+			{Version: utils.Ptr("1.32.5"), State: utils.Ptr(deprecatedState), ExpirationDate: utils.Ptr(testExpired)},
+			{Version: utils.Ptr("1.32.8"), State: utils.Ptr(supportedState)},
+			{Version: utils.Ptr("1.33.4"), State: utils.Ptr(supportedState)},
+			{Version: utils.Ptr("1.33.5"), State: utils.Ptr(supportedState)},
+			{Version: utils.Ptr("1.34.1"), State: utils.Ptr(previewState)},
+		},
+		MachineImages: []ske.MachineImage{
+			{
+				Name: utils.Ptr("flatcar"),
+				Versions: []ske.MachineImageVersion{
+					{
+						Version:        utils.Ptr("4152.2.3"),
+						State:          utils.Ptr(deprecatedState),
+						ExpirationDate: utils.Ptr(testExpiration),
+						Cri:            []ske.CRI{{Name: ske.NAMEOFTHECRILIBRARY_CONTAINERD.Ptr()}},
+					},
+					{
+						Version: utils.Ptr("4230.2.3"),
+						State:   utils.Ptr(supportedState),
+						Cri:     []ske.CRI{{Name: ske.NAMEOFTHECRILIBRARY_CONTAINERD.Ptr()}},
+					},
+					{
+						Version: utils.Ptr("4300.0.0"),
+						State:   utils.Ptr(previewState),
+						Cri:     []ske.CRI{{Name: ske.NAMEOFTHECRILIBRARY_CONTAINERD.Ptr()}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func fixtureUpgradeCluster(mods ...func(cluster *ske.Cluster)) *ske.Cluster {
+	cluster := &ske.Cluster{
+		Name: utils.Ptr(testClusterName),
+		Kubernetes: ske.Kubernetes{
+			Version: "1.31.10",
+		},
+		Nodepools: []ske.Nodepool{
+			{
+				Name: "pool-old",
+				Machine: ske.Machine{
+					Image: ske.Image{Name: "flatcar", Version: "4152.2.3"},
+				},
+				Cri: &ske.CRI{Name: ske.NAMEOFTHECRILIBRARY_CONTAINERD.Ptr()},
+			},
+			{
+				Name: "pool-new",
+				Machine: ske.Machine{
+					Image: ske.Image{Name: "flatcar", Version: "4230.2.3"},
+				},
+			},
+		},
+	}
+	for _, mod := range mods {
+		mod(cluster)
+	}
+	return cluster
+}
+
+func TestPlanUpgrade(t *testing.T) {
+	nodepools := []NodepoolUpgrade{
+		{
+			Name:          "pool-old",
+			Image:         "flatcar",
+			Current:       VersionStatus{Version: "4152.2.3", State: deprecatedState, ExpirationDate: utils.Ptr(testExpiration)},
+			TargetVersion: "4230.2.3",
+		},
+		{
+			Name:    "pool-new",
+			Image:   "flatcar",
+			Current: VersionStatus{Version: "4230.2.3", State: supportedState},
+		},
+	}
+	imageStep := UpgradeStep{MachineImageVersions: map[string]string{"pool-old": "4230.2.3"}}
+
+	tests := []struct {
+		description   string
+		cluster       *ske.Cluster
+		targetVersion string
+		isValid       bool
+		expected      *UpgradePlan
+	}{
+		{
+			description: "latest version",
+			cluster:     fixtureUpgradeCluster(),
+			isValid:     true,
+			expected: &UpgradePlan{
+				ClusterName: testClusterName,
+				Kubernetes:  VersionStatus{Version: "1.31.10", State: deprecatedState, ExpirationDate: utils.Ptr(testExpiration)},
+				Nodepools:   nodepools,
+				Steps: []UpgradeStep{
+					{KubernetesVersion: "1.32.8"},
+					{KubernetesVersion: "1.33.5"},
+					imageStep,
+				},
+			},
+		},
+		{
+			description:   "target version",
+			cluster:       fixtureUpgradeCluster(),
+			targetVersion: "1.32.8",
+			isValid:       true,
+			expected: &UpgradePlan{
+				ClusterName: testClusterName,
+				Kubernetes:  VersionStatus{Version: "1.31.10", State: deprecatedState, ExpirationDate: utils.Ptr(testExpiration)},
+				Nodepools:   nodepools,
+				Steps: []UpgradeStep{
+					{KubernetesVersion: "1.32.8"},
+					imageStep,
+				},
+			},
+		},
+		{
+			description: "patch upgrade",
+			cluster: fixtureUpgradeCluster(func(cluster *ske.Cluster) {
+				cluster.Kubernetes.Version = "1.33.4"
+				cluster.Nodepools = cluster.Nodepools[1:]
+			}),
+			isValid: true,
+			expected: &UpgradePlan{
+				ClusterName: testClusterName,
+				Kubernetes:  VersionStatus{Version: "1.33.4", State: supportedState},
+				Nodepools:   nodepools[1:],
+				Steps: []UpgradeStep{
+					{KubernetesVersion: "1.33.5"},
+				},
+			},
+		},
+		{
+			description: "up to date",
+			cluster: fixtureUpgradeCluster(func(cluster *ske.Cluster) {
+				cluster.Kubernetes.Version = "1.33.5"
+				cluster.Nodepools = cluster.Nodepools[1:]
+			}),
+			isValid: true,
+			expected: &UpgradePlan{
+				ClusterName: testClusterName,
+				Kubernetes:  VersionStatus{Version: "1.33.5", State: supportedState},
+				Nodepools:   nodepools[1:],
+				Steps:       []UpgradeStep{},
+			},
+		},
+		{
+			description:   "downgrade",
+			cluster:       fixtureUpgradeCluster(),
+			targetVersion: "1.30.1",
+			isValid:       false,
+		},
+		{
+			description:   "preview version",
+			cluster:       fixtureUpgradeCluster(),
+			targetVersion: "1.34.1",
+			isValid:       false,
+		},
+		{
+			description:   "expired version",
+			cluster:       fixtureUpgradeCluster(),
+			targetVersion: "1.32.5",
+			isValid:       false,
+		},
+		{
+			description: "missing intermediate version",
+			cluster: fixtureUpgradeCluster(func(cluster *ske.Cluster) {
+				cluster.Kubernetes.Version = "1.29.14"
+			}),
+			targetVersion: "1.32.8",
+			isValid:       false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			plan, err := PlanUpgrade(tt.cluster, fixtureUpgradeOptions(), tt.targetVersion, testNow)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("did not fail on invalid input")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			diff := cmp.Diff(plan, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestApplyUpgradeStep(t *testing.T) {
+	payload := PayloadFromCluster(fixtureUpgradeCluster())
+
+	ApplyUpgradeStep(payload, &UpgradeStep{
+		KubernetesVersion:    "1.32.8",
+		MachineImageVersions: map[string]string{"pool-old": "4230.2.3"},
+	})
+
+	if payload.Kubernetes.Version != "1.32.8" {
+		t.Fatalf("expected Kubernetes version 1.32.8, got %s", payload.Kubernetes.Version)
+	}
+	for _, nodepool := range payload.Nodepools {
+		if nodepool.Machine.Image.Version != "4230.2.3" {
+			t.Fatalf("expected image version 4230.2.3 for nodepool %q, got %s", nodepool.Name, nodepool.Machine.Image.Version)
+		}
+	}
+}
+
+func TestVersionWarnings(t *testing.T) {
+	tests := []struct {
+		description string
+		cluster     *ske.Cluster
+		expected    []string
+	}{
+		{
+			description: "deprecated versions",
+			cluster:     fixtureUpgradeCluster(),
+			expected: []string{
+				"Kubernetes version 1.31.10 is deprecated and expires on 2026-11-01 (in 30 days), when it is upgraded automatically",
+				`Machine image flatcar 4152.2.3 of nodepool "pool-old" is deprecated and expires on 2026-11-01 (in 30 days), when it is upgraded automatically`,
+			},
+		},
+		{
+			description: "supported versions",
+			cluster: fixtureUpgradeCluster(func(cluster *ske.Cluster) {
+				cluster.Kubernetes.Version = "1.33.5"
+				cluster.Nodepools = cluster.Nodepools[1:]
+			}),
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			warnings := VersionWarnings(tt.cluster, fixtureUpgradeOptions(), testNow)
+			diff := cmp.Diff(warnings, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
@@ -244,8 +244,9 @@ func ConvertToSeconds(timeStr string) (*string, error) {
 	return utils.Ptr(strconv.FormatUint(result, 10)), nil
 }
 
-// Merge new Kubeconfig into existing Kubeconfig. If it doesn´t exits, creates a new one
-func MergeKubeConfig(pathDestionationKubeConfig, contentNewKubeConfig string) error {
+// Merge new Kubeconfig into existing Kubeconfig. If it doesn´t exits, creates a new one.
+// The current context is set to the one of the new kubeconfig if switchContext is true or no current context is set
+func MergeKubeConfig(pathDestionationKubeConfig, contentNewKubeConfig string, switchContext bool) error {
 	if contentNewKubeConfig == "" {
 		return fmt.Errorf("no data to merge. the new kubeconfig is empty")
 	}
@@ -269,6 +270,10 @@ func MergeKubeConfig(pathDestionationKubeConfig, contentNewKubeConfig string) er
 	maps.Copy(existingConfig.Contexts, newConfig.Contexts)
 	maps.Copy(existingConfig.Clusters, newConfig.Clusters)
 
+	if newConfig.CurrentContext != "" && (switchContext || existingConfig.CurrentContext == "") {
+		existingConfig.CurrentContext = newConfig.CurrentContext
+	}
+
 	err = clientcmd.WriteToFile(*existingConfig, pathDestionationKubeConfig)
 	if err != nil {
 		return fmt.Errorf("error writing merged kubeconfig: %w", err)
@@ -311,3 +316,15 @@ func GetDefaultKubeconfigPath() (string, error) {
 
 	return filepath.Join(userHome, ".kube", "config"), nil
 }
+
+// GetKubeconfigPath returns the path of the --filepath flag if set and the default location of the kubeconfig file otherwise.
+func GetKubeconfigPath(filePath *string) (string, error) {
+	if filePath != nil {
+		return *filePath, nil
+	}
+	kubeconfigPath, err := GetDefaultKubeconfigPath()
+	if err != nil {
+		return "", fmt.Errorf("get default kubeconfig path: %w", err)
+	}
+	return kubeconfigPath, nil
+}
@@ -539,7 +539,10 @@ func TestMergeKubeConfig(t *testing.T) {
 		isValid            bool
 		isLocationDir      bool
 		isLocationEmpty    bool
+		switchContext      bool
 		expectedErr        string
+		// expectedContext is the expected current context, if set
+		expectedContext string
 	}{
 		{
 			description: "base",
@@ -580,6 +583,16 @@ func TestMergeKubeConfig(t *testing.T) {
 			kubeconfig:         newKubeConfig,
 			existingKubeconfig: existingKubeConfig,
 			isValid:            true,
+			expectedContext:    "existing-cluster",
+		},
+		{
+			description:        "switch context",
+			location:           filepath.Join("switch", "config"),
+			kubeconfig:         newKubeConfig,
+			existingKubeconfig: existingKubeConfig,
+			switchContext:      true,
+			isValid:            true,
+			expectedContext:    "my-new-super-ske-cluster",
 		},
 	}
 
@@ -614,7 +627,7 @@ func TestMergeKubeConfig(t *testing.T) {
 				testLocation += string(filepath.Separator)
 			}
 
-			err := MergeKubeConfig(testLocation, tt.kubeconfig)
+			err := MergeKubeConfig(testLocation, tt.kubeconfig, tt.switchContext)
 
 			if tt.isValid && err != nil {
 				t.Errorf("failed on valid input %s", err)
@@ -635,6 +648,10 @@ func TestMergeKubeConfig(t *testing.T) {
 					t.Errorf("error loading new kubeconfig: %s", err)
 				}
 
+				if tt.expectedContext != "" && kubeConfigFinal.CurrentContext != tt.expectedContext {
+					t.Errorf("expected current context %q, got %q", tt.expectedContext, kubeConfigFinal.CurrentContext)
+				}
+
 				// check new kubeconfig is still there
 				for name := range kubeConfigNew.AuthInfos {
 					_, exits := kubeConfigFinal.AuthInfos[name]
@@ -764,3 +781,37 @@ func TestGetDefaultKubeconfigPathWithEnvVar(t *testing.T) {
 		})
 	}
 }
+
+func TestGetKubeconfigPath(t *testing.T) {
+	tests := []struct {
+		description string
+		filepath    *string
+		expected    string
+	}{
+		{
+			description: "filepath set",
+			filepath:    utils.Ptr("/tmp/kubeconfig"),
+			expected:    "/tmp/kubeconfig",
+		},
+		{
+			description: "filepath not set",
+			expected:    "/home/test-user/.kube/config",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			t.Setenv("KUBECONFIG", "")
+			t.Setenv("HOME", "/home/test-user")
+
+			output, err := GetKubeconfigPath(tt.filepath)
+
+			if err != nil {
+				t.Errorf("failed on valid input")
+			}
+			if output != tt.expected {
+				t.Errorf("expected output to be %s, got %s", tt.expected, output)
+			}
+		})
+	}
+}
@@ -0,0 +1,273 @@
+package utils
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dbconnect"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dbcredentials"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flags"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/print"
+
+	"github.com/spf13/cobra"
+	sqlserverflex "github.com/stackitcloud/stackit-sdk-go/services/sqlserverflex/v3api"
+	sqlserverflexWait "github.com/stackitcloud/stackit-sdk-go/services/sqlserverflex/v3api/wait"
+)
+
+const (
+	LoginUserIdFlag = "login-user-id"
+
+	// passwordEnv is read by sqlcmd as well
+	passwordEnv = "SQLCMDPASSWORD"
+)
+
+// DatabaseRoles are the fixed database roles of SQLServer, which can be granted to users.
+// The API doesn't manage database roles, so they are granted with T-SQL
+var DatabaseRoles = []string{
+	"db_owner",
+	"db_securityadmin",
+	"db_accessadmin",
+	"db_backupoperator",
+	"db_ddladmin",
+	"db_datawriter",
+	"db_datareader",
+	"db_denydatawriter",
+	"db_denydatareader",
+}
+
+// Permission is the membership of a user in a role of a database
+type Permission struct {
+	Database string `json:"database"`
+	User     string `json:"user"`
+	Role     string `json:"role"`
+}
+
+// QueryOptions are the connection details used to run queries on an instance
+type QueryOptions struct {
+	LoginUserId int64
+	// If empty, the password is read from the SQLCMDPASSWORD environment variable or prompted for
+	Password string
+	Connect  *dbconnect.Options
+}
+
+// ConfigureQueryFlags adds the flags of commands which run queries on an instance to the command
+func ConfigureQueryFlags(cmd *cobra.Command) {
+	cmd.Flags().Int64(LoginUserIdFlag, 0, "ID of the user to run the queries as, which needs to be allowed to manage the roles of the databases. Its password is read from the SQLCMDPASSWORD environment variable or prompted for")
+	dbconnect.ConfigureExecFlags(cmd)
+}
+
+// ParseQueryFlags parses the flags configured by ConfigureQueryFlags
+func ParseQueryFlags(p *print.Printer, cmd *cobra.Command) (*QueryOptions, error) {
+	connectOptions, err := dbconnect.ParseFlags(p, cmd)
+	if err != nil {
+		return nil, err
+	}
+	return &QueryOptions{
+		LoginUserId: flags.FlagWithDefaultToInt64Value(p, cmd, LoginUserIdFlag),
+		Connect:     connectOptions,
+	}, nil
+}
+
+// InstanceACL reads and updates the ACL of a SQLServer Flex instance
+type InstanceACL struct {
+	APIClient  sqlserverflex.DefaultAPI
+	ProjectId  string
+	Region     string
+	InstanceId string
+}
+
+func (a *InstanceACL) Get(ctx context.Context) ([]string, error) {
+	resp, err := a.APIClient.GetInstance(ctx, a.ProjectId, a.Region, a.InstanceId).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get SQLServer Flex instance: %w", err)
+	}
+	return resp.Network.Acl, nil
+}
+
+func (a *InstanceACL) Update(ctx context.Context, acl []string) error {
+	_, err := a.APIClient.PartialUpdateInstance(ctx, a.ProjectId, a.Region, a.InstanceId).
+		PartialUpdateInstancePayload(sqlserverflex.PartialUpdateInstancePayload{
+			Network: &sqlserverflex.PartialUpdateInstancePayloadNetwork{Acl: acl},
+		}).Execute()
+	if err != nil {
+		return fmt.Errorf("update SQLServer Flex instance: %w", err)
+	}
+	_, err = sqlserverflexWait.UpdateInstanceWaitHandler(ctx, a.APIClient, a.ProjectId, a.Region, a.InstanceId).WaitWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("wait for SQLServer Flex instance update: %w", err)
+	}
+	return nil
+}
+
+// UserTarget returns the connection details of the user
+func UserTarget(user *sqlserverflex.GetUserResponse) *dbconnect.Target {
+	target := &dbconnect.Target{
+		Engine: dbcredentials.EngineSQLServer,
+	}
+	if user == nil {
+		return target
+	}
+	target.Host = user.Host
+	target.Username = user.Username
+	target.Database = user.DefaultDatabase
+	if user.Port != 0 {
+		target.Port = strconv.FormatInt(int64(user.Port), 10)
+	}
+	return target
+}
+
+// RunQuery runs the query on the instance as the login user, with its IP address temporarily added to the ACL
+func RunQuery(ctx context.Context, p *print.Printer, apiClient sqlserverflex.DefaultAPI, projectId, region, instanceId string, opts *QueryOptions, query string) (string, error) {
+	user, err := apiClient.GetUser(ctx, projectId, region, instanceId, opts.LoginUserId).Execute()
+	if err != nil {
+		return "", fmt.Errorf("get SQLServer Flex user: %w", err)
+	}
+
+	password := opts.Password
+	if password == "" {
+		password = os.Getenv(passwordEnv)
+	}
+	if password == "" {
+		password, err = p.PromptForPassword(fmt.Sprintf("Enter the password of user %q: ", user.Username))
+		if err != nil {
+			return "", err
+		}
+	}
+
+	ip := opts.Connect.IP
+	if ip == "" {
+		ip, err = dbconnect.GetPublicIP(ctx)
+		if err != nil {
+			return "", fmt.Errorf("detect public IP address, set it with the --%s flag instead: %w", dbconnect.IPFlag, err)
+		}
+	}
+
+	acl := &InstanceACL{
+		APIClient:  apiClient,
+		ProjectId:  projectId,
+		Region:     region,
+		InstanceId: instanceId,
+	}
+	return dbconnect.Exec(ctx, p, acl, UserTarget(user), dbconnect.ACLEntry(ip), password, query)
+}
+
+// ValidateDatabaseRole returns an error if the role is not a fixed database role
+func ValidateDatabaseRole(role string) error {
+	if !slices.Contains(DatabaseRoles, role) {
+		return fmt.Errorf("%q is not a database role, the available roles are: %s", role, strings.Join(DatabaseRoles, ", "))
+	}
+	return nil
+}
+
+// GrantRoleQuery returns the query which adds the user to the role of the database.
+// The database user is created for the login of the same name, if it doesn't exist yet
+func GrantRoleQuery(database, user, role string) string {
+	return strings.Join([]string{
+		"SET NOCOUNT ON;",
+		fmt.Sprintf("USE %s;", quoteIdentifier(database)),
+		fmt.Sprintf("IF NOT EXISTS (SELECT 1 FROM sys.database_principals WHERE name = %s) CREATE USER %s FOR LOGIN %s;", quoteString(user), quoteIdentifier(user), quoteIdentifier(user)),
+		fmt.Sprintf("ALTER ROLE %s ADD MEMBER %s;", quoteIdentifier(role), quoteIdentifier(user)),
+	}, "\n")
+}
+
+// RevokeRoleQuery returns the query which removes the user from the role of the database
+func RevokeRoleQuery(database, user, role string) string {
+	return strings.Join([]string{
+		"SET NOCOUNT ON;",
+		fmt.Sprintf("USE %s;", quoteIdentifier(database)),
+		fmt.Sprintf("ALTER ROLE %s DROP MEMBER %s;", quoteIdentifier(role), quoteIdentifier(user)),
+	}, "\n")
+}
+
+// PermissionsQuery returns the query which lists the role memberships of the databases, one per line as "[database]|[user]|[role]".
+// The names are quoted, as they can contain the separator of the columns.
+// Database users are listed by the name of their login, so the owner is listed instead of "dbo"
+func PermissionsQuery(databases []string) string {
+	selects := []string{}
+	for _, database := range databases {
+		db := quoteIdentifier(database)
+		selects = append(selects, fmt.Sprintf(
+			"SELECT QUOTENAME(%s), QUOTENAME(COALESCE(SUSER_SNAME(m.sid), m.name)), QUOTENAME(r.name) FROM %s.sys.database_role_members rm "+
+				"JOIN %s.sys.database_principals r ON rm.role_principal_id = r.principal_id "+
+				"JOIN %s.sys.database_principals m ON rm.member_principal_id = m.principal_id "+
+				"WHERE m.type IN ('S', 'U', 'E', 'X')",
+			quoteString(database), db, db, db))
+	}
+	return "SET NOCOUNT ON;\n" + strings.Join(selects, "\nUNION ALL\n") + ";"
+}
+
+// ParsePermissions parses the output of the query returned by PermissionsQuery
+func ParsePermissions(output string) ([]Permission, error) {
+	permissions := []Permission{}
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		fields, ok := splitQuotedIdentifiers(line)
+		if !ok || len(fields) != 3 {
+			return nil, fmt.Errorf("parse permissions: unexpected line %q", line)
+		}
+		permissions = append(permissions, Permission{
+			Database: fields[0],
+			User:     fields[1],
+			Role:     fields[2],
+		})
+	}
+	slices.SortFunc(permissions, func(a, b Permission) int {
+		return cmp.Or(
+			strings.Compare(a.User, b.User),
+			strings.Compare(a.Database, b.Database),
+			strings.Compare(a.Role, b.Role),
+		)
+	})
+	return permissions, nil
+}
+
+// splitQuotedIdentifiers splits a line of identifiers quoted with QUOTENAME, which are separated by "|"
+func splitQuotedIdentifiers(line string) (identifiers []string, ok bool) {
+	for {
+		if !strings.HasPrefix(line, "[") {
+			return nil, false
+		}
+		line = line[1:]
+
+		var identifier strings.Builder
+		for {
+			end := strings.Index(line, "]")
+			if end == -1 {
+				return nil, false
+			}
+			identifier.WriteString(line[:end])
+			line = line[end+1:]
+			// A closing bracket in the name is escaped by doubling it
+			if !strings.HasPrefix(line, "]") {
+				break
+			}
+			identifier.WriteString("]")
+			line = line[1:]
+		}
+		identifiers = append(identifiers, identifier.String())
+
+		if line == "" {
+			return identifiers, true
+		}
+		if !strings.HasPrefix(line, "|") {
+			return nil, false
+		}
+		line = line[1:]
+	}
+}
+
+func quoteIdentifier(name string) string {
+	return "[" + strings.ReplaceAll(name, "]", "]]") + "]"
+}
+
+func quoteString(value string) string {
+	return "N'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
@@ -0,0 +1,196 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	sqlserverflex "github.com/stackitcloud/stackit-sdk-go/services/sqlserverflex/v3api"
+
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dbconnect"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/dbcredentials"
+)
+
+func TestUserTarget(t *testing.T) {
+	tests := []struct {
+		description string
+		user        *sqlserverflex.GetUserResponse
+		expected    *dbconnect.Target
+	}{
+		{
+			description: "base",
+			user: &sqlserverflex.GetUserResponse{
+				Host:            "host",
+				Port:            1433,
+				Username:        "user",
+				DefaultDatabase: "db",
+			},
+			expected: &dbconnect.Target{
+				Engine:   dbcredentials.EngineSQLServer,
+				Host:     "host",
+				Port:     "1433",
+				Username: "user",
+				Database: "db",
+			},
+		},
+		{
+			description: "empty response",
+			user:        &sqlserverflex.GetUserResponse{},
+			expected:    &dbconnect.Target{Engine: dbcredentials.EngineSQLServer},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			target := UserTarget(tt.user)
+			diff := cmp.Diff(target, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateDatabaseRole(t *testing.T) {
+	tests := []struct {
+		description string
+		role        string
+		isValid     bool
+	}{
+		{
+			description: "base",
+			role:        "db_datareader",
+			isValid:     true,
+		},
+		{
+			description: "server role",
+			role:        "##STACKIT_LoginManager##",
+			isValid:     false,
+		},
+		{
+			description: "case sensitive",
+			role:        "DB_OWNER",
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := ValidateDatabaseRole(tt.role)
+			if tt.isValid && err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			if !tt.isValid && err == nil {
+				t.Fatalf("did not fail on invalid input")
+			}
+		})
+	}
+}
+
+func TestGrantRoleQuery(t *testing.T) {
+	expected := "SET NOCOUNT ON;\n" +
+		"USE [my]]db];\n" +
+		"IF NOT EXISTS (SELECT 1 FROM sys.database_principals WHERE name = N'o''brien') CREATE USER [o'brien] FOR LOGIN [o'brien];\n" +
+		"ALTER ROLE [db_datareader] ADD MEMBER [o'brien];"
+
+	query := GrantRoleQuery("my]db", "o'brien", "db_datareader")
+	if query != expected {
+		t.Fatalf("expected query %q, got %q", expected, query)
+	}
+}
+
+func TestRevokeRoleQuery(t *testing.T) {
+	expected := "SET NOCOUNT ON;\n" +
+		"USE [db];\n" +
+		"ALTER ROLE [db_owner] DROP MEMBER [user];"
+
+	query := RevokeRoleQuery("db", "user", "db_owner")
+	if query != expected {
+		t.Fatalf("expected query %q, got %q", expected, query)
+	}
+}
+
+func TestPermissionsQuery(t *testing.T) {
+	expected := "SET NOCOUNT ON;\n" +
+		"SELECT QUOTENAME(N'db1'), QUOTENAME(COALESCE(SUSER_SNAME(m.sid), m.name)), QUOTENAME(r.name) FROM [db1].sys.database_role_members rm " +
+		"JOIN [db1].sys.database_principals r ON rm.role_principal_id = r.principal_id " +
+		"JOIN [db1].sys.database_principals m ON rm.member_principal_id = m.principal_id " +
+		"WHERE m.type IN ('S', 'U', 'E', 'X')\n" +
+		"UNION ALL\n" +
+		"SELECT QUOTENAME(N'db''2'), QUOTENAME(COALESCE(SUSER_SNAME(m.sid), m.name)), QUOTENAME(r.name) FROM [db'2].sys.database_role_members rm " +
+		"JOIN [db'2].sys.database_principals r ON rm.role_principal_id = r.principal_id " +
+		"JOIN [db'2].sys.database_principals m ON rm.member_principal_id = m.principal_id " +
+		"WHERE m.type IN ('S', 'U', 'E', 'X');"
+
+	query := PermissionsQuery([]string{"db1", "db'2"})
+	if query != expected {
+		t.Fatalf("expected query %q, got %q", expected, query)
+	}
+}
+
+func TestParsePermissions(t *testing.T) {
+	tests := []struct {
+		description string
+		output      string
+		isValid     bool
+		expected    []Permission
+	}{
+		{
+			description: "base",
+			output:      "[db2]|[user]|[db_owner]\r\n[db1]|[user]|[db_datareader]\n\n[db1]|[admin]|[db_owner]\n",
+			isValid:     true,
+			expected: []Permission{
+				{Database: "db1", User: "admin", Role: "db_owner"},
+				{Database: "db1", User: "user", Role: "db_datareader"},
+				{Database: "db2", User: "user", Role: "db_owner"},
+			},
+		},
+		{
+			description: "names with separator and brackets",
+			output:      "[db|1]|[user]]|x]|[db_owner]\n",
+			isValid:     true,
+			expected: []Permission{
+				{Database: "db|1", User: "user]|x", Role: "db_owner"},
+			},
+		},
+		{
+			description: "missing column",
+			output:      "[db1]|[user]\n",
+			isValid:     false,
+		},
+		{
+			description: "unquoted names",
+			output:      "db1|user|db_owner\n",
+			isValid:     false,
+		},
+		{
+			description: "empty output",
+			output:      "",
+			isValid:     true,
+			expected:    []Permission{},
+		},
+		{
+			description: "unexpected output",
+			output:      "Msg 229, Level 14, State 5",
+			isValid:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			permissions, err := ParsePermissions(tt.output)
+			if !tt.isValid {
+				if err == nil {
+					t.Fatalf("did not fail on invalid input")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed on valid input: %v", err)
+			}
+			diff := cmp.Diff(permissions, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
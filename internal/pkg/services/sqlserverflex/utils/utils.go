@@ -5,9 +5,12 @@ import (
 	"fmt"
 	"math"
 	"strings"
+	"time"
 
 	"github.com/stackitcloud/stackit-cli/internal/pkg/errors"
+	"github.com/stackitcloud/stackit-cli/internal/pkg/flexsizing"
 
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
 	sqlserverflex "github.com/stackitcloud/stackit-sdk-go/services/sqlserverflex/v3api"
 )
 
@@ -15,6 +18,10 @@ const (
 	ServiceCmd = "sqlserverflex"
 )
 
+// The restore job isn't listed right after it was triggered.
+// If it isn't listed within this period, waiting for it fails.
+var restoreJobListedGracePeriod = 2 * time.Minute
+
 func ValidateFlavorId(flavorId string, flavors []sqlserverflex.ListFlavors) error {
 	if flavors == nil {
 		return fmt.Errorf("nil flavors")
@@ -76,6 +83,34 @@ func LoadFlavorId(cpu, ram int64, flavors []sqlserverflex.ListFlavors) (string,
 	}
 }
 
+// SizingOptions joins the flavors with their storages and the supported versions
+func SizingOptions(flavors []sqlserverflex.ListFlavors, versions []sqlserverflex.Version) []flexsizing.Option {
+	versionNames := []string{}
+	for _, v := range versions {
+		versionNames = append(versionNames, string(v.Version))
+	}
+
+	options := []flexsizing.Option{}
+	for _, f := range flavors {
+		storageClasses := []string{}
+		for _, sc := range f.StorageClasses {
+			storageClasses = append(storageClasses, sc.Class)
+		}
+		options = append(options, flexsizing.Option{
+			FlavorId:       f.Id,
+			CPU:            f.Cpu,
+			RAM:            f.Memory,
+			NodeType:       f.NodeType,
+			Description:    f.Description,
+			MinStorage:     int64(f.MinGB),
+			MaxStorage:     int64(f.MaxGB),
+			StorageClasses: storageClasses,
+			Versions:       versionNames,
+		})
+	}
+	return options
+}
+
 func ListAllFlavors(ctx context.Context, api sqlserverflex.DefaultAPI, projectId, region string) ([]sqlserverflex.ListFlavors, error) {
 	const pageSize = 100
 	const sort = sqlserverflex.FLAVORSORT_ID_ASC
@@ -121,3 +156,38 @@ func GetFlavor(ctx context.Context, client sqlserverflex.DefaultAPI, projectId,
 	}
 	return nil, fmt.Errorf("flavor with ID %q not found in project %q", flavorId, projectId)
 }
+
+// RestoreDatabaseWaitHandler waits until the restore job of the database was listed on the instance and isn't running anymore.
+// As the finished restore jobs aren't listed, the restored database is checked to exist afterwards.
+func RestoreDatabaseWaitHandler(ctx context.Context, apiClient sqlserverflex.DefaultAPI, projectId, region, instanceId, databaseName string) *wait.AsyncActionHandler[sqlserverflex.ListCurrentRunningRestoreJobs] {
+	startedAt := time.Now()
+	seen := false
+	handler := wait.New(func() (waitFinished bool, response *sqlserverflex.ListCurrentRunningRestoreJobs, err error) {
+		resp, err := apiClient.ListCurrentRunningRestoreJobs(ctx, projectId, region, instanceId).Execute()
+		if err != nil {
+			return false, nil, err
+		}
+		if resp == nil {
+			return false, nil, fmt.Errorf("list SQLServer Flex restore jobs: empty response")
+		}
+		for _, job := range resp.RunningRestores {
+			if job.DatabaseName == databaseName {
+				seen = true
+				return false, resp, nil
+			}
+		}
+		if !seen {
+			if time.Since(startedAt) < restoreJobListedGracePeriod {
+				return false, resp, nil
+			}
+			return true, resp, fmt.Errorf("restore job of database %q wasn't listed within %s", databaseName, restoreJobListedGracePeriod)
+		}
+		_, err = apiClient.GetDatabase(ctx, projectId, region, instanceId, databaseName).Execute()
+		if err != nil {
+			return true, resp, fmt.Errorf("get restored SQLServer Flex database %q: %w", databaseName, err)
+		}
+		return true, resp, nil
+	})
+	handler.SetTimeout(45 * time.Minute)
+	return handler
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stackitcloud/stackit-cli/internal/pkg/utils"
 
@@ -22,21 +23,25 @@ const (
 	testInstanceName = "instance"
 	testUserName     = "user"
 	testRegion       = "eu01"
+	testDatabaseName = "database"
 )
 
 type mockSettings struct {
-	listFlavorsFails     bool
-	listFlavorsResp      *sqlserverflex.ListFlavorsResponse
-	listFlavorsResps     []*sqlserverflex.ListFlavorsResponse
-	listFlavorsCallCount int
-	listVersionsFails    bool
-	listVersionsResp     *sqlserverflex.ListVersionsResponse
-	getInstanceFails     bool
-	getInstanceResp      *sqlserverflex.GetInstanceResponse
-	getUserFails         bool
-	getUserResp          *sqlserverflex.GetUserResponse
-	listRestoreJobsFails bool
-	listRestoreJobsResp  *sqlserverflex.ListCurrentRunningRestoreJobs
+	listFlavorsFails         bool
+	listFlavorsResp          *sqlserverflex.ListFlavorsResponse
+	listFlavorsResps         []*sqlserverflex.ListFlavorsResponse
+	listFlavorsCallCount     int
+	listVersionsFails        bool
+	listVersionsResp         *sqlserverflex.ListVersionsResponse
+	getInstanceFails         bool
+	getInstanceResp          *sqlserverflex.GetInstanceResponse
+	getUserFails             bool
+	getUserResp              *sqlserverflex.GetUserResponse
+	listRestoreJobsFails     bool
+	listRestoreJobsResp      *sqlserverflex.ListCurrentRunningRestoreJobs
+	listRestoreJobsResps     []*sqlserverflex.ListCurrentRunningRestoreJobs
+	listRestoreJobsCallCount int
+	getDatabaseFails         bool
 }
 
 func newApiMock(s *mockSettings) sqlserverflex.DefaultAPI {
@@ -75,10 +80,22 @@ func newApiMock(s *mockSettings) sqlserverflex.DefaultAPI {
 		}),
 		ListCurrentRunningRestoreJobsExecuteMock: utils.Ptr(func(_ sqlserverflex.ApiListCurrentRunningRestoreJobsRequest) (*sqlserverflex.ListCurrentRunningRestoreJobs, error) {
 			if s.listRestoreJobsFails {
-				return nil, fmt.Errorf("could not list versions")
+				return nil, fmt.Errorf("could not list restore jobs")
+			}
+			if len(s.listRestoreJobsResps) > 0 {
+				// The last response is repeated
+				resp := s.listRestoreJobsResps[min(s.listRestoreJobsCallCount, len(s.listRestoreJobsResps)-1)]
+				s.listRestoreJobsCallCount++
+				return resp, nil
 			}
 			return s.listRestoreJobsResp, nil
 		}),
+		GetDatabaseExecuteMock: utils.Ptr(func(_ sqlserverflex.ApiGetDatabaseRequest) (*sqlserverflex.GetDatabaseResponse, error) {
+			if s.getDatabaseFails {
+				return nil, fmt.Errorf("could not get database")
+			}
+			return &sqlserverflex.GetDatabaseResponse{}, nil
+		}),
 	}
 }
 
@@ -647,3 +664,117 @@ func TestGetUserName(t *testing.T) {
 		})
 	}
 }
+
+func TestRestoreDatabaseWaitHandler(t *testing.T) {
+	runningRestoreJobs := &sqlserverflex.ListCurrentRunningRestoreJobs{
+		RunningRestores: []sqlserverflex.RestoreRunningRestore{
+			{
+				DatabaseName: testDatabaseName,
+			},
+		},
+	}
+
+	tests := []struct {
+		description                 string
+		listRestoreJobsFails        bool
+		listRestoreJobsResps        []*sqlserverflex.ListCurrentRunningRestoreJobs
+		getDatabaseFails            bool
+		restoreJobListedGracePeriod time.Duration
+		isValid                     bool
+	}{
+		{
+			description: "restore job finished",
+			listRestoreJobsResps: []*sqlserverflex.ListCurrentRunningRestoreJobs{
+				{},
+				runningRestoreJobs,
+				{},
+			},
+			restoreJobListedGracePeriod: time.Hour,
+			isValid:                     true,
+		},
+		{
+			description: "restored database missing",
+			listRestoreJobsResps: []*sqlserverflex.ListCurrentRunningRestoreJobs{
+				runningRestoreJobs,
+				{},
+			},
+			getDatabaseFails:            true,
+			restoreJobListedGracePeriod: time.Hour,
+			isValid:                     false,
+		},
+		{
+			description: "restore job not listed yet",
+			listRestoreJobsResps: []*sqlserverflex.ListCurrentRunningRestoreJobs{
+				{},
+			},
+			restoreJobListedGracePeriod: time.Hour,
+			isValid:                     false,
+		},
+		{
+			description: "restore job never listed",
+			listRestoreJobsResps: []*sqlserverflex.ListCurrentRunningRestoreJobs{
+				{},
+			},
+			restoreJobListedGracePeriod: 0,
+			isValid:                     false,
+		},
+		{
+			description: "restore job of other database running",
+			listRestoreJobsResps: []*sqlserverflex.ListCurrentRunningRestoreJobs{
+				{
+					RunningRestores: []sqlserverflex.RestoreRunningRestore{
+						{
+							DatabaseName: "other-database",
+						},
+					},
+				},
+			},
+			restoreJobListedGracePeriod: 0,
+			isValid:                     false,
+		},
+		{
+			description: "restore job still running",
+			listRestoreJobsResps: []*sqlserverflex.ListCurrentRunningRestoreJobs{
+				runningRestoreJobs,
+			},
+			restoreJobListedGracePeriod: 0,
+			isValid:                     false,
+		},
+		{
+			description:          "list restore jobs fails",
+			listRestoreJobsFails: true,
+			isValid:              false,
+		},
+		{
+			description: "empty response",
+			isValid:     false,
+		},
+	}
+
+	defaultRestoreJobListedGracePeriod := restoreJobListedGracePeriod
+	t.Cleanup(func() {
+		restoreJobListedGracePeriod = defaultRestoreJobListedGracePeriod
+	})
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			settings := &mockSettings{
+				listRestoreJobsFails: tt.listRestoreJobsFails,
+				listRestoreJobsResps: tt.listRestoreJobsResps,
+				getDatabaseFails:     tt.getDatabaseFails,
+			}
+			restoreJobListedGracePeriod = tt.restoreJobListedGracePeriod
+
+			handler := RestoreDatabaseWaitHandler(context.Background(), newApiMock(settings), testProjectId, testRegion, testInstanceId, testDatabaseName)
+			handler.SetSleepBeforeWait(0).SetThrottle(10 * time.Millisecond).SetTimeout(100 * time.Millisecond)
+			_, err := handler.WaitWithContext(context.Background())
+
+			if tt.isValid && err != nil {
+				t.Errorf("failed on valid input: %v", err)
+			}
+			if !tt.isValid && err == nil {
+				t.Errorf("did not fail on invalid input")
+			}
+		})
+	}
+}
@@ -32,7 +32,7 @@ func main() {
 		Fs:         utils.OsFS{},
 		Args:       os.Args[1:],
 	}
-	if !cmd.Execute(&params) {
-		os.Exit(1)
+	if exitCode := cmd.Execute(&params); exitCode != 0 {
+		os.Exit(exitCode)
 	}
 }